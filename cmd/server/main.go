@@ -45,23 +45,72 @@ func main() {
 
 	// Wire repositories (postgres implementations) and services
 	pool := repo.Pool()
+	leagueRepo := repoPg.NewLeagueRepository(pool)
 	teamRepo := repoPg.NewTeamRepository(pool)
 	playerRepo := repoPg.NewPlayerRepository(pool)
+	seasonRepo := repoPg.NewSeasonRepository(pool)
+	ruleProfileRepo := repoPg.NewRuleProfileRepository(pool)
+	venueRepo := repoPg.NewVenueRepository(pool)
 	gameRepo := repoPg.NewGameRepository(pool)
+	officialRepo := repoPg.NewOfficialRepository(pool)
+	staffRepo := repoPg.NewStaffRepository(pool)
 	statsRepo := repoPg.NewStatsRepository(pool)
+	availabilityRepo := repoPg.NewAvailabilityRepository(pool)
+	eventRepo := repoPg.NewEventRepository(pool)
+	lineupRepo := repoPg.NewLineupRepository(pool)
+	shotRepo := repoPg.NewShotRepository(pool)
+	metricsRepo := repoPg.NewMetricsRepository(pool)
+	leadersRepo := repoPg.NewLeadersRepository(pool)
+	achievementRepo := repoPg.NewAchievementRepository(pool)
+	searchRepo := repoPg.NewSearchRepository(pool)
 	txManager := repoPg.NewTxManager(pool)
 
-	teamSvc := service.NewTeamService(teamRepo, appLogger)
-	playerSvc := service.NewPlayerService(playerRepo, teamRepo, appLogger)
-	gameSvc := service.NewGameService(gameRepo, teamRepo, txManager, appLogger)
-	statsSvc := service.NewStatsService(statsRepo, playerRepo, gameRepo, txManager, appLogger)
+	leagueSvc := service.NewLeagueService(leagueRepo, appLogger)
+	teamSvc := service.NewTeamService(teamRepo, venueRepo, appLogger)
+	playerSvc := service.NewPlayerService(playerRepo, teamRepo, txManager, appLogger)
+	seasonSvc := service.NewSeasonService(seasonRepo, txManager, appLogger)
+	ruleProfileSvc := service.NewRuleProfileService(ruleProfileRepo, appLogger)
+	venueSvc := service.NewVenueService(venueRepo, appLogger)
+	gameSvc := service.NewGameService(gameRepo, teamRepo, seasonRepo, ruleProfileRepo, venueRepo, statsRepo, achievementRepo, txManager, appLogger)
+	officialSvc := service.NewOfficialService(officialRepo, gameRepo, txManager, appLogger)
+	staffSvc := service.NewStaffService(staffRepo, teamRepo, txManager, appLogger)
+	statsSvc := service.NewStatsService(statsRepo, playerRepo, gameRepo, ruleProfileRepo, achievementRepo, availabilityRepo, shotRepo, txManager, appLogger)
+	availabilitySvc := service.NewAvailabilityService(availabilityRepo, playerRepo, gameRepo, statsRepo, txManager, appLogger)
+	eventSvc := service.NewEventService(eventRepo, statsRepo, achievementRepo, playerRepo, gameRepo, ruleProfileRepo, availabilityRepo, shotRepo, txManager, appLogger)
+	lineupSvc := service.NewLineupService(lineupRepo, teamRepo, playerRepo, gameRepo, ruleProfileRepo, txManager, appLogger)
+	shotSvc := service.NewShotService(shotRepo, statsRepo, playerRepo, gameRepo, ruleProfileRepo, txManager, appLogger)
+	metricsSvc := service.NewMetricsService(metricsRepo, playerRepo, appLogger)
+	standingsSvc := service.NewStandingsService(teamRepo, seasonRepo, appLogger)
+	leadersSvc := service.NewLeadersService(leadersRepo, cfg.Leaders.MinGames, appLogger)
+	achievementSvc := service.NewAchievementService(achievementRepo, playerRepo, appLogger)
+	searchSvc := service.NewSearchService(searchRepo, appLogger)
 
 	// HTTP server (Gin)
 	gin.SetMode(gin.ReleaseMode)
 	r := gin.New()
 	r.Use(gin.Recovery())
 
-	handler.Register(r, repo, teamSvc, playerSvc, gameSvc, statsSvc)
+	handler.Register(r, repo, handler.Services{
+		Leagues:      leagueSvc,
+		Teams:        teamSvc,
+		Players:      playerSvc,
+		Seasons:      seasonSvc,
+		RuleProfiles: ruleProfileSvc,
+		Venues:       venueSvc,
+		Games:        gameSvc,
+		Officials:    officialSvc,
+		Staff:        staffSvc,
+		Stats:        statsSvc,
+		Availability: availabilitySvc,
+		Events:       eventSvc,
+		Lineups:      lineupSvc,
+		Shots:        shotSvc,
+		Metrics:      metricsSvc,
+		Standings:    standingsSvc,
+		Leaders:      leadersSvc,
+		Achievements: achievementSvc,
+		Search:       searchSvc,
+	})
 
 	addr := fmt.Sprintf(":%d", cfg.App.Port)
 	srv := &http.Server{
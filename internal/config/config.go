@@ -38,8 +38,15 @@ func (p PostgresConfig) String() string {
 	)
 }
 
+// LeadersConfig tunes statistical leaderboards.
+type LeadersConfig struct {
+	// MinGames is the number of games a player needs to qualify for a leaderboard; 0 disables the threshold.
+	MinGames int `mapstructure:"min_games"`
+}
+
 type Config struct {
 	App      AppConfig           `mapstructure:"app"`
 	Logger   logger.LoggerConfig `mapstructure:"logger"`
 	Postgres PostgresConfig      `mapstructure:"postgres"`
+	Leaders  LeadersConfig       `mapstructure:"leaders"`
 }
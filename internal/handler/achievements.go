@@ -0,0 +1,59 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/maxviazov/basketball-stats-service/internal/model"
+	"github.com/maxviazov/basketball-stats-service/internal/repository"
+	"github.com/maxviazov/basketball-stats-service/internal/service"
+	"github.com/maxviazov/basketball-stats-service/pkg/response"
+)
+
+type AchievementHandler struct {
+	svc service.AchievementService
+}
+
+func NewAchievementHandler(svc service.AchievementService) *AchievementHandler {
+	return &AchievementHandler{svc: svc}
+}
+
+func (h *AchievementHandler) Register(r *gin.RouterGroup) {
+	r.GET("/achievements", h.list)
+	r.Group("/players").GET("/:id/achievements", h.listByPlayer)
+}
+
+// list serves /achievements?[season=YYYY-YY][&kind=][&limit=&offset=].
+func (h *AchievementHandler) list(c *gin.Context) {
+	var f model.AchievementFilter
+	if v := c.Query("season"); v != "" {
+		f.Season = &v
+	}
+	if v := c.Query("kind"); v != "" {
+		f.Kind = &v
+	}
+	limit, _ := strconv.Atoi(c.Query("limit"))
+	offset, _ := strconv.Atoi(c.Query("offset"))
+	res, err := h.svc.ListAchievements(c.Request.Context(), f, repository.Page{Limit: limit, Offset: offset})
+	if err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	response.WriteData(c, http.StatusOK, res)
+}
+
+func (h *AchievementHandler) listByPlayer(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+	limit, _ := strconv.Atoi(c.Query("limit"))
+	offset, _ := strconv.Atoi(c.Query("offset"))
+	res, err := h.svc.ListPlayerAchievements(c.Request.Context(), id, repository.Page{Limit: limit, Offset: offset})
+	if err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	response.WriteData(c, http.StatusOK, res)
+}
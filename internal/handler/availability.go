@@ -0,0 +1,195 @@
+package handler
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/maxviazov/basketball-stats-service/internal/model"
+	"github.com/maxviazov/basketball-stats-service/internal/service"
+	"github.com/maxviazov/basketball-stats-service/pkg/response"
+)
+
+type AvailabilityHandler struct {
+	svc service.AvailabilityService
+}
+
+func NewAvailabilityHandler(svc service.AvailabilityService) *AvailabilityHandler {
+	return &AvailabilityHandler{svc: svc}
+}
+
+func (h *AvailabilityHandler) Register(r *gin.RouterGroup) {
+	g := r.Group("/games")
+	{
+		g.GET("/:id/availability", h.listByGame)
+		g.PUT("/:id/availability/:player_id", h.set)
+		g.DELETE("/:id/availability/:player_id", h.clear)
+	}
+	p := r.Group("/players")
+	{
+		p.POST("/:id/injuries", h.recordInjury)
+		p.GET("/:id/injuries", h.listInjuries)
+		p.PATCH("/:id/injuries/:injury_id", h.updateInjury)
+	}
+}
+
+type setAvailabilityRequest struct {
+	Status string  `json:"status"`
+	Reason *string `json:"reason"`
+}
+
+func (h *AvailabilityHandler) set(c *gin.Context) {
+	gameID, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+	playerID, ok := parseIDParam(c, "player_id")
+	if !ok {
+		return
+	}
+	var req setAvailabilityRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.WriteError(c, service.ErrInvalidInput)
+		return
+	}
+	out, err := h.svc.SetAvailability(c.Request.Context(), gameID, playerID, req.Status, req.Reason)
+	if err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	response.WriteData(c, http.StatusOK, out)
+}
+
+func (h *AvailabilityHandler) listByGame(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+	items, err := h.svc.ListGameAvailability(c.Request.Context(), id)
+	if err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	response.WriteData(c, http.StatusOK, items)
+}
+
+func (h *AvailabilityHandler) clear(c *gin.Context) {
+	gameID, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+	playerID, ok := parseIDParam(c, "player_id")
+	if !ok {
+		return
+	}
+	if err := h.svc.ClearAvailability(c.Request.Context(), gameID, playerID); err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// injuryRequest carries calendar dates as YYYY-MM-DD strings, like transfers.
+type injuryRequest struct {
+	Description        *string `json:"description"`
+	StartDate          string  `json:"start_date"`
+	ExpectedReturnDate *string `json:"expected_return_date"`
+	ReturnedOn         *string `json:"returned_on"`
+}
+
+// parseDates converts the optional dates of the request, collecting a field error for each malformed one.
+func (req injuryRequest) parseDates() (expected, returned *time.Time, err error) {
+	var ferrs []service.FieldError
+	parse := func(v *string, field string) *time.Time {
+		if v == nil {
+			return nil
+		}
+		d, perr := time.Parse(dateLayout, strings.TrimSpace(*v))
+		if perr != nil {
+			ferrs = append(ferrs, service.FieldError{Field: field, Message: "must be a date in YYYY-MM-DD format"})
+			return nil
+		}
+		return &d
+	}
+	expected = parse(req.ExpectedReturnDate, "expected_return_date")
+	returned = parse(req.ReturnedOn, "returned_on")
+	return expected, returned, service.NewInvalidInputError(ferrs)
+}
+
+func (h *AvailabilityHandler) recordInjury(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+	var req injuryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.WriteError(c, service.ErrInvalidInput)
+		return
+	}
+	start, err := time.Parse(dateLayout, strings.TrimSpace(req.StartDate))
+	if err != nil {
+		response.WriteError(c, service.NewInvalidInputError([]service.FieldError{{Field: "start_date", Message: "must be a date in YYYY-MM-DD format"}}))
+		return
+	}
+	expected, returned, err := req.parseDates()
+	if err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	inj := model.Injury{PlayerID: id, StartDate: start, ExpectedReturnDate: expected, ReturnedOn: returned}
+	if req.Description != nil {
+		inj.Description = *req.Description
+	}
+	out, err := h.svc.RecordInjury(c.Request.Context(), inj)
+	if err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	response.WriteData(c, http.StatusCreated, out)
+}
+
+func (h *AvailabilityHandler) listInjuries(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+	items, err := h.svc.ListInjuries(c.Request.Context(), id)
+	if err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	response.WriteData(c, http.StatusOK, items)
+}
+
+// updateInjury takes the description, expected_return_date and returned_on; the start date is fixed.
+func (h *AvailabilityHandler) updateInjury(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+	injuryID, ok := parseIDParam(c, "injury_id")
+	if !ok {
+		return
+	}
+	var req injuryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.WriteError(c, service.ErrInvalidInput)
+		return
+	}
+	expected, returned, err := req.parseDates()
+	if err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	out, err := h.svc.UpdateInjury(c.Request.Context(), id, injuryID, model.InjuryPatch{
+		Description:        req.Description,
+		ExpectedReturnDate: expected,
+		ReturnedOn:         returned,
+	})
+	if err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	response.WriteData(c, http.StatusOK, out)
+}
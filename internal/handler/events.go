@@ -0,0 +1,128 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/maxviazov/basketball-stats-service/internal/model"
+	"github.com/maxviazov/basketball-stats-service/internal/service"
+	"github.com/maxviazov/basketball-stats-service/pkg/response"
+)
+
+type EventHandler struct {
+	svc service.EventService
+}
+
+func NewEventHandler(svc service.EventService) *EventHandler { return &EventHandler{svc: svc} }
+
+func (h *EventHandler) Register(r *gin.RouterGroup) {
+	// Play-by-play lives under the game: /api/v1/games/:id/events
+	g := r.Group("/games")
+	{
+		g.POST("/:id/events", h.create)
+		g.GET("/:id/events", h.list)
+		g.PUT("/:id/events/:event_id", h.correct)
+		g.DELETE("/:id/events/:event_id", h.delete)
+	}
+}
+
+type gameEventRequest struct {
+	Period       int    `json:"period"`
+	ClockSeconds int    `json:"clock_seconds"`
+	TeamID       int64  `json:"team_id"`
+	PlayerID     int64  `json:"player_id"`
+	EventType    string `json:"event_type"`
+}
+
+func (r gameEventRequest) toModel(gameID int64) model.GameEvent {
+	return model.GameEvent{
+		GameID:       gameID,
+		Period:       r.Period,
+		ClockSeconds: r.ClockSeconds,
+		TeamID:       r.TeamID,
+		PlayerID:     r.PlayerID,
+		EventType:    r.EventType,
+	}
+}
+
+func (h *EventHandler) create(c *gin.Context) {
+	gameID, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+	var req gameEventRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.WriteError(c, service.ErrInvalidInput)
+		return
+	}
+	ev, err := h.svc.RecordEvent(c.Request.Context(), req.toModel(gameID))
+	if err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	response.WriteData(c, http.StatusCreated, ev)
+}
+
+func (h *EventHandler) list(c *gin.Context) {
+	gameID, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+	events, err := h.svc.ListEvents(c.Request.Context(), gameID)
+	if err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	response.WriteData(c, http.StatusOK, events)
+}
+
+func (h *EventHandler) correct(c *gin.Context) {
+	gameID, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+	eventID, ok := parseIDParam(c, "event_id")
+	if !ok {
+		return
+	}
+	var req gameEventRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.WriteError(c, service.ErrInvalidInput)
+		return
+	}
+	e := req.toModel(gameID)
+	e.ID = eventID
+	ev, err := h.svc.CorrectEvent(c.Request.Context(), e)
+	if err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	response.WriteData(c, http.StatusOK, ev)
+}
+
+func (h *EventHandler) delete(c *gin.Context) {
+	gameID, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+	eventID, ok := parseIDParam(c, "event_id")
+	if !ok {
+		return
+	}
+	if err := h.svc.DeleteEvent(c.Request.Context(), gameID, eventID); err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// parseIDParam reads a positive integer path parameter, writing a 400 and returning false when it is malformed.
+func parseIDParam(c *gin.Context, name string) (int64, bool) {
+	id, err := strconv.ParseInt(c.Param(name), 10, 64)
+	if err != nil || id <= 0 {
+		response.WriteError(c, service.NewInvalidInputError([]service.FieldError{{Field: name, Message: "must be a valid integer > 0"}}))
+		return 0, false
+	}
+	return id, true
+}
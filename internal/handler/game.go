@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/maxviazov/basketball-stats-service/internal/model"
 	"github.com/maxviazov/basketball-stats-service/internal/repository"
 	"github.com/maxviazov/basketball-stats-service/internal/service"
 	"github.com/maxviazov/basketball-stats-service/pkg/response"
@@ -23,15 +24,27 @@ func (h *GameHandler) Register(r *gin.RouterGroup) {
 		g.POST("", h.create)
 		g.GET(":id", h.getByID)
 		g.GET("", h.list)
+		g.PATCH("/:id", h.update)
+		g.DELETE("/:id", h.delete)
+		g.POST("/:id/restore", h.restore)
+		g.POST("/:id/transitions", h.transition)
+		g.GET("/:id/transitions", h.listTransitions)
+		g.PUT("/:id/score", h.updateScore)
+		g.GET("/:id/score/reconciliation", h.reconcileScore)
+		g.GET("/:id/boxscore", h.boxScore)
 	}
 }
 
 type createGameRequest struct {
-	Season   string `json:"season"`
-	Date     string `json:"date"` // RFC3339
-	HomeTeam int64  `json:"home_team_id"`
-	AwayTeam int64  `json:"away_team_id"`
-	Status   string `json:"status"`
+	Season      string `json:"season"`
+	Phase       string `json:"phase"` // preseason, regular (default) or playoffs
+	Date        string `json:"date"`  // RFC3339
+	HomeTeam    int64  `json:"home_team_id"`
+	AwayTeam    int64  `json:"away_team_id"`
+	Status      string `json:"status"`          // scheduled, or in_progress/finished to import a game; recorded as transitions
+	RuleProfile int64  `json:"rule_profile_id"` // optional, the season's profile by default
+	Venue       *int64 `json:"venue_id"`        // optional, the home team's venue by default
+	Attendance  *int   `json:"attendance"`
 }
 
 func (h *GameHandler) create(c *gin.Context) {
@@ -45,7 +58,17 @@ func (h *GameHandler) create(c *gin.Context) {
 		response.WriteError(c, service.ErrInvalidInput)
 		return
 	}
-	game, err := h.svc.CreateGame(c.Request.Context(), req.Season, parsedDate, req.HomeTeam, req.AwayTeam, req.Status)
+	game, err := h.svc.CreateGame(c.Request.Context(), model.Game{
+		Season:        req.Season,
+		Phase:         req.Phase,
+		Date:          parsedDate,
+		HomeTeamID:    req.HomeTeam,
+		AwayTeamID:    req.AwayTeam,
+		Status:        req.Status,
+		RuleProfileID: req.RuleProfile,
+		VenueID:       req.Venue,
+		Attendance:    req.Attendance,
+	})
 	if err != nil {
 		response.WriteError(c, err)
 		return
@@ -63,6 +86,100 @@ func (h *GameHandler) getByID(c *gin.Context) {
 	response.WriteData(c, http.StatusOK, game)
 }
 
+type updateGameRequest struct {
+	Date       *string `json:"date"` // RFC3339
+	Phase      *string `json:"phase"`
+	Venue      *int64  `json:"venue_id"`
+	Attendance *int    `json:"attendance"`
+}
+
+func (h *GameHandler) update(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+	var req updateGameRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.WriteError(c, service.ErrInvalidInput)
+		return
+	}
+	patch := model.GamePatch{Phase: req.Phase, VenueID: req.Venue, Attendance: req.Attendance}
+	if req.Date != nil {
+		parsedDate, err := time.Parse(time.RFC3339, *req.Date)
+		if err != nil {
+			response.WriteError(c, service.NewInvalidInputError([]service.FieldError{{Field: "date", Message: "must be an RFC3339 timestamp"}}))
+			return
+		}
+		patch.Date = &parsedDate
+	}
+	game, err := h.svc.UpdateGame(c.Request.Context(), id, patch)
+	if err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	response.WriteData(c, http.StatusOK, game)
+}
+
+func (h *GameHandler) delete(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+	if err := h.svc.DeleteGame(c.Request.Context(), id); err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func (h *GameHandler) restore(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+	game, err := h.svc.RestoreGame(c.Request.Context(), id)
+	if err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	response.WriteData(c, http.StatusOK, game)
+}
+
+type transitionGameRequest struct {
+	Status string `json:"status"`
+}
+
+func (h *GameHandler) transition(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+	var req transitionGameRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.WriteError(c, service.ErrInvalidInput)
+		return
+	}
+	tr, err := h.svc.TransitionGame(c.Request.Context(), id, req.Status)
+	if err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	response.WriteData(c, http.StatusCreated, tr)
+}
+
+func (h *GameHandler) listTransitions(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+	items, err := h.svc.ListGameTransitions(c.Request.Context(), id)
+	if err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	response.WriteData(c, http.StatusOK, items)
+}
+
 func (h *GameHandler) list(c *gin.Context) {
 	limit, _ := strconv.Atoi(c.Query("limit"))
 	offset, _ := strconv.Atoi(c.Query("offset"))
@@ -74,3 +191,66 @@ func (h *GameHandler) list(c *gin.Context) {
 	}
 	response.WriteData(c, http.StatusOK, res)
 }
+
+type periodScoreRequest struct {
+	Period     int `json:"period"`
+	HomePoints int `json:"home_points"`
+	AwayPoints int `json:"away_points"`
+}
+
+type updateScoreRequest struct {
+	HomeScore int                  `json:"home_score"`
+	AwayScore int                  `json:"away_score"`
+	Periods   []periodScoreRequest `json:"periods"`
+}
+
+func (h *GameHandler) updateScore(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.WriteError(c, service.NewInvalidInputError([]service.FieldError{{Field: "id", Message: "must be a valid integer"}}))
+		return
+	}
+	var req updateScoreRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.WriteError(c, service.ErrInvalidInput)
+		return
+	}
+	score := model.GameScore{HomeScore: req.HomeScore, AwayScore: req.AwayScore}
+	for _, p := range req.Periods {
+		score.Periods = append(score.Periods, model.PeriodScore{Period: p.Period, HomePoints: p.HomePoints, AwayPoints: p.AwayPoints})
+	}
+	game, err := h.svc.UpdateScore(c.Request.Context(), id, score)
+	if err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	response.WriteData(c, http.StatusOK, game)
+}
+
+func (h *GameHandler) reconcileScore(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.WriteError(c, service.NewInvalidInputError([]service.FieldError{{Field: "id", Message: "must be a valid integer"}}))
+		return
+	}
+	rec, err := h.svc.ReconcileScore(c.Request.Context(), id)
+	if err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	response.WriteData(c, http.StatusOK, rec)
+}
+
+func (h *GameHandler) boxScore(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.WriteError(c, service.NewInvalidInputError([]service.FieldError{{Field: "id", Message: "must be a valid integer"}}))
+		return
+	}
+	box, err := h.svc.GetBoxScore(c.Request.Context(), id)
+	if err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	response.WriteData(c, http.StatusOK, box)
+}
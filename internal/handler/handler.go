@@ -5,9 +5,33 @@ import (
 	"github.com/maxviazov/basketball-stats-service/internal/service"
 )
 
+// Services groups the service layer dependencies behind the public API.
+// Any of them may be nil in tests; the routes are still mounted, they just must not be called.
+type Services struct {
+	Leagues      service.LeagueService
+	Teams        service.TeamService
+	Players      service.PlayerService
+	Seasons      service.SeasonService
+	RuleProfiles service.RuleProfileService
+	Venues       service.VenueService
+	Officials    service.OfficialService
+	Staff        service.StaffService
+	Games        service.GameService
+	Stats        service.StatsService
+	Availability service.AvailabilityService
+	Events       service.EventService
+	Lineups      service.LineupService
+	Shots        service.ShotService
+	Metrics      service.MetricsService
+	Standings    service.StandingsService
+	Leaders      service.LeadersService
+	Achievements service.AchievementService
+	Search       service.SearchService
+}
+
 // Register mounts all public routes on the given engine.
 // Accepts service layer dependencies for API endpoints.
-func Register(r *gin.Engine, repo Pinger, teamSvc service.TeamService, playerSvc service.PlayerService, gameSvc service.GameService, statsSvc service.StatsService) {
+func Register(r *gin.Engine, repo Pinger, svcs Services) {
 	h := NewHealthHandler(repo)
 
 	// Health probes
@@ -24,9 +48,33 @@ func Register(r *gin.Engine, repo Pinger, teamSvc service.TeamService, playerSvc
 			health.GET("/live", h.Liveness)
 			health.GET("/ready", h.Readiness)
 		}
-		NewTeamHandler(teamSvc).Register(api)
-		NewPlayerHandler(playerSvc).Register(api)
-		NewGameHandler(gameSvc).Register(api)
-		NewStatsHandler(statsSvc).Register(api)
+		leagues := NewLeagueHandler(svcs.Leagues)
+		leagues.Register(api)
+		NewRuleProfileHandler(svcs.RuleProfiles).Register(api)
+
+		// Unscoped routes address the default league; /leagues/:league_id/... addresses any league.
+		registerLeagueRoutes(api, svcs)
+		registerLeagueRoutes(api.Group("/leagues/:league_id", leagues.Scope()), svcs)
 	}
 }
+
+// registerLeagueRoutes mounts every route whose data belongs to a league.
+func registerLeagueRoutes(r *gin.RouterGroup, svcs Services) {
+	NewTeamHandler(svcs.Teams).Register(r)
+	NewPlayerHandler(svcs.Players).Register(r)
+	NewSeasonHandler(svcs.Seasons).Register(r)
+	NewVenueHandler(svcs.Venues).Register(r)
+	NewGameHandler(svcs.Games).Register(r)
+	NewOfficialHandler(svcs.Officials).Register(r)
+	NewStaffHandler(svcs.Staff).Register(r)
+	NewStatsHandler(svcs.Stats).Register(r)
+	NewAvailabilityHandler(svcs.Availability).Register(r)
+	NewEventHandler(svcs.Events).Register(r)
+	NewLineupHandler(svcs.Lineups).Register(r)
+	NewShotHandler(svcs.Shots).Register(r)
+	NewMetricsHandler(svcs.Metrics).Register(r)
+	NewStandingsHandler(svcs.Standings).Register(r)
+	NewLeadersHandler(svcs.Leaders).Register(r)
+	NewAchievementHandler(svcs.Achievements).Register(r)
+	NewSearchHandler(svcs.Search).Register(r)
+}
@@ -0,0 +1,50 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/maxviazov/basketball-stats-service/internal/service"
+	"github.com/maxviazov/basketball-stats-service/pkg/response"
+)
+
+type LeadersHandler struct {
+	svc service.LeadersService
+}
+
+func NewLeadersHandler(svc service.LeadersService) *LeadersHandler { return &LeadersHandler{svc: svc} }
+
+func (h *LeadersHandler) Register(r *gin.RouterGroup) {
+	r.GET("/leaders", h.get)
+}
+
+// get serves /leaders?stat=points&per=game&season=YYYY-YY&limit=10.
+func (h *LeadersHandler) get(c *gin.Context) {
+	var season, phase *string
+	if v := c.Query("season"); v != "" {
+		season = &v
+	}
+	if v := c.Query("phase"); v != "" {
+		phase = &v
+	}
+	limit := 0
+	if v := c.Query("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			response.WriteError(c, service.NewInvalidInputError([]service.FieldError{{Field: "limit", Message: "must be a valid integer"}}))
+			return
+		}
+		limit = n
+	}
+	ctx, cancel := context.WithTimeout(c.Request.Context(), serviceTimeout)
+	defer cancel()
+
+	board, err := h.svc.GetLeaders(ctx, c.Query("stat"), c.Query("per"), season, phase, limit)
+	if err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	response.WriteData(c, http.StatusOK, board)
+}
@@ -0,0 +1,87 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/maxviazov/basketball-stats-service/internal/repository"
+	"github.com/maxviazov/basketball-stats-service/internal/service"
+	"github.com/maxviazov/basketball-stats-service/pkg/response"
+)
+
+type LeagueHandler struct {
+	svc service.LeagueService
+}
+
+func NewLeagueHandler(svc service.LeagueService) *LeagueHandler { return &LeagueHandler{svc: svc} }
+
+func (h *LeagueHandler) Register(r *gin.RouterGroup) {
+	g := r.Group("/leagues")
+	{
+		g.POST("", h.create)
+		g.GET("", h.list)
+		g.GET("/:league_id", h.getByID)
+	}
+}
+
+// Scope resolves :league_id and scopes the request context to that league, so every repository call made
+// while serving the request filters by it. An unknown league is a 404 before any handler runs.
+func (h *LeagueHandler) Scope() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := parseIDParam(c, "league_id")
+		if !ok {
+			c.Abort()
+			return
+		}
+		if _, err := h.svc.GetLeague(c.Request.Context(), id); err != nil {
+			response.WriteError(c, err)
+			c.Abort()
+			return
+		}
+		c.Request = c.Request.WithContext(repository.WithLeague(c.Request.Context(), id))
+		c.Next()
+	}
+}
+
+type createLeagueRequest struct {
+	Name string `json:"name"`
+}
+
+func (h *LeagueHandler) create(c *gin.Context) {
+	var req createLeagueRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.WriteError(c, service.ErrInvalidInput)
+		return
+	}
+	out, err := h.svc.CreateLeague(c.Request.Context(), req.Name)
+	if err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	response.WriteData(c, http.StatusCreated, out)
+}
+
+func (h *LeagueHandler) getByID(c *gin.Context) {
+	id, ok := parseIDParam(c, "league_id")
+	if !ok {
+		return
+	}
+	out, err := h.svc.GetLeague(c.Request.Context(), id)
+	if err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	response.WriteData(c, http.StatusOK, out)
+}
+
+func (h *LeagueHandler) list(c *gin.Context) {
+	limit, _ := strconv.Atoi(c.Query("limit"))
+	offset, _ := strconv.Atoi(c.Query("offset"))
+	res, err := h.svc.ListLeagues(c.Request.Context(), repository.Page{Limit: limit, Offset: offset})
+	if err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	response.WriteData(c, http.StatusOK, res)
+}
@@ -0,0 +1,147 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/maxviazov/basketball-stats-service/internal/model"
+	"github.com/maxviazov/basketball-stats-service/internal/service"
+	"github.com/maxviazov/basketball-stats-service/pkg/response"
+)
+
+type LineupHandler struct {
+	svc service.LineupService
+}
+
+func NewLineupHandler(svc service.LineupService) *LineupHandler { return &LineupHandler{svc: svc} }
+
+func (h *LineupHandler) Register(r *gin.RouterGroup) {
+	g := r.Group("/games")
+	{
+		g.GET("/:id/starters", h.listStarters)
+		g.PUT("/:id/starters/:team_id", h.setStarters)
+		g.POST("/:id/stints", h.recordStint)
+		g.GET("/:id/stints", h.listStints)
+		g.DELETE("/:id/stints/:stint_id", h.deleteStint)
+	}
+	r.Group("/teams").GET("/:team_id/lineups", h.listTeamLineups)
+}
+
+type startersRequest struct {
+	PlayerIDs []int64 `json:"player_ids"`
+}
+
+func (h *LineupHandler) setStarters(c *gin.Context) {
+	gameID, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+	teamID, ok := parseIDParam(c, "team_id")
+	if !ok {
+		return
+	}
+	var req startersRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.WriteError(c, service.ErrInvalidInput)
+		return
+	}
+	out, err := h.svc.SetStarters(c.Request.Context(), gameID, teamID, req.PlayerIDs)
+	if err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	response.WriteData(c, http.StatusOK, out)
+}
+
+func (h *LineupHandler) listStarters(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+	items, err := h.svc.ListStarters(c.Request.Context(), id)
+	if err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	response.WriteData(c, http.StatusOK, items)
+}
+
+type stintRequest struct {
+	TeamID     int64   `json:"team_id"`
+	Period     int     `json:"period"`
+	StartClock int     `json:"start_clock"`
+	EndClock   int     `json:"end_clock"`
+	PlayerIDs  []int64 `json:"player_ids"`
+}
+
+func (h *LineupHandler) recordStint(c *gin.Context) {
+	gameID, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+	var req stintRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.WriteError(c, service.ErrInvalidInput)
+		return
+	}
+	out, err := h.svc.RecordStint(c.Request.Context(), model.LineupStint{
+		GameID:     gameID,
+		TeamID:     req.TeamID,
+		Period:     req.Period,
+		StartClock: req.StartClock,
+		EndClock:   req.EndClock,
+		PlayerIDs:  req.PlayerIDs,
+	})
+	if err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	response.WriteData(c, http.StatusCreated, out)
+}
+
+func (h *LineupHandler) listStints(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+	items, err := h.svc.ListStints(c.Request.Context(), id)
+	if err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	response.WriteData(c, http.StatusOK, items)
+}
+
+func (h *LineupHandler) deleteStint(c *gin.Context) {
+	gameID, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+	stintID, ok := parseIDParam(c, "stint_id")
+	if !ok {
+		return
+	}
+	if err := h.svc.DeleteStint(c.Request.Context(), gameID, stintID); err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// listTeamLineups serves /teams/:team_id/lineups?season=YYYY-YY; the season is mandatory.
+func (h *LineupHandler) listTeamLineups(c *gin.Context) {
+	id, ok := parseIDParam(c, "team_id")
+	if !ok {
+		return
+	}
+	ctx, cancel := context.WithTimeout(c.Request.Context(), serviceTimeout)
+	defer cancel()
+
+	items, err := h.svc.ListTeamLineups(ctx, id, c.Query("season"))
+	if err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	response.WriteData(c, http.StatusOK, items)
+}
@@ -0,0 +1,37 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/maxviazov/basketball-stats-service/internal/service"
+	"github.com/maxviazov/basketball-stats-service/pkg/response"
+)
+
+type MetricsHandler struct {
+	svc service.MetricsService
+}
+
+func NewMetricsHandler(svc service.MetricsService) *MetricsHandler { return &MetricsHandler{svc: svc} }
+
+func (h *MetricsHandler) Register(r *gin.RouterGroup) {
+	r.Group("/players").GET("/:id/advanced", h.getPlayerAdvanced)
+}
+
+// getPlayerAdvanced serves /players/:id/advanced?season=YYYY-YY; the season is mandatory since PER is league-relative.
+func (h *MetricsHandler) getPlayerAdvanced(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+	ctx, cancel := context.WithTimeout(c.Request.Context(), serviceTimeout)
+	defer cancel()
+
+	stats, err := h.svc.GetPlayerAdvancedStats(ctx, id, c.Query("season"))
+	if err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	response.WriteData(c, http.StatusOK, stats)
+}
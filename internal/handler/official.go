@@ -0,0 +1,169 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/maxviazov/basketball-stats-service/internal/repository"
+	"github.com/maxviazov/basketball-stats-service/internal/service"
+	"github.com/maxviazov/basketball-stats-service/pkg/response"
+)
+
+type OfficialHandler struct {
+	svc service.OfficialService
+}
+
+func NewOfficialHandler(svc service.OfficialService) *OfficialHandler {
+	return &OfficialHandler{svc: svc}
+}
+
+func (h *OfficialHandler) Register(r *gin.RouterGroup) {
+	o := r.Group("/officials")
+	{
+		o.POST("", h.create)
+		o.GET("", h.list)
+		o.GET("/:id", h.getByID)
+		o.GET("/:id/games", h.listGames)
+		o.GET("/:id/summary", h.summary)
+	}
+	// The crew lives under the game: /api/v1/games/:id/officials
+	g := r.Group("/games")
+	{
+		g.GET("/:id/officials", h.listCrew)
+		g.PUT("/:id/officials/:official_id", h.assign)
+		g.DELETE("/:id/officials/:official_id", h.unassign)
+	}
+}
+
+type createOfficialRequest struct {
+	FirstName string `json:"first_name"`
+	LastName  string `json:"last_name"`
+}
+
+func (h *OfficialHandler) create(c *gin.Context) {
+	var req createOfficialRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.WriteError(c, service.ErrInvalidInput)
+		return
+	}
+	out, err := h.svc.CreateOfficial(c.Request.Context(), req.FirstName, req.LastName)
+	if err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	response.WriteData(c, http.StatusCreated, out)
+}
+
+func (h *OfficialHandler) getByID(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+	out, err := h.svc.GetOfficial(c.Request.Context(), id)
+	if err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	response.WriteData(c, http.StatusOK, out)
+}
+
+func (h *OfficialHandler) list(c *gin.Context) {
+	limit, _ := strconv.Atoi(c.Query("limit"))
+	offset, _ := strconv.Atoi(c.Query("offset"))
+	res, err := h.svc.ListOfficials(c.Request.Context(), repository.Page{Limit: limit, Offset: offset})
+	if err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	response.WriteData(c, http.StatusOK, res)
+}
+
+// listGames serves /officials/:id/games, newest first, each game with the role the official had.
+func (h *OfficialHandler) listGames(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+	limit, _ := strconv.Atoi(c.Query("limit"))
+	offset, _ := strconv.Atoi(c.Query("offset"))
+	res, err := h.svc.ListOfficialGames(c.Request.Context(), id, repository.Page{Limit: limit, Offset: offset})
+	if err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	response.WriteData(c, http.StatusOK, res)
+}
+
+// summary serves /officials/:id/summary?[season=].
+func (h *OfficialHandler) summary(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+	var season *string
+	if v := c.Query("season"); v != "" {
+		season = &v
+	}
+	out, err := h.svc.GetOfficialSummary(c.Request.Context(), id, season)
+	if err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	response.WriteData(c, http.StatusOK, out)
+}
+
+func (h *OfficialHandler) listCrew(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+	items, err := h.svc.ListGameOfficials(c.Request.Context(), id)
+	if err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	response.WriteData(c, http.StatusOK, items)
+}
+
+type assignOfficialRequest struct {
+	Role string `json:"role"`
+}
+
+func (h *OfficialHandler) assign(c *gin.Context) {
+	gameID, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+	officialID, ok := parseIDParam(c, "official_id")
+	if !ok {
+		return
+	}
+	var req assignOfficialRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.WriteError(c, service.ErrInvalidInput)
+		return
+	}
+	out, err := h.svc.AssignOfficial(c.Request.Context(), gameID, officialID, req.Role)
+	if err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	response.WriteData(c, http.StatusOK, out)
+}
+
+func (h *OfficialHandler) unassign(c *gin.Context) {
+	gameID, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+	officialID, ok := parseIDParam(c, "official_id")
+	if !ok {
+		return
+	}
+	if err := h.svc.UnassignOfficial(c.Request.Context(), gameID, officialID); err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
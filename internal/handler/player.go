@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/maxviazov/basketball-stats-service/internal/model"
 	"github.com/maxviazov/basketball-stats-service/internal/repository"
 	"github.com/maxviazov/basketball-stats-service/internal/service"
 	"github.com/maxviazov/basketball-stats-service/pkg/response"
@@ -16,12 +17,40 @@ import (
 
 const serviceTimeout = 5 * time.Second
 
+// dateLayout is the format of calendar dates (no time of day) in query parameters and request bodies.
+const dateLayout = "2006-01-02"
+
+// parseDateQuery reads an optional YYYY-MM-DD query parameter; it writes a 400 and returns false when malformed.
+func parseDateQuery(c *gin.Context, name string) (*time.Time, bool) {
+	v := strings.TrimSpace(c.Query(name))
+	if v == "" {
+		return nil, true
+	}
+	d, err := time.Parse(dateLayout, v)
+	if err != nil {
+		response.WriteError(c, service.NewInvalidInputError([]service.FieldError{{Field: name, Message: "must be a date in YYYY-MM-DD format"}}))
+		return nil, false
+	}
+	return &d, true
+}
+
 // parseBoolQuery is a helper to flexibly parse boolean-like query parameters.
 func parseBoolQuery(s string) bool {
 	s = strings.ToLower(strings.TrimSpace(s))
 	return s == "true" || s == "1"
 }
 
+// parseListQuery reads an optional comma-separated query parameter; empty items are dropped.
+func parseListQuery(c *gin.Context, name string) []string {
+	var out []string
+	for _, v := range strings.Split(c.Query(name), ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
 type PlayerHandler struct {
 	svc service.PlayerService
 }
@@ -33,7 +62,13 @@ func (h *PlayerHandler) Register(r *gin.RouterGroup) {
 	{
 		g.POST("", h.create)
 		g.GET("/:id", h.getByID)
+		g.PATCH("/:id", h.update)
+		g.DELETE("/:id", h.delete)
+		g.POST("/:id/restore", h.restore)
 		g.GET("/:id/aggregates", h.getAggregatedStats)
+		g.GET("/:id/splits", h.getSplits)
+		g.POST("/:id/transfers", h.transfer)
+		g.GET("/:id/transfers", h.listMemberships)
 		// Compatibility alias: keep alternative path style without breaking current contract
 		g.GET("/:id/stats/aggregate", h.getAggregatedStats)
 	}
@@ -46,6 +81,34 @@ type createPlayerRequest struct {
 	FirstName string `json:"first_name"`
 	LastName  string `json:"last_name"`
 	Position  string `json:"position"`
+	playerBioRequest
+}
+
+// playerBioRequest carries the optional bio of create and update requests; the birth date is YYYY-MM-DD.
+type playerBioRequest struct {
+	JerseyNumber       *string   `json:"jersey_number"`
+	HeightCm           *int      `json:"height_cm"`
+	WeightKg           *int      `json:"weight_kg"`
+	BirthDate          *string   `json:"birth_date"`
+	Nationality        *string   `json:"nationality"`
+	Handedness         *string   `json:"handedness"`
+	SecondaryPositions *[]string `json:"secondary_positions"`
+}
+
+// birthDate parses the birth date; an empty string yields the zero date, which clears it on update.
+func (req playerBioRequest) birthDate() (*time.Time, error) {
+	if req.BirthDate == nil {
+		return nil, nil
+	}
+	v := strings.TrimSpace(*req.BirthDate)
+	if v == "" {
+		return &time.Time{}, nil
+	}
+	d, err := time.Parse(dateLayout, v)
+	if err != nil {
+		return nil, service.NewInvalidInputError([]service.FieldError{{Field: "birth_date", Message: "must be a date in YYYY-MM-DD format"}})
+	}
+	return &d, nil
 }
 
 func (h *PlayerHandler) create(c *gin.Context) {
@@ -54,7 +117,23 @@ func (h *PlayerHandler) create(c *gin.Context) {
 		response.WriteError(c, service.ErrInvalidInput)
 		return
 	}
-	player, err := h.svc.CreatePlayer(c.Request.Context(), req.TeamID, req.FirstName, req.LastName, req.Position)
+	birthDate, err := req.birthDate()
+	if err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	bio := model.PlayerBio{
+		JerseyNumber: req.JerseyNumber,
+		HeightCm:     req.HeightCm,
+		WeightKg:     req.WeightKg,
+		BirthDate:    birthDate,
+		Nationality:  req.Nationality,
+		Handedness:   req.Handedness,
+	}
+	if req.SecondaryPositions != nil {
+		bio.SecondaryPositions = *req.SecondaryPositions
+	}
+	player, err := h.svc.CreatePlayer(c.Request.Context(), req.TeamID, req.FirstName, req.LastName, req.Position, bio)
 	if err != nil {
 		response.WriteError(c, err)
 		return
@@ -77,6 +156,72 @@ func (h *PlayerHandler) getByID(c *gin.Context) {
 	response.WriteData(c, http.StatusOK, player)
 }
 
+type updatePlayerRequest struct {
+	FirstName *string `json:"first_name"`
+	LastName  *string `json:"last_name"`
+	Position  *string `json:"position"`
+	playerBioRequest
+}
+
+func (h *PlayerHandler) update(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+	var req updatePlayerRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.WriteError(c, service.ErrInvalidInput)
+		return
+	}
+	birthDate, err := req.birthDate()
+	if err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	player, err := h.svc.UpdatePlayer(c.Request.Context(), id, model.PlayerPatch{
+		FirstName:          req.FirstName,
+		LastName:           req.LastName,
+		Position:           req.Position,
+		JerseyNumber:       req.JerseyNumber,
+		HeightCm:           req.HeightCm,
+		WeightKg:           req.WeightKg,
+		BirthDate:          birthDate,
+		Nationality:        req.Nationality,
+		Handedness:         req.Handedness,
+		SecondaryPositions: req.SecondaryPositions,
+	})
+	if err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	response.WriteData(c, http.StatusOK, player)
+}
+
+func (h *PlayerHandler) delete(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+	if err := h.svc.DeletePlayer(c.Request.Context(), id); err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func (h *PlayerHandler) restore(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+	player, err := h.svc.RestorePlayer(c.Request.Context(), id)
+	if err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	response.WriteData(c, http.StatusOK, player)
+}
+
 func (h *PlayerHandler) listByTeam(c *gin.Context) {
 	idStr := c.Param("team_id")
 	teamID, err := strconv.ParseInt(strings.TrimSpace(idStr), 10, 64)
@@ -84,11 +229,56 @@ func (h *PlayerHandler) listByTeam(c *gin.Context) {
 		response.WriteError(c, service.NewInvalidInputError([]service.FieldError{{Field: "team_id", Message: "must be a valid integer"}}))
 		return
 	}
+	asOf, ok := parseDateQuery(c, "as_of")
+	if !ok {
+		return
+	}
 	// Atoi errors are ignored intentionally, as 0 is a valid default for limit/offset, handled by the service layer.
 	limit, _ := strconv.Atoi(c.Query("limit"))
 	offset, _ := strconv.Atoi(c.Query("offset"))
 	page := repository.Page{Limit: limit, Offset: offset}
-	res, err := h.svc.ListPlayersByTeam(c.Request.Context(), teamID, page)
+	res, err := h.svc.ListPlayersByTeam(c.Request.Context(), teamID, asOf, page)
+	if err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	response.WriteData(c, http.StatusOK, res)
+}
+
+type transferRequest struct {
+	TeamID int64  `json:"team_id"`
+	Date   string `json:"date"` // YYYY-MM-DD, first day with the new team
+}
+
+func (h *PlayerHandler) transfer(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+	var req transferRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.WriteError(c, service.ErrInvalidInput)
+		return
+	}
+	on, err := time.Parse(dateLayout, strings.TrimSpace(req.Date))
+	if err != nil {
+		response.WriteError(c, service.NewInvalidInputError([]service.FieldError{{Field: "date", Message: "must be a date in YYYY-MM-DD format"}}))
+		return
+	}
+	m, err := h.svc.TransferPlayer(c.Request.Context(), id, req.TeamID, on)
+	if err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	response.WriteData(c, http.StatusCreated, m)
+}
+
+func (h *PlayerHandler) listMemberships(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+	res, err := h.svc.ListMemberships(c.Request.Context(), id)
 	if err != nil {
 		response.WriteError(c, err)
 		return
@@ -124,11 +314,15 @@ func (h *PlayerHandler) getAggregatedStats(c *gin.Context) {
 	} else if parseBoolQuery(careerQuery) {
 		season = nil // Explicitly nil for career stats
 	}
+	var phase *string
+	if v := c.Query("phase"); v != "" {
+		phase = &v
+	}
 
 	ctx, cancel := context.WithTimeout(c.Request.Context(), serviceTimeout)
 	defer cancel()
 
-	stats, err := h.svc.GetPlayerAggregatedStats(ctx, id, season)
+	stats, err := h.svc.GetPlayerAggregatedStats(ctx, id, season, phase)
 
 	logger := log.With().
 		Str("path", c.Request.URL.Path).
@@ -147,3 +341,27 @@ func (h *PlayerHandler) getAggregatedStats(c *gin.Context) {
 	logger.Info().Int("status", http.StatusOK).Msg("player aggregates retrieved")
 	response.WriteData(c, http.StatusOK, stats)
 }
+
+// getSplits serves /players/:id/splits?[season=][&phase=][&by=location,result,month,opponent,rest].
+func (h *PlayerHandler) getSplits(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+	var season, phase *string
+	if v := c.Query("season"); v != "" {
+		season = &v
+	}
+	if v := c.Query("phase"); v != "" {
+		phase = &v
+	}
+	ctx, cancel := context.WithTimeout(c.Request.Context(), serviceTimeout)
+	defer cancel()
+
+	splits, err := h.svc.GetPlayerSplits(ctx, id, season, phase, parseListQuery(c, "by"))
+	if err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	response.WriteData(c, http.StatusOK, splits)
+}
@@ -0,0 +1,83 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/maxviazov/basketball-stats-service/internal/model"
+	"github.com/maxviazov/basketball-stats-service/internal/repository"
+	"github.com/maxviazov/basketball-stats-service/internal/service"
+	"github.com/maxviazov/basketball-stats-service/pkg/response"
+)
+
+type RuleProfileHandler struct {
+	svc service.RuleProfileService
+}
+
+func NewRuleProfileHandler(svc service.RuleProfileService) *RuleProfileHandler {
+	return &RuleProfileHandler{svc: svc}
+}
+
+func (h *RuleProfileHandler) Register(r *gin.RouterGroup) {
+	g := r.Group("/rule-profiles")
+	{
+		g.POST("", h.create)
+		g.GET("", h.list)
+		g.GET("/:id", h.getByID)
+	}
+}
+
+type createRuleProfileRequest struct {
+	Name            string `json:"name"`
+	PeriodMinutes   int    `json:"period_minutes"`
+	Periods         int    `json:"periods"`
+	OvertimeMinutes int    `json:"overtime_minutes"`
+	FoulLimit       int    `json:"foul_limit"`
+	RosterSize      int    `json:"roster_size"`
+}
+
+func (h *RuleProfileHandler) create(c *gin.Context) {
+	var req createRuleProfileRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.WriteError(c, service.ErrInvalidInput)
+		return
+	}
+	out, err := h.svc.CreateRuleProfile(c.Request.Context(), model.RuleProfile{
+		Name:            req.Name,
+		PeriodMinutes:   req.PeriodMinutes,
+		Periods:         req.Periods,
+		OvertimeMinutes: req.OvertimeMinutes,
+		FoulLimit:       req.FoulLimit,
+		RosterSize:      req.RosterSize,
+	})
+	if err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	response.WriteData(c, http.StatusCreated, out)
+}
+
+func (h *RuleProfileHandler) getByID(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+	out, err := h.svc.GetRuleProfile(c.Request.Context(), id)
+	if err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	response.WriteData(c, http.StatusOK, out)
+}
+
+func (h *RuleProfileHandler) list(c *gin.Context) {
+	limit, _ := strconv.Atoi(c.Query("limit"))
+	offset, _ := strconv.Atoi(c.Query("offset"))
+	res, err := h.svc.ListRuleProfiles(c.Request.Context(), repository.Page{Limit: limit, Offset: offset})
+	if err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	response.WriteData(c, http.StatusOK, res)
+}
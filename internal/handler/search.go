@@ -0,0 +1,37 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/maxviazov/basketball-stats-service/internal/repository"
+	"github.com/maxviazov/basketball-stats-service/internal/service"
+	"github.com/maxviazov/basketball-stats-service/pkg/response"
+)
+
+type SearchHandler struct {
+	svc service.SearchService
+}
+
+func NewSearchHandler(svc service.SearchService) *SearchHandler { return &SearchHandler{svc: svc} }
+
+func (h *SearchHandler) Register(r *gin.RouterGroup) {
+	r.GET("/search", h.search)
+}
+
+// search serves /search?q=[&type=team|player][&limit=&offset=].
+func (h *SearchHandler) search(c *gin.Context) {
+	var kind *string
+	if v := c.Query("type"); v != "" {
+		kind = &v
+	}
+	limit, _ := strconv.Atoi(c.Query("limit"))
+	offset, _ := strconv.Atoi(c.Query("offset"))
+	res, err := h.svc.Search(c.Request.Context(), c.Query("q"), kind, repository.Page{Limit: limit, Offset: offset})
+	if err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	response.WriteData(c, http.StatusOK, res)
+}
@@ -0,0 +1,113 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/maxviazov/basketball-stats-service/internal/model"
+	"github.com/maxviazov/basketball-stats-service/internal/repository"
+	"github.com/maxviazov/basketball-stats-service/internal/service"
+	"github.com/maxviazov/basketball-stats-service/pkg/response"
+)
+
+type SeasonHandler struct {
+	svc service.SeasonService
+}
+
+func NewSeasonHandler(svc service.SeasonService) *SeasonHandler { return &SeasonHandler{svc: svc} }
+
+func (h *SeasonHandler) Register(r *gin.RouterGroup) {
+	g := r.Group("/seasons")
+	{
+		g.POST("", h.create)
+		g.GET("", h.list)
+		g.GET("/:id", h.getByID)
+	}
+}
+
+type seasonPhaseRequest struct {
+	Phase     string `json:"phase"`
+	StartDate string `json:"start_date"` // YYYY-MM-DD
+	EndDate   string `json:"end_date"`   // YYYY-MM-DD
+}
+
+type createSeasonRequest struct {
+	Name          string               `json:"name"`
+	StartDate     string               `json:"start_date"`      // YYYY-MM-DD
+	EndDate       string               `json:"end_date"`        // YYYY-MM-DD
+	RuleProfileID int64                `json:"rule_profile_id"` // optional, NBA rules by default
+	Phases        []seasonPhaseRequest `json:"phases"`
+}
+
+// toModel parses the calendar dates of the request, reporting every malformed one at once.
+func (r createSeasonRequest) toModel() (model.Season, []service.FieldError) {
+	var ferrs []service.FieldError
+	parse := func(field, v string) time.Time {
+		d, err := time.Parse(dateLayout, strings.TrimSpace(v))
+		if err != nil {
+			ferrs = append(ferrs, service.FieldError{Field: field, Message: "must be a date in YYYY-MM-DD format"})
+		}
+		return d
+	}
+	out := model.Season{
+		Name:          r.Name,
+		StartDate:     parse("start_date", r.StartDate),
+		EndDate:       parse("end_date", r.EndDate),
+		RuleProfileID: r.RuleProfileID,
+	}
+	for _, p := range r.Phases {
+		out.Phases = append(out.Phases, model.SeasonPhase{
+			Phase:     p.Phase,
+			StartDate: parse("phases.start_date", p.StartDate),
+			EndDate:   parse("phases.end_date", p.EndDate),
+		})
+	}
+	return out, ferrs
+}
+
+func (h *SeasonHandler) create(c *gin.Context) {
+	var req createSeasonRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.WriteError(c, service.ErrInvalidInput)
+		return
+	}
+	season, ferrs := req.toModel()
+	if err := service.NewInvalidInputError(ferrs); err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	out, err := h.svc.CreateSeason(c.Request.Context(), season)
+	if err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	response.WriteData(c, http.StatusCreated, out)
+}
+
+func (h *SeasonHandler) getByID(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+	season, err := h.svc.GetSeason(c.Request.Context(), id)
+	if err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	response.WriteData(c, http.StatusOK, season)
+}
+
+func (h *SeasonHandler) list(c *gin.Context) {
+	// Atoi errors are ignored intentionally, as 0 is a valid default for limit/offset, handled by the service layer.
+	limit, _ := strconv.Atoi(c.Query("limit"))
+	offset, _ := strconv.Atoi(c.Query("offset"))
+	res, err := h.svc.ListSeasons(c.Request.Context(), repository.Page{Limit: limit, Offset: offset})
+	if err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	response.WriteData(c, http.StatusOK, res)
+}
@@ -0,0 +1,119 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/maxviazov/basketball-stats-service/internal/model"
+	"github.com/maxviazov/basketball-stats-service/internal/service"
+	"github.com/maxviazov/basketball-stats-service/pkg/response"
+)
+
+type ShotHandler struct {
+	svc service.ShotService
+}
+
+func NewShotHandler(svc service.ShotService) *ShotHandler { return &ShotHandler{svc: svc} }
+
+func (h *ShotHandler) Register(r *gin.RouterGroup) {
+	g := r.Group("/games")
+	{
+		g.POST("/:id/shots", h.recordShot)
+		g.GET("/:id/shots", h.listShots)
+		g.DELETE("/:id/shots/:shot_id", h.deleteShot)
+	}
+	r.Group("/players").GET("/:id/shotchart", h.shotChart)
+}
+
+type shotRequest struct {
+	PlayerID     int64   `json:"player_id"`
+	Period       int     `json:"period"`
+	ClockSeconds int     `json:"clock_seconds"`
+	X            float64 `json:"x"`
+	Y            float64 `json:"y"`
+	ShotType     string  `json:"shot_type"`
+	Made         *bool   `json:"made"`
+}
+
+func (h *ShotHandler) recordShot(c *gin.Context) {
+	gameID, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+	var req shotRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.WriteError(c, service.ErrInvalidInput)
+		return
+	}
+	// A missing result must not silently chart a miss.
+	if req.Made == nil {
+		response.WriteError(c, service.NewInvalidInputError([]service.FieldError{{Field: "made", Message: "is required"}}))
+		return
+	}
+	out, err := h.svc.RecordShot(c.Request.Context(), model.Shot{
+		GameID:       gameID,
+		PlayerID:     req.PlayerID,
+		Period:       req.Period,
+		ClockSeconds: req.ClockSeconds,
+		X:            req.X,
+		Y:            req.Y,
+		ShotType:     req.ShotType,
+		Made:         *req.Made,
+	})
+	if err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	response.WriteData(c, http.StatusCreated, out)
+}
+
+func (h *ShotHandler) listShots(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+	items, err := h.svc.ListGameShots(c.Request.Context(), id)
+	if err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	response.WriteData(c, http.StatusOK, items)
+}
+
+func (h *ShotHandler) deleteShot(c *gin.Context) {
+	gameID, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+	shotID, ok := parseIDParam(c, "shot_id")
+	if !ok {
+		return
+	}
+	if err := h.svc.DeleteShot(c.Request.Context(), gameID, shotID); err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// shotChart serves /players/:id/shotchart?[season=YYYY-YY]; without a season it covers the whole career.
+func (h *ShotHandler) shotChart(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+	var season *string
+	if v := c.Query("season"); v != "" {
+		season = &v
+	}
+	ctx, cancel := context.WithTimeout(c.Request.Context(), serviceTimeout)
+	defer cancel()
+
+	out, err := h.svc.GetShotChart(ctx, id, season)
+	if err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	response.WriteData(c, http.StatusOK, out)
+}
@@ -0,0 +1,207 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/maxviazov/basketball-stats-service/internal/model"
+	"github.com/maxviazov/basketball-stats-service/internal/repository"
+	"github.com/maxviazov/basketball-stats-service/internal/service"
+	"github.com/maxviazov/basketball-stats-service/pkg/response"
+)
+
+type StaffHandler struct {
+	svc service.StaffService
+}
+
+func NewStaffHandler(svc service.StaffService) *StaffHandler { return &StaffHandler{svc: svc} }
+
+func (h *StaffHandler) Register(r *gin.RouterGroup) {
+	s := r.Group("/staff")
+	{
+		s.POST("", h.create)
+		s.GET("", h.list)
+		s.GET("/:id", h.getByID)
+		s.POST("/:id/tenures", h.addTenure)
+		s.GET("/:id/tenures", h.listTenures)
+		s.PATCH("/:id/tenures/:tenure_id", h.endTenure)
+	}
+	r.Group("/teams").GET("/:team_id/staff", h.listByTeam)
+	// A coach is a staff member; the record only counts their head coach tenures.
+	r.Group("/coaches").GET("/:id/record", h.coachRecord)
+}
+
+type createStaffRequest struct {
+	FirstName string `json:"first_name"`
+	LastName  string `json:"last_name"`
+}
+
+func (h *StaffHandler) create(c *gin.Context) {
+	var req createStaffRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.WriteError(c, service.ErrInvalidInput)
+		return
+	}
+	out, err := h.svc.CreateStaff(c.Request.Context(), req.FirstName, req.LastName)
+	if err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	response.WriteData(c, http.StatusCreated, out)
+}
+
+func (h *StaffHandler) getByID(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+	out, err := h.svc.GetStaff(c.Request.Context(), id)
+	if err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	response.WriteData(c, http.StatusOK, out)
+}
+
+func (h *StaffHandler) list(c *gin.Context) {
+	limit, _ := strconv.Atoi(c.Query("limit"))
+	offset, _ := strconv.Atoi(c.Query("offset"))
+	res, err := h.svc.ListStaff(c.Request.Context(), repository.Page{Limit: limit, Offset: offset})
+	if err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	response.WriteData(c, http.StatusOK, res)
+}
+
+type tenureRequest struct {
+	TeamID    int64   `json:"team_id"`
+	Role      string  `json:"role"`
+	StartDate string  `json:"start_date"`         // YYYY-MM-DD, first day with the team
+	EndDate   *string `json:"end_date,omitempty"` // YYYY-MM-DD, first day without the team
+}
+
+func (h *StaffHandler) addTenure(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+	var req tenureRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.WriteError(c, service.ErrInvalidInput)
+		return
+	}
+	t := model.StaffTenure{StaffID: id, TeamID: req.TeamID, Role: req.Role}
+	var ferrs []service.FieldError
+	if v := strings.TrimSpace(req.StartDate); v != "" {
+		d, err := time.Parse(dateLayout, v)
+		if err != nil {
+			ferrs = append(ferrs, service.FieldError{Field: "start_date", Message: "must be a date in YYYY-MM-DD format"})
+		}
+		t.StartDate = d
+	}
+	if req.EndDate != nil {
+		d, err := time.Parse(dateLayout, strings.TrimSpace(*req.EndDate))
+		if err != nil {
+			ferrs = append(ferrs, service.FieldError{Field: "end_date", Message: "must be a date in YYYY-MM-DD format"})
+		}
+		t.EndDate = &d
+	}
+	if err := service.NewInvalidInputError(ferrs); err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	out, err := h.svc.AddTenure(c.Request.Context(), t)
+	if err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	response.WriteData(c, http.StatusCreated, out)
+}
+
+func (h *StaffHandler) listTenures(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+	items, err := h.svc.ListTenures(c.Request.Context(), id)
+	if err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	response.WriteData(c, http.StatusOK, items)
+}
+
+type endTenureRequest struct {
+	EndDate string `json:"end_date"` // YYYY-MM-DD, first day without the team
+}
+
+func (h *StaffHandler) endTenure(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+	tenureID, ok := parseIDParam(c, "tenure_id")
+	if !ok {
+		return
+	}
+	var req endTenureRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.WriteError(c, service.ErrInvalidInput)
+		return
+	}
+	end, err := time.Parse(dateLayout, strings.TrimSpace(req.EndDate))
+	if err != nil {
+		response.WriteError(c, service.NewInvalidInputError([]service.FieldError{{Field: "end_date", Message: "must be a date in YYYY-MM-DD format"}}))
+		return
+	}
+	out, err := h.svc.EndTenure(c.Request.Context(), id, tenureID, end)
+	if err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	response.WriteData(c, http.StatusOK, out)
+}
+
+// listByTeam serves /teams/:team_id/staff?[as_of=YYYY-MM-DD], head coach first.
+func (h *StaffHandler) listByTeam(c *gin.Context) {
+	teamID, ok := parseIDParam(c, "team_id")
+	if !ok {
+		return
+	}
+	asOf, ok := parseDateQuery(c, "as_of")
+	if !ok {
+		return
+	}
+	items, err := h.svc.ListTeamStaff(c.Request.Context(), teamID, asOf)
+	if err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	response.WriteData(c, http.StatusOK, items)
+}
+
+// coachRecord serves /coaches/:id/record?[phase=]; the record covers the whole career, season by season.
+func (h *StaffHandler) coachRecord(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+	var phase *string
+	if v := c.Query("phase"); v != "" {
+		phase = &v
+	}
+	ctx, cancel := context.WithTimeout(c.Request.Context(), serviceTimeout)
+	defer cancel()
+
+	out, err := h.svc.GetCoachRecord(ctx, id, phase)
+	if err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	response.WriteData(c, http.StatusOK, out)
+}
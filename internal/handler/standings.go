@@ -0,0 +1,39 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/maxviazov/basketball-stats-service/internal/service"
+	"github.com/maxviazov/basketball-stats-service/pkg/response"
+)
+
+type StandingsHandler struct {
+	svc service.StandingsService
+}
+
+func NewStandingsHandler(svc service.StandingsService) *StandingsHandler {
+	return &StandingsHandler{svc: svc}
+}
+
+func (h *StandingsHandler) Register(r *gin.RouterGroup) {
+	r.GET("/standings", h.get)
+}
+
+// get serves /standings?season=YYYY-YY[&phase=]; the season is mandatory.
+func (h *StandingsHandler) get(c *gin.Context) {
+	var phase *string
+	if v := c.Query("phase"); v != "" {
+		phase = &v
+	}
+	ctx, cancel := context.WithTimeout(c.Request.Context(), serviceTimeout)
+	defer cancel()
+
+	standings, err := h.svc.GetStandings(ctx, c.Query("season"), phase)
+	if err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	response.WriteData(c, http.StatusOK, standings)
+}
@@ -3,9 +3,11 @@ package handler
 import (
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/maxviazov/basketball-stats-service/internal/model"
+	"github.com/maxviazov/basketball-stats-service/internal/repository"
 	"github.com/maxviazov/basketball-stats-service/internal/service"
 	"github.com/maxviazov/basketball-stats-service/pkg/response"
 )
@@ -21,19 +23,29 @@ func (h *StatsHandler) Register(r *gin.RouterGroup) {
 	r.Group("/stats").POST("", h.upsert)
 	// Listing by game id: /api/v1/games/:id/stats
 	r.Group("/games").GET("/:id/stats", h.listByGame)
+	// Game log of a player: /api/v1/players/:id/games
+	r.Group("/players").GET("/:id/games", h.listByPlayer)
 }
 
 type upsertStatRequest struct {
-	PlayerID      int64   `json:"player_id"`
-	GameID        int64   `json:"game_id"`
-	Points        int     `json:"points"`
-	Rebounds      int     `json:"rebounds"`
-	Assists       int     `json:"assists"`
-	Steals        int     `json:"steals"`
-	Blocks        int     `json:"blocks"`
-	Fouls         int     `json:"fouls"`
-	Turnovers     int     `json:"turnovers"`
-	MinutesPlayed float32 `json:"minutes_played"`
+	PlayerID               int64   `json:"player_id"`
+	GameID                 int64   `json:"game_id"`
+	Points                 int     `json:"points"`
+	Rebounds               int     `json:"rebounds"`
+	OffensiveRebounds      int     `json:"offensive_rebounds"`
+	DefensiveRebounds      int     `json:"defensive_rebounds"`
+	Assists                int     `json:"assists"`
+	Steals                 int     `json:"steals"`
+	Blocks                 int     `json:"blocks"`
+	Fouls                  int     `json:"fouls"`
+	Turnovers              int     `json:"turnovers"`
+	FieldGoalsMade         int     `json:"field_goals_made"`
+	FieldGoalsAttempted    int     `json:"field_goals_attempted"`
+	ThreePointersMade      int     `json:"three_pointers_made"`
+	ThreePointersAttempted int     `json:"three_pointers_attempted"`
+	FreeThrowsMade         int     `json:"free_throws_made"`
+	FreeThrowsAttempted    int     `json:"free_throws_attempted"`
+	MinutesPlayed          float32 `json:"minutes_played"`
 }
 
 func (h *StatsHandler) upsert(c *gin.Context) {
@@ -43,16 +55,24 @@ func (h *StatsHandler) upsert(c *gin.Context) {
 		return
 	}
 	line, err := h.svc.UpsertStatLine(c.Request.Context(), model.PlayerStatLine{
-		PlayerID:      req.PlayerID,
-		GameID:        req.GameID,
-		Points:        req.Points,
-		Rebounds:      req.Rebounds,
-		Assists:       req.Assists,
-		Steals:        req.Steals,
-		Blocks:        req.Blocks,
-		Fouls:         req.Fouls,
-		Turnovers:     req.Turnovers,
-		MinutesPlayed: req.MinutesPlayed,
+		PlayerID:               req.PlayerID,
+		GameID:                 req.GameID,
+		Points:                 req.Points,
+		Rebounds:               req.Rebounds,
+		OffensiveRebounds:      req.OffensiveRebounds,
+		DefensiveRebounds:      req.DefensiveRebounds,
+		Assists:                req.Assists,
+		Steals:                 req.Steals,
+		Blocks:                 req.Blocks,
+		Fouls:                  req.Fouls,
+		Turnovers:              req.Turnovers,
+		FieldGoalsMade:         req.FieldGoalsMade,
+		FieldGoalsAttempted:    req.FieldGoalsAttempted,
+		ThreePointersMade:      req.ThreePointersMade,
+		ThreePointersAttempted: req.ThreePointersAttempted,
+		FreeThrowsMade:         req.FreeThrowsMade,
+		FreeThrowsAttempted:    req.FreeThrowsAttempted,
+		MinutesPlayed:          req.MinutesPlayed,
 	})
 	if err != nil {
 		response.WriteError(c, err)
@@ -75,3 +95,47 @@ func (h *StatsHandler) listByGame(c *gin.Context) {
 	}
 	response.WriteData(c, http.StatusOK, lines)
 }
+
+// listByPlayer serves a player's game log with optional season, from/to, opponent_id and last filters.
+func (h *StatsHandler) listByPlayer(c *gin.Context) {
+	playerID, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+	var f model.GameLogFilter
+	if v := strings.TrimSpace(c.Query("season")); v != "" {
+		f.Season = &v
+	}
+	if f.From, ok = parseDateQuery(c, "from"); !ok {
+		return
+	}
+	if f.To, ok = parseDateQuery(c, "to"); !ok {
+		return
+	}
+	if v := c.Query("opponent_id"); v != "" {
+		id, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			response.WriteError(c, service.NewInvalidInputError([]service.FieldError{{Field: "opponent_id", Message: "must be a valid integer"}}))
+			return
+		}
+		f.OpponentID = &id
+	}
+	if v := c.Query("last"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			response.WriteError(c, service.NewInvalidInputError([]service.FieldError{{Field: "last", Message: "must be a valid integer"}}))
+			return
+		}
+		f.Last = n
+	}
+	// Atoi errors are ignored intentionally, as 0 is a valid default for limit/offset, handled by the service layer.
+	limit, _ := strconv.Atoi(c.Query("limit"))
+	offset, _ := strconv.Atoi(c.Query("offset"))
+	page := repository.Page{Limit: limit, Offset: offset}
+	res, err := h.svc.ListPlayerGameLog(c.Request.Context(), playerID, f, page)
+	if err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	response.WriteData(c, http.StatusOK, res)
+}
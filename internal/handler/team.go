@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/maxviazov/basketball-stats-service/internal/model"
 	"github.com/maxviazov/basketball-stats-service/internal/repository"
 	"github.com/maxviazov/basketball-stats-service/internal/service"
 	"github.com/maxviazov/basketball-stats-service/pkg/response"
@@ -26,14 +27,20 @@ func (h *TeamHandler) Register(r *gin.RouterGroup) {
 		// Use a stable wildcard name (team_id) so nested routes (e.g. players) can reuse it without Gin conflicts.
 		g.GET("/:team_id", h.getByID)
 		g.GET("", h.list)
+		g.PATCH("/:team_id", h.update)
+		g.DELETE("/:team_id", h.delete)
+		g.POST("/:team_id/restore", h.restore)
 		g.GET("/:team_id/aggregates", h.getAggregatedStats)
+		g.GET("/:team_id/splits", h.getSplits)
 		// Compatibility alias to support alternative path shape without changing contract
 		g.GET("/:team_id/stats/aggregate", h.getAggregatedStats)
 	}
+	r.GET("/attendance", h.getAttendance)
 }
 
 type createTeamRequest struct {
-	Name string `json:"name"`
+	Name        string `json:"name"`
+	HomeVenueID *int64 `json:"home_venue_id"`
 }
 
 func (h *TeamHandler) create(c *gin.Context) {
@@ -42,7 +49,7 @@ func (h *TeamHandler) create(c *gin.Context) {
 		response.WriteError(c, service.ErrInvalidInput)
 		return
 	}
-	team, err := h.svc.CreateTeam(c.Request.Context(), req.Name)
+	team, err := h.svc.CreateTeam(c.Request.Context(), model.Team{Name: req.Name, HomeVenueID: req.HomeVenueID})
 	if err != nil {
 		response.WriteError(c, err)
 		return
@@ -65,6 +72,54 @@ func (h *TeamHandler) getByID(c *gin.Context) {
 	response.WriteData(c, http.StatusOK, team)
 }
 
+type updateTeamRequest struct {
+	Name        *string `json:"name"`
+	HomeVenueID *int64  `json:"home_venue_id"`
+}
+
+func (h *TeamHandler) update(c *gin.Context) {
+	id, ok := parseIDParam(c, "team_id")
+	if !ok {
+		return
+	}
+	var req updateTeamRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.WriteError(c, service.ErrInvalidInput)
+		return
+	}
+	team, err := h.svc.UpdateTeam(c.Request.Context(), id, model.TeamPatch{Name: req.Name, HomeVenueID: req.HomeVenueID})
+	if err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	response.WriteData(c, http.StatusOK, team)
+}
+
+func (h *TeamHandler) delete(c *gin.Context) {
+	id, ok := parseIDParam(c, "team_id")
+	if !ok {
+		return
+	}
+	if err := h.svc.DeleteTeam(c.Request.Context(), id); err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func (h *TeamHandler) restore(c *gin.Context) {
+	id, ok := parseIDParam(c, "team_id")
+	if !ok {
+		return
+	}
+	team, err := h.svc.RestoreTeam(c.Request.Context(), id)
+	if err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	response.WriteData(c, http.StatusOK, team)
+}
+
 func (h *TeamHandler) list(c *gin.Context) {
 	// Atoi errors are ignored intentionally, as 0 is a valid default for limit/offset, handled by the service layer.
 	limit, _ := strconv.Atoi(c.Query("limit"))
@@ -106,11 +161,15 @@ func (h *TeamHandler) getAggregatedStats(c *gin.Context) {
 	} else if parseBoolQuery(careerQuery) {
 		season = nil // Explicitly nil for career stats
 	}
+	var phase *string
+	if v := c.Query("phase"); v != "" {
+		phase = &v
+	}
 
 	ctx, cancel := context.WithTimeout(c.Request.Context(), serviceTimeout)
 	defer cancel()
 
-	stats, err := h.svc.GetTeamAggregatedStats(ctx, id, season)
+	stats, err := h.svc.GetTeamAggregatedStats(ctx, id, season, phase)
 
 	logger := log.With().
 		Str("path", c.Request.URL.Path).
@@ -129,3 +188,50 @@ func (h *TeamHandler) getAggregatedStats(c *gin.Context) {
 	logger.Info().Int("status", http.StatusOK).Msg("team aggregates retrieved")
 	response.WriteData(c, http.StatusOK, stats)
 }
+
+// getSplits serves /teams/:team_id/splits?[season=][&phase=][&by=location,result,month,opponent,rest].
+func (h *TeamHandler) getSplits(c *gin.Context) {
+	id, ok := parseIDParam(c, "team_id")
+	if !ok {
+		return
+	}
+	var season, phase *string
+	if v := c.Query("season"); v != "" {
+		season = &v
+	}
+	if v := c.Query("phase"); v != "" {
+		phase = &v
+	}
+	ctx, cancel := context.WithTimeout(c.Request.Context(), serviceTimeout)
+	defer cancel()
+
+	splits, err := h.svc.GetTeamSplits(ctx, id, season, phase, parseListQuery(c, "by"))
+	if err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	response.WriteData(c, http.StatusOK, splits)
+}
+
+// getAttendance serves /attendance?[season=][&team_id=]: average home attendance per team and season.
+func (h *TeamHandler) getAttendance(c *gin.Context) {
+	var season *string
+	if v := c.Query("season"); v != "" {
+		season = &v
+	}
+	var teamID *int64
+	if v := c.Query("team_id"); v != "" {
+		id, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			response.WriteError(c, service.NewInvalidInputError([]service.FieldError{{Field: "team_id", Message: "must be a valid integer"}}))
+			return
+		}
+		teamID = &id
+	}
+	res, err := h.svc.GetAttendance(c.Request.Context(), season, teamID)
+	if err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	response.WriteData(c, http.StatusOK, res)
+}
@@ -0,0 +1,113 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/maxviazov/basketball-stats-service/internal/model"
+	"github.com/maxviazov/basketball-stats-service/internal/repository"
+	"github.com/maxviazov/basketball-stats-service/internal/service"
+	"github.com/maxviazov/basketball-stats-service/pkg/response"
+)
+
+type VenueHandler struct {
+	svc service.VenueService
+}
+
+func NewVenueHandler(svc service.VenueService) *VenueHandler { return &VenueHandler{svc: svc} }
+
+func (h *VenueHandler) Register(r *gin.RouterGroup) {
+	g := r.Group("/venues")
+	{
+		g.POST("", h.create)
+		g.GET("", h.list)
+		g.GET("/:venue_id", h.getByID)
+		g.PATCH("/:venue_id", h.update)
+		g.DELETE("/:venue_id", h.delete)
+	}
+}
+
+type createVenueRequest struct {
+	Name      string   `json:"name"`
+	City      string   `json:"city"`
+	Capacity  int      `json:"capacity"`
+	Timezone  string   `json:"timezone"`
+	Latitude  *float64 `json:"latitude"`
+	Longitude *float64 `json:"longitude"`
+}
+
+func (h *VenueHandler) create(c *gin.Context) {
+	var req createVenueRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.WriteError(c, service.ErrInvalidInput)
+		return
+	}
+	out, err := h.svc.CreateVenue(c.Request.Context(), model.Venue{
+		Name:      req.Name,
+		City:      req.City,
+		Capacity:  req.Capacity,
+		Timezone:  req.Timezone,
+		Latitude:  req.Latitude,
+		Longitude: req.Longitude,
+	})
+	if err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	response.WriteData(c, http.StatusCreated, out)
+}
+
+func (h *VenueHandler) getByID(c *gin.Context) {
+	id, ok := parseIDParam(c, "venue_id")
+	if !ok {
+		return
+	}
+	out, err := h.svc.GetVenue(c.Request.Context(), id)
+	if err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	response.WriteData(c, http.StatusOK, out)
+}
+
+func (h *VenueHandler) list(c *gin.Context) {
+	limit, _ := strconv.Atoi(c.Query("limit"))
+	offset, _ := strconv.Atoi(c.Query("offset"))
+	res, err := h.svc.ListVenues(c.Request.Context(), repository.Page{Limit: limit, Offset: offset})
+	if err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	response.WriteData(c, http.StatusOK, res)
+}
+
+func (h *VenueHandler) update(c *gin.Context) {
+	id, ok := parseIDParam(c, "venue_id")
+	if !ok {
+		return
+	}
+	var patch model.VenuePatch
+	if err := c.ShouldBindJSON(&patch); err != nil {
+		response.WriteError(c, service.ErrInvalidInput)
+		return
+	}
+	out, err := h.svc.UpdateVenue(c.Request.Context(), id, patch)
+	if err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	response.WriteData(c, http.StatusOK, out)
+}
+
+func (h *VenueHandler) delete(c *gin.Context) {
+	id, ok := parseIDParam(c, "venue_id")
+	if !ok {
+		return
+	}
+	if err := h.svc.DeleteVenue(c.Request.Context(), id); err != nil {
+		response.WriteError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
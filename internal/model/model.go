@@ -4,66 +4,504 @@ package model
 
 import "time"
 
-// Team represents a basketball team.
-type Team struct {
+// League is an independent competition. Teams, players, seasons and games belong to exactly one league.
+type League struct {
 	ID        int64     `json:"id"`
 	Name      string    `json:"name"`
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
 }
 
+// Venue is an arena games are played in. Venues are shared by every league.
+type Venue struct {
+	ID       int64  `json:"id"`
+	Name     string `json:"name"`
+	City     string `json:"city"`
+	Capacity int    `json:"capacity"`
+	// Timezone is an IANA name, e.g. America/New_York; tip-off times are rendered in it.
+	Timezone  string    `json:"timezone"`
+	Latitude  *float64  `json:"latitude"`
+	Longitude *float64  `json:"longitude"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// VenuePatch is a partial update of a venue; nil fields are left unchanged.
+type VenuePatch struct {
+	Name      *string  `json:"name"`
+	City      *string  `json:"city"`
+	Capacity  *int     `json:"capacity"`
+	Timezone  *string  `json:"timezone"`
+	Latitude  *float64 `json:"latitude"`
+	Longitude *float64 `json:"longitude"`
+}
+
+// Team represents a basketball team.
+type Team struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+	// HomeVenueID is where the team's home games are played unless a game names another venue.
+	HomeVenueID *int64    `json:"home_venue_id"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// TeamPatch is a partial update of a team; nil fields are left unchanged.
+type TeamPatch struct {
+	Name        *string `json:"name"`
+	HomeVenueID *int64  `json:"home_venue_id"`
+}
+
+// TeamAttendance is a team's home attendance over one season.
+// Only home games with a recorded attendance count.
+type TeamAttendance struct {
+	TeamID            int64   `json:"team_id"`
+	TeamName          string  `json:"team_name"`
+	Season            string  `json:"season"`
+	Games             int     `json:"games"`
+	TotalAttendance   int64   `json:"total_attendance"`
+	AverageAttendance float64 `json:"average_attendance"`
+}
+
 // Player represents an athlete belonging to a team.
+// TeamID is the current team; the history lives in the player's memberships.
 type Player struct {
-	ID        int64     `json:"id"`
-	TeamID    int64     `json:"team_id"`
-	FirstName string    `json:"first_name"`
-	LastName  string    `json:"last_name"`
-	Position  string    `json:"position"`
+	ID        int64  `json:"id"`
+	TeamID    int64  `json:"team_id"`
+	FirstName string `json:"first_name"`
+	LastName  string `json:"last_name"`
+	Position  string `json:"position"`
+	PlayerBio
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// PlayerBio holds the optional biographical fields of a player. JerseyNumber is text so that 0 and 00 differ;
+// it is unique among the active players of a team. Height is in centimetres, weight in kilograms,
+// Nationality is an ISO 3166-1 alpha-2 code and Handedness is left or right.
+type PlayerBio struct {
+	JerseyNumber       *string    `json:"jersey_number"`
+	HeightCm           *int       `json:"height_cm"`
+	WeightKg           *int       `json:"weight_kg"`
+	BirthDate          *time.Time `json:"birth_date"`
+	Nationality        *string    `json:"nationality"`
+	Handedness         *string    `json:"handedness"`
+	SecondaryPositions []string   `json:"secondary_positions"`
+}
+
+// AgeOn returns the player's age in whole years on the given day, or nil without a birth date.
+func (b PlayerBio) AgeOn(day time.Time) *int {
+	return AgeOn(b.BirthDate, day)
+}
+
+// AgeOn returns the age in whole years on the given day of someone born on birthDate, or nil if it is unknown.
+// Both are compared as calendar dates; a 29 February birthday is reached on 1 March in common years.
+func AgeOn(birthDate *time.Time, day time.Time) *int {
+	if birthDate == nil {
+		return nil
+	}
+	age := day.Year() - birthDate.Year()
+	if day.Month() < birthDate.Month() || (day.Month() == birthDate.Month() && day.Day() < birthDate.Day()) {
+		age--
+	}
+	return &age
+}
+
+// PlayerPatch is a partial update of a player; nil fields are left unchanged.
+// The team is not part of it: moving a player to another team is a transfer.
+// An optional bio field is cleared by its zero value: an empty string, 0, a zero date or an empty list.
+type PlayerPatch struct {
+	FirstName          *string    `json:"first_name"`
+	LastName           *string    `json:"last_name"`
+	Position           *string    `json:"position"`
+	JerseyNumber       *string    `json:"jersey_number"`
+	HeightCm           *int       `json:"height_cm"`
+	WeightKg           *int       `json:"weight_kg"`
+	BirthDate          *time.Time `json:"birth_date"`
+	Nationality        *string    `json:"nationality"`
+	Handedness         *string    `json:"handedness"`
+	SecondaryPositions *[]string  `json:"secondary_positions"`
+}
+
+// Membership is a period during which a player was registered with a team.
+// Periods are half-open, [StartDate, EndDate), so a transfer date belongs to the new team.
+type Membership struct {
+	ID        int64      `json:"id"`
+	PlayerID  int64      `json:"player_id"`
+	TeamID    int64      `json:"team_id"`
+	StartDate *time.Time `json:"start_date"` // nil: since the player was registered
+	EndDate   *time.Time `json:"end_date"`   // nil: current membership
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// PlayerAvailability records whether a player could play in a game and, if they did not play, why.
+type PlayerAvailability struct {
+	GameID    int64     `json:"game_id"`
+	PlayerID  int64     `json:"player_id"`
+	Status    string    `json:"status"` // active, dnp_coach, inactive, injured, suspended
+	Reason    *string   `json:"reason,omitempty"`
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
 }
 
+// Injury is one entry of a player's injury timeline. Dates are calendar days; ReturnedOn is nil while the player is out.
+type Injury struct {
+	ID                 int64      `json:"id"`
+	PlayerID           int64      `json:"player_id"`
+	Description        string     `json:"description"`
+	StartDate          time.Time  `json:"start_date"`
+	ExpectedReturnDate *time.Time `json:"expected_return_date"`
+	ReturnedOn         *time.Time `json:"returned_on"`
+	CreatedAt          time.Time  `json:"created_at"`
+	UpdatedAt          time.Time  `json:"updated_at"`
+}
+
+// InjuryPatch carries the fields of an injury that may change as it heals; nil leaves a field unchanged.
+type InjuryPatch struct {
+	Description        *string    `json:"description"`
+	ExpectedReturnDate *time.Time `json:"expected_return_date"`
+	ReturnedOn         *time.Time `json:"returned_on"`
+}
+
+// Season is a named date range, e.g. 2023-24, split into phases. Dates are inclusive.
+type Season struct {
+	ID        int64     `json:"id"`
+	Name      string    `json:"name"`
+	StartDate time.Time `json:"start_date"`
+	EndDate   time.Time `json:"end_date"`
+	// RuleProfileID is the default rule profile of the season's games; zero on creation means NBA rules.
+	RuleProfileID int64         `json:"rule_profile_id"`
+	Phases        []SeasonPhase `json:"phases"`
+	CreatedAt     time.Time     `json:"created_at"`
+	UpdatedAt     time.Time     `json:"updated_at"`
+}
+
+// SeasonPhase is a part of a season: preseason, regular or playoffs. Dates are inclusive.
+type SeasonPhase struct {
+	Phase     string    `json:"phase"`
+	StartDate time.Time `json:"start_date"`
+	EndDate   time.Time `json:"end_date"`
+}
+
 // Game represents a scheduled or finished match.
 type Game struct {
 	ID         int64     `json:"id"`
 	Season     string    `json:"season"`
+	SeasonID   int64     `json:"season_id"`
+	Phase      string    `json:"phase"` // preseason, regular, playoffs
 	Date       time.Time `json:"date"`
 	HomeTeamID int64     `json:"home_team_id"`
 	AwayTeamID int64     `json:"away_team_id"`
-	Status     string    `json:"status"` // scheduled, in_progress, finished
+	Status     string    `json:"status"` // scheduled, in_progress, finished, postponed, cancelled
+	// RuleProfileID is the rule profile the game is played under; zero on creation means the season's profile.
+	RuleProfileID int64 `json:"rule_profile_id"`
+	// VenueID defaults to the home team's venue on creation. Attendance cannot exceed the venue's capacity.
+	VenueID    *int64 `json:"venue_id"`
+	Attendance *int   `json:"attendance"`
+	// LocalTipOff is Date in the venue's timezone; nil for a game without a venue.
+	LocalTipOff *time.Time `json:"local_tip_off,omitempty"`
+	// HomeScore and AwayScore are the official score; nil until one has been recorded.
+	HomeScore *int          `json:"home_score"`
+	AwayScore *int          `json:"away_score"`
+	LineScore []PeriodScore `json:"line_score,omitempty"`
+	CreatedAt time.Time     `json:"created_at"`
+	UpdatedAt time.Time     `json:"updated_at"`
+}
+
+// GamePatch is a partial update of a game; nil fields are left unchanged.
+// The season and the teams are fixed once a game exists, since stat lines are attributed through them.
+// The status only changes through a GameTransition.
+type GamePatch struct {
+	Date       *time.Time `json:"date"`
+	Phase      *string    `json:"phase"`
+	VenueID    *int64     `json:"venue_id"`
+	Attendance *int       `json:"attendance"`
+}
+
+// RuleProfile describes how a competition is played. Limits that used to be fixed NBA numbers,
+// such as the foul-out limit and the length of a game, are derived from it.
+type RuleProfile struct {
+	ID              int64     `json:"id"`
+	Name            string    `json:"name"`
+	PeriodMinutes   int       `json:"period_minutes"`
+	Periods         int       `json:"periods"`
+	OvertimeMinutes int       `json:"overtime_minutes"`
+	FoulLimit       int       `json:"foul_limit"`
+	RosterSize      int       `json:"roster_size"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+// MaxMinutes is the length of a game with the given number of overtimes, the most a single player can play.
+func (r RuleProfile) MaxMinutes(overtimes int) float64 {
+	return float64(r.Periods*r.PeriodMinutes + overtimes*r.OvertimeMinutes)
+}
+
+// PeriodSeconds is the full clock of a period; periods past regulation are overtimes.
+func (r RuleProfile) PeriodSeconds(period int) int {
+	if period > r.Periods {
+		return r.OvertimeMinutes * 60
+	}
+	return r.PeriodMinutes * 60
+}
+
+// Official is a referee who works games in a league.
+type Official struct {
+	ID        int64     `json:"id"`
+	FirstName string    `json:"first_name"`
+	LastName  string    `json:"last_name"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// GameOfficial assigns an official to a game's crew. An official works at most one game a day
+// and a game has at most one crew chief.
+type GameOfficial struct {
+	GameID     int64     `json:"game_id"`
+	OfficialID int64     `json:"official_id"`
+	Role       string    `json:"role"` // crew_chief, referee, umpire
+	FirstName  string    `json:"first_name"`
+	LastName   string    `json:"last_name"`
 	CreatedAt  time.Time `json:"created_at"`
-	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// OfficialGame is a game an official worked, with the role they had on the crew.
+type OfficialGame struct {
+	Game
+	Role string `json:"role"`
+}
+
+// OfficialSummary averages the fouls called in the finished games an official worked.
+// Fouls are read from player_stats, so games without stat lines do not count.
+type OfficialSummary struct {
+	OfficialID   int64   `json:"official_id"`
+	Season       *string `json:"season,omitempty"`
+	Games        int     `json:"games"`
+	Fouls        int     `json:"fouls"`
+	FoulsPerGame float64 `json:"fouls_per_game"`
+}
+
+// Staff is a coach or trainer who works for the teams of a league.
+type Staff struct {
+	ID        int64     `json:"id"`
+	FirstName string    `json:"first_name"`
+	LastName  string    `json:"last_name"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// StaffTenure puts a staff member on a team's staff in one role for the half-open period [StartDate, EndDate).
+// A team has one head coach at a time and a staff member holds one tenure at a time.
+type StaffTenure struct {
+	ID        int64      `json:"id"`
+	StaffID   int64      `json:"staff_id"`
+	TeamID    int64      `json:"team_id"`
+	Role      string     `json:"role"` // head_coach, assistant_coach, trainer
+	StartDate time.Time  `json:"start_date"`
+	EndDate   *time.Time `json:"end_date"` // nil: current tenure
+	FirstName string     `json:"first_name"`
+	LastName  string     `json:"last_name"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// CoachSeasonRecord is a head coach's record in one season, over every team they led in it.
+type CoachSeasonRecord struct {
+	Season string  `json:"season"`
+	Wins   int     `json:"wins"`
+	Losses int     `json:"losses"`
+	WinPct float64 `json:"win_pct"`
+}
+
+// CoachRecord is a head coach's win-loss record over the finished games their teams played during their
+// head coach tenures. Other roles do not count, so an assistant's record is empty.
+type CoachRecord struct {
+	StaffID int64               `json:"staff_id"`
+	Phase   *string             `json:"phase,omitempty"`
+	Wins    int                 `json:"wins"`
+	Losses  int                 `json:"losses"`
+	WinPct  float64             `json:"win_pct"`
+	Seasons []CoachSeasonRecord `json:"seasons"`
+}
+
+// GameTransition records one move of a game through its lifecycle.
+type GameTransition struct {
+	ID             int64     `json:"id"`
+	GameID         int64     `json:"game_id"`
+	FromStatus     string    `json:"from_status"`
+	ToStatus       string    `json:"to_status"`
+	TransitionedAt time.Time `json:"transitioned_at"`
+}
+
+// PeriodScore is a single entry of a game's line score.
+// Periods are numbered from 1; anything past regulation is an overtime.
+type PeriodScore struct {
+	Period     int `json:"period"`
+	HomePoints int `json:"home_points"`
+	AwayPoints int `json:"away_points"`
+}
+
+// GameScore is the official result submitted for a game: final score plus an optional line score.
+type GameScore struct {
+	HomeScore int           `json:"home_score"`
+	AwayScore int           `json:"away_score"`
+	Periods   []PeriodScore `json:"periods"`
+}
+
+// ScoreReconciliation compares the official score of a game with the sum of its player stat lines.
+// It is a read-only diagnostic; the official score always wins.
+type ScoreReconciliation struct {
+	GameID           int64 `json:"game_id"`
+	HomeScore        *int  `json:"home_score"`
+	AwayScore        *int  `json:"away_score"`
+	HomePlayerPoints int   `json:"home_player_points"`
+	AwayPlayerPoints int   `json:"away_player_points"`
+	Consistent       bool  `json:"consistent"`
 }
 
 // PlayerStatLine represents per-game stats for a player.
+// Points must equal 2*FGM + 3PM + FTM and Rebounds must equal OREB + DREB; the service layer enforces both.
 type PlayerStatLine struct {
-	ID            int64     `json:"id"`
-	PlayerID      int64     `json:"player_id"`
-	GameID        int64     `json:"game_id"`
-	Points        int       `json:"points"`
-	Rebounds      int       `json:"rebounds"`
-	Assists       int       `json:"assists"`
-	Steals        int       `json:"steals"`
-	Blocks        int       `json:"blocks"`
-	Fouls         int       `json:"fouls"`
-	Turnovers     int       `json:"turnovers"`
-	MinutesPlayed float32   `json:"minutes_played"`
-	CreatedAt     time.Time `json:"created_at"`
-	UpdatedAt     time.Time `json:"updated_at"`
+	ID                     int64     `json:"id"`
+	PlayerID               int64     `json:"player_id"`
+	GameID                 int64     `json:"game_id"`
+	Points                 int       `json:"points"`
+	Rebounds               int       `json:"rebounds"`
+	OffensiveRebounds      int       `json:"offensive_rebounds"`
+	DefensiveRebounds      int       `json:"defensive_rebounds"`
+	Assists                int       `json:"assists"`
+	Steals                 int       `json:"steals"`
+	Blocks                 int       `json:"blocks"`
+	Fouls                  int       `json:"fouls"`
+	Turnovers              int       `json:"turnovers"`
+	FieldGoalsMade         int       `json:"field_goals_made"`
+	FieldGoalsAttempted    int       `json:"field_goals_attempted"`
+	ThreePointersMade      int       `json:"three_pointers_made"`
+	ThreePointersAttempted int       `json:"three_pointers_attempted"`
+	FreeThrowsMade         int       `json:"free_throws_made"`
+	FreeThrowsAttempted    int       `json:"free_throws_attempted"`
+	MinutesPlayed          float32   `json:"minutes_played"`
+	CreatedAt              time.Time `json:"created_at"`
+	UpdatedAt              time.Time `json:"updated_at"`
+}
+
+// GameEvent is a single play-by-play entry. Box score lines are derived from the event stream.
+type GameEvent struct {
+	ID           int64     `json:"id"`
+	GameID       int64     `json:"game_id"`
+	Period       int       `json:"period"`
+	ClockSeconds int       `json:"clock_seconds"` // seconds remaining in the period
+	TeamID       int64     `json:"team_id"`
+	PlayerID     int64     `json:"player_id"`
+	EventType    string    `json:"event_type"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// Shot is a field goal attempt placed on the court. X and Y are in feet from the center of the basket the
+// shooter attacked: X runs from sideline to sideline, Y from the baseline (-5.25) towards the far end.
+type Shot struct {
+	ID           int64     `json:"id"`
+	GameID       int64     `json:"game_id"`
+	PlayerID     int64     `json:"player_id"`
+	Period       int       `json:"period"`
+	ClockSeconds int       `json:"clock_seconds"` // seconds remaining in the period
+	X            float64   `json:"x"`
+	Y            float64   `json:"y"`
+	ShotType     string    `json:"shot_type"` // two_pt or three_pt
+	Made         bool      `json:"made"`
+	Zone         string    `json:"zone"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// ShotCounts totals a player's charted shots in one game, to compare with their stat line.
+type ShotCounts struct {
+	Attempts      int
+	Makes         int
+	ThreeAttempts int
+	ThreeMakes    int
+}
+
+// ShotZoneStats is one zone of a shot chart; Pct is a fraction in [0, 1].
+type ShotZoneStats struct {
+	Zone     string  `json:"zone"`
+	Attempts int     `json:"attempts"`
+	Makes    int     `json:"makes"`
+	Pct      float64 `json:"pct"`
+}
+
+// ShotChart is a player's charted shots by zone, every zone listed even without attempts.
+type ShotChart struct {
+	PlayerID int64           `json:"player_id"`
+	Season   *string         `json:"season,omitempty"`
+	Zones    []ShotZoneStats `json:"zones"`
+}
+
+// GameStarter is one of the five players a team started a game with.
+type GameStarter struct {
+	GameID   int64 `json:"game_id"`
+	TeamID   int64 `json:"team_id"`
+	PlayerID int64 `json:"player_id"`
+}
+
+// LineupStint is a stretch of one period a five-man unit spent on court, from StartClock down to EndClock.
+// Clocks are seconds remaining in the period, as in GameEvent; PlayerIDs are in ascending order.
+type LineupStint struct {
+	ID         int64     `json:"id"`
+	GameID     int64     `json:"game_id"`
+	TeamID     int64     `json:"team_id"`
+	Period     int       `json:"period"`
+	StartClock int       `json:"start_clock"`
+	EndClock   int       `json:"end_clock"`
+	PlayerIDs  []int64   `json:"player_ids"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// LineupStats sums every stint of one five-man unit in a season. Points and possessions come from the
+// play-by-play recorded during the stints; ratings are points per 100 possessions.
+type LineupStats struct {
+	PlayerIDs            []int64 `json:"player_ids"`
+	Games                int     `json:"games"`
+	Minutes              float64 `json:"minutes"`
+	PointsFor            int     `json:"points_for"`
+	PointsAgainst        int     `json:"points_against"`
+	OffensivePossessions float64 `json:"offensive_possessions"`
+	DefensivePossessions float64 `json:"defensive_possessions"`
+	OffensiveRating      float64 `json:"offensive_rating"`
+	DefensiveRating      float64 `json:"defensive_rating"`
+	NetRating            float64 `json:"net_rating"`
 }
 
 // PlayerAggregatedStats holds calculated statistics for a player, such as career totals or seasonal averages.
 // This model is designed for read-only query results and is not persisted directly.
+// GamesPlayed counts lines where the player got on the floor; averages are per game played.
+// GamesAvailable adds the games they were active for but did not play, including coach's decisions.
 type PlayerAggregatedStats struct {
-	GamesPlayed   int     `json:"games_played"`
-	TotalPoints   int     `json:"total_points"`
-	TotalRebounds int     `json:"total_rebounds"`
-	TotalAssists  int     `json:"total_assists"`
-	TotalSteals   int     `json:"total_steals"`
-	TotalBlocks   int     `json:"total_blocks"`
-	AvgPoints     float64 `json:"avg_points"`
-	AvgRebounds   float64 `json:"avg_rebounds"`
-	AvgAssists    float64 `json:"avg_assists"`
+	GamesPlayed    int     `json:"games_played"`
+	GamesAvailable int     `json:"games_available"`
+	TotalPoints    int     `json:"total_points"`
+	TotalRebounds  int     `json:"total_rebounds"`
+	TotalAssists   int     `json:"total_assists"`
+	TotalSteals    int     `json:"total_steals"`
+	TotalBlocks    int     `json:"total_blocks"`
+	AvgPoints      float64 `json:"avg_points"`
+	AvgRebounds    float64 `json:"avg_rebounds"`
+	AvgAssists     float64 `json:"avg_assists"`
+
+	TotalFieldGoalsMade         int `json:"total_field_goals_made"`
+	TotalFieldGoalsAttempted    int `json:"total_field_goals_attempted"`
+	TotalThreePointersMade      int `json:"total_three_pointers_made"`
+	TotalThreePointersAttempted int `json:"total_three_pointers_attempted"`
+	TotalFreeThrowsMade         int `json:"total_free_throws_made"`
+	TotalFreeThrowsAttempted    int `json:"total_free_throws_attempted"`
+	TotalOffensiveRebounds      int `json:"total_offensive_rebounds"`
+	TotalDefensiveRebounds      int `json:"total_defensive_rebounds"`
+
+	// Shooting percentages are fractions in [0, 1]; they are 0 when there were no attempts.
+	FieldGoalPct    float64 `json:"fg_pct"`
+	ThreePointPct   float64 `json:"three_pt_pct"`
+	FreeThrowPct    float64 `json:"ft_pct"`
+	EffectiveFGPct  float64 `json:"efg_pct"`
+	TrueShootingPct float64 `json:"ts_pct"`
 }
 
 // TeamAggregatedStats provides a summary of a team's performance, including win-loss record and point differentials.
@@ -76,3 +514,318 @@ type TeamAggregatedStats struct {
 	AvgPointsScored    float64 `json:"avg_points_scored"`
 	AvgPointsAllowed   float64 `json:"avg_points_allowed"`
 }
+
+// PlayerSplit is a player's aggregate over one bucket of a split dimension, e.g. location "home" or month "2025-01".
+type PlayerSplit struct {
+	Dimension string `json:"dimension"`
+	Key       string `json:"key"`
+	PlayerAggregatedStats
+}
+
+// PlayerSplits groups a player's split buckets over an optional season and phase.
+type PlayerSplits struct {
+	PlayerID int64         `json:"player_id"`
+	Season   *string       `json:"season"`
+	Phase    *string       `json:"phase"`
+	Splits   []PlayerSplit `json:"splits"`
+}
+
+// TeamSplit is a team's record over one bucket of a split dimension.
+type TeamSplit struct {
+	Dimension string `json:"dimension"`
+	Key       string `json:"key"`
+	TeamAggregatedStats
+}
+
+// TeamSplits groups a team's split buckets over an optional season and phase.
+type TeamSplits struct {
+	TeamID int64       `json:"team_id"`
+	Season *string     `json:"season"`
+	Phase  *string     `json:"phase"`
+	Splits []TeamSplit `json:"splits"`
+}
+
+// WinLoss is a win-loss record over some subset of games, e.g. home games or the last ten.
+type WinLoss struct {
+	Wins   int `json:"wins"`
+	Losses int `json:"losses"`
+}
+
+// TeamStanding is one row of a league table. Rows are ranked by win percentage; ties are broken by
+// head-to-head record among the tied teams, then by point differential.
+type TeamStanding struct {
+	Rank          int     `json:"rank"`
+	TeamID        int64   `json:"team_id"`
+	TeamName      string  `json:"team_name"`
+	Wins          int     `json:"wins"`
+	Losses        int     `json:"losses"`
+	WinPct        float64 `json:"win_pct"`
+	GamesBehind   float64 `json:"games_behind"`
+	Home          WinLoss `json:"home"`
+	Away          WinLoss `json:"away"`
+	Last10        WinLoss `json:"last_10"`
+	Streak        string  `json:"streak"` // e.g. W3 or L1; empty before the first game
+	PointsFor     int     `json:"points_for"`
+	PointsAgainst int     `json:"points_against"`
+	PointDiff     int     `json:"point_diff"`
+	// HeadToHead is the record against each opponent, keyed by opponent team ID; used only for tie-breaking.
+	HeadToHead map[int64]WinLoss `json:"-"`
+}
+
+// Standings is the league table of a season phase.
+type Standings struct {
+	Season string         `json:"season"`
+	Phase  string         `json:"phase"`
+	Teams  []TeamStanding `json:"teams"`
+}
+
+// LeaderQuery selects a leaderboard: one stat column, as totals or per-game averages, over optional season and phase.
+type LeaderQuery struct {
+	Stat     string
+	PerGame  bool
+	Season   *string
+	Phase    *string
+	MinGames int // players with fewer games are not ranked
+	Limit    int // ranks to return; players tied on the last rank are all included
+}
+
+// LeaderEntry is one ranked player on a leaderboard. Tied players share a rank.
+type LeaderEntry struct {
+	Rank        int     `json:"rank"`
+	PlayerID    int64   `json:"player_id"`
+	FirstName   string  `json:"first_name"`
+	LastName    string  `json:"last_name"`
+	TeamID      int64   `json:"team_id"`
+	GamesPlayed int     `json:"games_played"`
+	Value       float64 `json:"value"`
+}
+
+// Leaderboard is the response for a leaders query.
+type Leaderboard struct {
+	Stat     string        `json:"stat"`
+	Per      string        `json:"per"` // game or total
+	Season   *string       `json:"season"`
+	Phase    *string       `json:"phase,omitempty"`
+	MinGames int           `json:"min_games"`
+	Leaders  []LeaderEntry `json:"leaders"`
+}
+
+// StatTotals are box score counts summed over a set of stat lines: a player's season, a team's, or a whole league's.
+type StatTotals struct {
+	Games                  int     `json:"games"`
+	Minutes                float64 `json:"minutes"`
+	Points                 int     `json:"points"`
+	FieldGoalsMade         int     `json:"field_goals_made"`
+	FieldGoalsAttempted    int     `json:"field_goals_attempted"`
+	ThreePointersMade      int     `json:"three_pointers_made"`
+	ThreePointersAttempted int     `json:"three_pointers_attempted"`
+	FreeThrowsMade         int     `json:"free_throws_made"`
+	FreeThrowsAttempted    int     `json:"free_throws_attempted"`
+	OffensiveRebounds      int     `json:"offensive_rebounds"`
+	DefensiveRebounds      int     `json:"defensive_rebounds"`
+	Rebounds               int     `json:"rebounds"`
+	Assists                int     `json:"assists"`
+	Steals                 int     `json:"steals"`
+	Blocks                 int     `json:"blocks"`
+	Turnovers              int     `json:"turnovers"`
+	Fouls                  int     `json:"fouls"`
+}
+
+// Played reports whether the line shows the player got on the floor. A line of zeros, as kept for a DNP,
+// does not count as a game played; the same rule is applied in SQL by the aggregate queries.
+func (l PlayerStatLine) Played() bool {
+	return l.MinutesPlayed > 0 || l.Points > 0 || l.Rebounds > 0 || l.Assists > 0 || l.Steals > 0 || l.Blocks > 0 ||
+		l.Turnovers > 0 || l.Fouls > 0 || l.FieldGoalsAttempted > 0 || l.FreeThrowsAttempted > 0
+}
+
+// Totals converts a single stat line into totals over one game.
+func (l PlayerStatLine) Totals() StatTotals {
+	return StatTotals{
+		Games:                  1,
+		Minutes:                float64(l.MinutesPlayed),
+		Points:                 l.Points,
+		FieldGoalsMade:         l.FieldGoalsMade,
+		FieldGoalsAttempted:    l.FieldGoalsAttempted,
+		ThreePointersMade:      l.ThreePointersMade,
+		ThreePointersAttempted: l.ThreePointersAttempted,
+		FreeThrowsMade:         l.FreeThrowsMade,
+		FreeThrowsAttempted:    l.FreeThrowsAttempted,
+		OffensiveRebounds:      l.OffensiveRebounds,
+		DefensiveRebounds:      l.DefensiveRebounds,
+		Rebounds:               l.Rebounds,
+		Assists:                l.Assists,
+		Steals:                 l.Steals,
+		Blocks:                 l.Blocks,
+		Turnovers:              l.Turnovers,
+		Fouls:                  l.Fouls,
+	}
+}
+
+// Add accumulates o into t.
+func (t *StatTotals) Add(o StatTotals) {
+	t.Games += o.Games
+	t.Minutes += o.Minutes
+	t.Points += o.Points
+	t.FieldGoalsMade += o.FieldGoalsMade
+	t.FieldGoalsAttempted += o.FieldGoalsAttempted
+	t.ThreePointersMade += o.ThreePointersMade
+	t.ThreePointersAttempted += o.ThreePointersAttempted
+	t.FreeThrowsMade += o.FreeThrowsMade
+	t.FreeThrowsAttempted += o.FreeThrowsAttempted
+	t.OffensiveRebounds += o.OffensiveRebounds
+	t.DefensiveRebounds += o.DefensiveRebounds
+	t.Rebounds += o.Rebounds
+	t.Assists += o.Assists
+	t.Steals += o.Steals
+	t.Blocks += o.Blocks
+	t.Turnovers += o.Turnovers
+	t.Fouls += o.Fouls
+}
+
+// PlayerSeasonTotals pairs a player's season totals with the totals of the team they played for.
+// Team totals cover every game of that team in the season, which is what team-relative metrics expect.
+type PlayerSeasonTotals struct {
+	PlayerID int64      `json:"player_id"`
+	TeamID   int64      `json:"team_id"`
+	Player   StatTotals `json:"player"`
+	Team     StatTotals `json:"team"`
+	// TeamGameMinutes is the regulation length of the team's games under their rule profiles
+	// (periods × period minutes), summed over the games counted in Team.Games.
+	TeamGameMinutes float64 `json:"team_game_minutes"`
+}
+
+// PlayerGameLine is a stat line together with the date of the game it belongs to.
+type PlayerGameLine struct {
+	PlayerStatLine
+	GameDate time.Time `json:"game_date"`
+}
+
+// BoxScore is the full box score of a game: the header, both teams with their player lines and team totals.
+type BoxScore struct {
+	Game Game         `json:"game"`
+	Home BoxScoreTeam `json:"home"`
+	Away BoxScoreTeam `json:"away"`
+}
+
+// BoxScoreTeam is one side of a box score. Totals count the game once; percentages are fractions in [0, 1].
+type BoxScoreTeam struct {
+	TeamID        int64          `json:"team_id"`
+	Name          string         `json:"name"`
+	Players       []BoxScoreLine `json:"players"`
+	Totals        StatTotals     `json:"totals"`
+	FieldGoalPct  float64        `json:"fg_pct"`
+	ThreePointPct float64        `json:"three_pt_pct"`
+	FreeThrowPct  float64        `json:"ft_pct"`
+}
+
+// BoxScoreLine is a player's line in a box score, with the player's name, position and team for that game.
+type BoxScoreLine struct {
+	PlayerStatLine
+	FirstName string `json:"first_name"`
+	LastName  string `json:"last_name"`
+	Position  string `json:"position"`
+	TeamID    int64  `json:"team_id"`
+	Starter   bool   `json:"starter"`
+	Age       *int   `json:"age"` // on the game date; nil without a birth date
+}
+
+// GameLogEntry is one line of a player's game log: the stat line plus the game it was played in,
+// seen from the side of the team the player was with on the game date.
+type GameLogEntry struct {
+	PlayerStatLine
+	GameDate      time.Time `json:"game_date"`
+	Season        string    `json:"season"`
+	TeamID        int64     `json:"team_id"`
+	OpponentID    int64     `json:"opponent_id"`
+	Home          bool      `json:"home"`
+	Result        string    `json:"result"` // W or L; empty until the game is final
+	TeamScore     *int      `json:"team_score"`
+	OpponentScore *int      `json:"opponent_score"`
+	Age           *int      `json:"age"` // on the game date; nil without a birth date
+}
+
+// GameLogFilter narrows a game log. Nil fields do not filter; From and To are inclusive calendar days in UTC.
+type GameLogFilter struct {
+	Season     *string
+	From       *time.Time
+	To         *time.Time
+	OpponentID *int64
+	Last       int // only the N most recent matching games; 0 for all
+}
+
+// SearchResult is one ranked match of a global name search.
+type SearchResult struct {
+	Type   string  `json:"type"` // team or player
+	ID     int64   `json:"id"`
+	Name   string  `json:"name"`
+	TeamID *int64  `json:"team_id,omitempty"` // the current team of a player
+	Score  float64 `json:"score"`             // trigram similarity in [0, 1]
+}
+
+// Achievement is a statistical feat of a player: a single-game feat such as a triple-double,
+// or a career milestone such as a 1000th point, recorded in the game it was reached.
+type Achievement struct {
+	ID        int64     `json:"id"`
+	PlayerID  int64     `json:"player_id"`
+	GameID    int64     `json:"game_id"`
+	Kind      string    `json:"kind"`
+	Value     int       `json:"value"` // the stat value for single-game feats, the threshold for milestones
+	Milestone bool      `json:"milestone"`
+	GameDate  time.Time `json:"game_date"`
+	Season    string    `json:"season"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// CareerMilestone is a career total worth recording, e.g. 1000 points.
+type CareerMilestone struct {
+	Stat      string // points, rebounds or assists
+	Threshold int
+}
+
+// AchievementFilter narrows the league-wide list of achievements; nil fields are not applied.
+type AchievementFilter struct {
+	Season *string
+	Kind   *string
+}
+
+// GameScoreEntry is John Hollinger's Game Score for a single game.
+type GameScoreEntry struct {
+	GameID    int64     `json:"game_id"`
+	GameDate  time.Time `json:"game_date"`
+	GameScore float64   `json:"game_score"`
+}
+
+// Per36Stats are per-36-minute rates, a common way to compare players with different playing time.
+type Per36Stats struct {
+	Points    float64 `json:"points"`
+	Rebounds  float64 `json:"rebounds"`
+	Assists   float64 `json:"assists"`
+	Steals    float64 `json:"steals"`
+	Blocks    float64 `json:"blocks"`
+	Turnovers float64 `json:"turnovers"`
+}
+
+// LeagueContext holds the season-wide constants the advanced metrics were computed with.
+// They are derived from the stored stat lines of that season, never hard-coded.
+type LeagueContext struct {
+	Pace              float64 `json:"pace"`
+	ValueOfPossession float64 `json:"value_of_possession"`
+	DefReboundPct     float64 `json:"def_rebound_pct"`
+	// AvgUnscaledPER is the minutes-weighted league average of pace-adjusted PER before it is scaled to 15.
+	AvgUnscaledPER float64 `json:"avg_unscaled_per"`
+}
+
+// PlayerAdvancedStats is a read-only set of advanced metrics for one player and season.
+type PlayerAdvancedStats struct {
+	PlayerID         int64            `json:"player_id"`
+	Season           string           `json:"season"`
+	GamesPlayed      int              `json:"games_played"`
+	MinutesPlayed    float64          `json:"minutes_played"`
+	PER              float64          `json:"per"`
+	UsageRate        float64          `json:"usage_rate"` // percentage of team possessions used while on court
+	AssistToTurnover float64          `json:"ast_to_ratio"`
+	AvgGameScore     float64          `json:"avg_game_score"`
+	Per36            Per36Stats       `json:"per_36"`
+	GameScores       []GameScoreEntry `json:"game_scores"`
+	League           LeagueContext    `json:"league"`
+}
@@ -2,6 +2,7 @@ package contract
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -11,18 +12,565 @@ import (
 
 // Team contracts
 
+type LeagueFactory func(t *testing.T) (leagues repository.LeagueRepository, teams repository.TeamRepository, cleanup func())
+
 type TeamFactory func(t *testing.T) (repository.TeamRepository, func())
 
+type VenueFactory func(t *testing.T) (venues repository.VenueRepository, teams repository.TeamRepository, games repository.GameRepository, cleanup func())
+
+// OfficialFactory also returns the game repository and helpers that create a finished game at a given time
+// and record a stat line with the given number of fouls for it.
+type OfficialFactory func(t *testing.T) (officials repository.OfficialRepository, games repository.GameRepository, mkGame func(ctx context.Context, date time.Time) (int64, error), addFouls func(ctx context.Context, gameID int64, fouls int) error, cleanup func())
+
+// AvailabilityFactory also returns helpers that create a player and a finished game with that player's team at home.
+type AvailabilityFactory func(t *testing.T) (repo repository.AvailabilityRepository, players repository.PlayerRepository, stats repository.StatsRepository, mkPlayer func(ctx context.Context) (int64, error), mkGame func(ctx context.Context, playerID int64, date time.Time) (int64, error), cleanup func())
+
+// LineupFactory also returns a helper that creates an in-progress game with six home and five away players.
+type LineupFactory func(t *testing.T) (repo repository.LineupRepository, events repository.EventRepository, mkGame func(ctx context.Context) (gameID, homeID, awayID int64, home, away []int64, err error), cleanup func())
+
+// ShotFactory also returns a helper that creates a player and one in-progress game of their team
+// in each of the 2024-25 and 2025-26 seasons.
+type ShotFactory func(t *testing.T) (repo repository.ShotRepository, mkGames func(ctx context.Context) (playerID int64, games []int64, err error), cleanup func())
+
+// StaffFactory also returns helpers that create a team and a finished game with an official final score.
+type StaffFactory func(t *testing.T) (repo repository.StaffRepository, mkTeam func(ctx context.Context, name string) (int64, error), mkGame func(ctx context.Context, season string, date time.Time, homeID, awayID int64, homeScore, awayScore int) error, cleanup func())
+
 type PlayerFactory func(t *testing.T) (repo repository.PlayerRepository, createTeam func(ctx context.Context, name string) (int64, error), cleanup func())
 
 type GameFactory func(t *testing.T) (repo repository.GameRepository, createTeam func(ctx context.Context, name string) (int64, error), cleanup func())
 
 type StatsFactory func(t *testing.T) (repo repository.StatsRepository, mkPlayer func(ctx context.Context) (int64, error), mkGame func(ctx context.Context) (int64, error), cleanup func())
 
+type EventFactory func(t *testing.T) (repo repository.EventRepository, mkGame func(ctx context.Context) (gameID, teamID, playerID int64, err error), cleanup func())
+
 type TxFactory func(t *testing.T) (tx repository.TxManager, teams repository.TeamRepository, cleanup func())
 
 type PingerFactory func(t *testing.T) (repository.Pinger, func())
 
+func RunLeagueRepositoryContract(t *testing.T, makeRepo LeagueFactory) {
+	t.Helper()
+
+	t.Run("create_get_duplicate", func(t *testing.T) {
+		repo, _, cleanup := makeRepo(t)
+		t.Cleanup(cleanup)
+		ctx := context.Background()
+		l, err := repo.Create(ctx, model.League{Name: "EuroLeague"})
+		if err != nil {
+			t.Fatalf("create: %v", err)
+		}
+		got, err := repo.GetByID(ctx, l.ID)
+		if err != nil || got.Name != "EuroLeague" {
+			t.Fatalf("get: %+v %v", got, err)
+		}
+		if _, err := repo.Create(ctx, model.League{Name: "EuroLeague"}); err != repository.ErrAlreadyExists {
+			t.Fatalf("expected ErrAlreadyExists, got %v", err)
+		}
+		if _, err := repo.GetByID(ctx, 7777777); err != repository.ErrNotFound {
+			t.Fatalf("expected ErrNotFound, got %v", err)
+		}
+	})
+
+	t.Run("teams_are_scoped_to_their_league", func(t *testing.T) {
+		repo, teams, cleanup := makeRepo(t)
+		t.Cleanup(cleanup)
+		ctx := context.Background()
+		l, err := repo.Create(ctx, model.League{Name: "G League"})
+		if err != nil {
+			t.Fatalf("create league: %v", err)
+		}
+		other := repository.WithLeague(ctx, l.ID)
+		home, err := teams.Create(ctx, model.Team{Name: "Lakers"})
+		if err != nil {
+			t.Fatalf("create default team: %v", err)
+		}
+		// Names are unique per league only.
+		away, err := teams.Create(other, model.Team{Name: "Lakers"})
+		if err != nil {
+			t.Fatalf("same name in another league: %v", err)
+		}
+		if _, err := teams.Create(other, model.Team{Name: "Lakers"}); err != repository.ErrAlreadyExists {
+			t.Fatalf("expected ErrAlreadyExists within a league, got %v", err)
+		}
+		if _, err := teams.GetByID(other, home.ID); err != repository.ErrNotFound {
+			t.Fatalf("default team visible in another league: %v", err)
+		}
+		if err := teams.Delete(ctx, away.ID); err != repository.ErrNotFound {
+			t.Fatalf("deleted a team of another league: %v", err)
+		}
+		page, err := teams.List(other, repository.Page{Limit: 10})
+		if err != nil {
+			t.Fatalf("list: %v", err)
+		}
+		if page.Total != 1 || page.Items[0].ID != away.ID {
+			t.Fatalf("list leaked across leagues: %#v", page)
+		}
+	})
+}
+
+func RunVenueRepositoryContract(t *testing.T, makeRepo VenueFactory) {
+	t.Helper()
+
+	t.Run("create_update_duplicate", func(t *testing.T) {
+		repo, _, _, cleanup := makeRepo(t)
+		t.Cleanup(cleanup)
+		ctx := context.Background()
+		lat, lon := 34.043, -118.267
+		v, err := repo.Create(ctx, model.Venue{Name: "Crypto.com Arena", City: "Los Angeles", Capacity: 19079, Timezone: "America/Los_Angeles", Latitude: &lat, Longitude: &lon})
+		if err != nil {
+			t.Fatalf("create: %v", err)
+		}
+		if v.Latitude == nil || *v.Latitude != lat {
+			t.Fatalf("coordinates not stored: %+v", v)
+		}
+		if _, err := repo.Create(ctx, model.Venue{Name: "Crypto.com Arena", City: "Los Angeles", Capacity: 1, Timezone: "UTC"}); err != repository.ErrAlreadyExists {
+			t.Fatalf("expected ErrAlreadyExists, got %v", err)
+		}
+		v.Capacity = 18997
+		got, err := repo.Update(ctx, v)
+		if err != nil || got.Capacity != 18997 {
+			t.Fatalf("update: %+v %v", got, err)
+		}
+		other := repository.WithLeague(ctx, v.ID+1000)
+		if _, err := repo.GetByID(other, v.ID); err != repository.ErrNotFound {
+			t.Fatalf("expected another league not to see the venue, got %v", err)
+		}
+		if _, err := repo.Update(other, v); err != repository.ErrNotFound {
+			t.Fatalf("expected ErrNotFound updating from another league, got %v", err)
+		}
+		if err := repo.Delete(other, v.ID); err != repository.ErrNotFound {
+			t.Fatalf("expected ErrNotFound deleting from another league, got %v", err)
+		}
+		if res, err := repo.List(other, repository.Page{Limit: 10}); err != nil || res.Total != 0 {
+			t.Fatalf("expected no venues in another league, got %+v %v", res, err)
+		}
+		if err := repo.Delete(ctx, v.ID); err != nil {
+			t.Fatalf("delete: %v", err)
+		}
+		if _, err := repo.GetByID(ctx, v.ID); err != repository.ErrNotFound {
+			t.Fatalf("expected ErrNotFound after delete, got %v", err)
+		}
+	})
+
+	t.Run("games_default_to_home_venue_and_tip_off_locally", func(t *testing.T) {
+		repo, teams, games, cleanup := makeRepo(t)
+		t.Cleanup(cleanup)
+		ctx := context.Background()
+		v, err := repo.Create(ctx, model.Venue{Name: "Madison Square Garden", City: "New York", Capacity: 19812, Timezone: "America/New_York"})
+		if err != nil {
+			t.Fatalf("create venue: %v", err)
+		}
+		home, err := teams.Create(ctx, model.Team{Name: "Knicks", HomeVenueID: &v.ID})
+		if err != nil {
+			t.Fatalf("create home: %v", err)
+		}
+		away, err := teams.Create(ctx, model.Team{Name: "Celtics"})
+		if err != nil {
+			t.Fatalf("create away: %v", err)
+		}
+		crowd := 19500
+		tipOff := time.Date(2024, 3, 1, 0, 30, 0, 0, time.UTC)
+		g, err := games.Create(ctx, model.Game{Season: "2023-24", Date: tipOff, HomeTeamID: home.ID, AwayTeamID: away.ID, Status: "finished", Attendance: &crowd})
+		if err != nil {
+			t.Fatalf("create game: %v", err)
+		}
+		if g.VenueID == nil || *g.VenueID != v.ID {
+			t.Fatalf("expected the home venue, got %v", g.VenueID)
+		}
+		if g.LocalTipOff == nil || g.LocalTipOff.Format("2006-01-02 15:04 MST") != "2024-02-29 19:30 EST" {
+			t.Fatalf("unexpected local tip-off: %v", g.LocalTipOff)
+		}
+		if err := repo.Delete(ctx, v.ID); err != repository.ErrConflict {
+			t.Fatalf("expected ErrConflict deleting a venue in use, got %v", err)
+		}
+		rows, err := teams.ListAttendance(ctx, nil, nil)
+		if err != nil {
+			t.Fatalf("attendance: %v", err)
+		}
+		if len(rows) != 1 || rows[0].TeamID != home.ID || rows[0].Games != 1 || rows[0].AverageAttendance != 19500 {
+			t.Fatalf("unexpected attendance: %+v", rows)
+		}
+	})
+}
+
+func RunOfficialRepositoryContract(t *testing.T, makeRepo OfficialFactory) {
+	t.Helper()
+
+	t.Run("assign_reassign_and_crew_order", func(t *testing.T) {
+		repo, _, mkGame, _, cleanup := makeRepo(t)
+		t.Cleanup(cleanup)
+		ctx := context.Background()
+		gameID, err := mkGame(ctx, time.Date(2025, 11, 5, 19, 30, 0, 0, time.UTC))
+		if err != nil {
+			t.Fatalf("create game: %v", err)
+		}
+		ref, _ := repo.Create(ctx, model.Official{FirstName: "Tony", LastName: "Brothers"})
+		chief, err := repo.Create(ctx, model.Official{FirstName: "Scott", LastName: "Foster"})
+		if err != nil {
+			t.Fatalf("create official: %v", err)
+		}
+		if _, err := repo.Assign(ctx, gameID, ref.ID, "crew_chief"); err != nil {
+			t.Fatalf("assign: %v", err)
+		}
+		if _, err := repo.Assign(ctx, gameID, chief.ID, "crew_chief"); err != repository.ErrAlreadyExists {
+			t.Fatalf("expected ErrAlreadyExists for a second crew chief, got %v", err)
+		}
+		if _, err := repo.Assign(ctx, gameID, ref.ID, "referee"); err != nil {
+			t.Fatalf("re-role: %v", err)
+		}
+		if _, err := repo.Assign(ctx, gameID, chief.ID, "crew_chief"); err != nil {
+			t.Fatalf("assign crew chief: %v", err)
+		}
+		crew, err := repo.ListByGame(ctx, gameID)
+		if err != nil || len(crew) != 2 || crew[0].OfficialID != chief.ID || crew[1].Role != "referee" {
+			t.Fatalf("unexpected crew: %+v %v", crew, err)
+		}
+		if _, err := repo.Assign(ctx, gameID+1000, chief.ID, "referee"); err != repository.ErrConflict {
+			t.Fatalf("expected ErrConflict for a missing game, got %v", err)
+		}
+		if err := repo.Unassign(ctx, gameID, ref.ID); err != nil {
+			t.Fatalf("unassign: %v", err)
+		}
+		if err := repo.Unassign(ctx, gameID, ref.ID); err != repository.ErrNotFound {
+			t.Fatalf("expected ErrNotFound, got %v", err)
+		}
+	})
+
+	t.Run("one_game_a_day", func(t *testing.T) {
+		repo, _, mkGame, _, cleanup := makeRepo(t)
+		t.Cleanup(cleanup)
+		ctx := context.Background()
+		early, _ := mkGame(ctx, time.Date(2025, 11, 5, 17, 0, 0, 0, time.UTC))
+		late, _ := mkGame(ctx, time.Date(2025, 11, 5, 22, 0, 0, 0, time.UTC))
+		o, _ := repo.Create(ctx, model.Official{FirstName: "Ed", LastName: "Malloy"})
+		if _, err := repo.Assign(ctx, early, o.ID, "umpire"); err != nil {
+			t.Fatalf("assign: %v", err)
+		}
+		if _, err := repo.Assign(ctx, late, o.ID, "umpire"); err != repository.ErrAlreadyExists {
+			t.Fatalf("expected ErrAlreadyExists for a double booking, got %v", err)
+		}
+		ids, err := repo.GamesOn(ctx, o.ID, time.Date(2025, 11, 5, 0, 0, 0, 0, time.UTC))
+		if err != nil || len(ids) != 1 || ids[0] != early {
+			t.Fatalf("unexpected games on the day: %v %v", ids, err)
+		}
+	})
+
+	t.Run("deleted_games_free_the_day", func(t *testing.T) {
+		repo, games, mkGame, _, cleanup := makeRepo(t)
+		t.Cleanup(cleanup)
+		ctx := context.Background()
+		early, _ := mkGame(ctx, time.Date(2025, 11, 5, 17, 0, 0, 0, time.UTC))
+		late, _ := mkGame(ctx, time.Date(2025, 11, 5, 22, 0, 0, 0, time.UTC))
+		o, _ := repo.Create(ctx, model.Official{FirstName: "Ed", LastName: "Malloy"})
+		if _, err := repo.Assign(ctx, early, o.ID, "umpire"); err != nil {
+			t.Fatalf("assign: %v", err)
+		}
+		if err := games.Delete(ctx, early); err != nil {
+			t.Fatalf("delete game: %v", err)
+		}
+		if _, err := repo.Assign(ctx, late, o.ID, "umpire"); err != nil {
+			t.Fatalf("expected the deleted game to free the day, got %v", err)
+		}
+		ids, err := repo.GamesOn(ctx, o.ID, time.Date(2025, 11, 5, 0, 0, 0, 0, time.UTC))
+		if err != nil || len(ids) != 1 || ids[0] != late {
+			t.Fatalf("unexpected games on the day: %v %v", ids, err)
+		}
+		if _, err := games.Restore(ctx, early); err != repository.ErrAlreadyExists {
+			t.Fatalf("expected ErrAlreadyExists restoring onto a booked day, got %v", err)
+		}
+	})
+
+	t.Run("games_and_fouls_per_game", func(t *testing.T) {
+		repo, _, mkGame, addFouls, cleanup := makeRepo(t)
+		t.Cleanup(cleanup)
+		ctx := context.Background()
+		o, _ := repo.Create(ctx, model.Official{FirstName: "Zach", LastName: "Zarba"})
+		for i, fouls := range []int{3, 6} {
+			gameID, err := mkGame(ctx, time.Date(2025, 11, 5+i, 19, 0, 0, 0, time.UTC))
+			if err != nil {
+				t.Fatalf("create game: %v", err)
+			}
+			if _, err := repo.Assign(ctx, gameID, o.ID, "referee"); err != nil {
+				t.Fatalf("assign: %v", err)
+			}
+			if err := addFouls(ctx, gameID, fouls); err != nil {
+				t.Fatalf("add fouls: %v", err)
+			}
+		}
+		games, err := repo.ListGames(ctx, o.ID, repository.Page{Limit: 10})
+		if err != nil || games.Total != 2 || games.Items[0].Role != "referee" || !games.Items[0].Date.After(games.Items[1].Date) {
+			t.Fatalf("unexpected games: %+v %v", games, err)
+		}
+		sum, err := repo.GetSummary(ctx, o.ID, nil)
+		if err != nil {
+			t.Fatalf("summary: %v", err)
+		}
+		if sum.Games != 2 || sum.Fouls != 9 || sum.FoulsPerGame != 4.5 {
+			t.Fatalf("unexpected summary: %+v", sum)
+		}
+	})
+}
+
+func RunAvailabilityRepositoryContract(t *testing.T, makeRepo AvailabilityFactory) {
+	t.Helper()
+
+	t.Run("set_replace_and_clear", func(t *testing.T) {
+		repo, _, _, mkPlayer, mkGame, cleanup := makeRepo(t)
+		t.Cleanup(cleanup)
+		ctx := context.Background()
+		playerID, err := mkPlayer(ctx)
+		if err != nil {
+			t.Fatalf("create player: %v", err)
+		}
+		gameID, err := mkGame(ctx, playerID, time.Date(2025, 11, 5, 19, 0, 0, 0, time.UTC))
+		if err != nil {
+			t.Fatalf("create game: %v", err)
+		}
+		reason := "left ankle"
+		if _, err := repo.SetAvailability(ctx, model.PlayerAvailability{GameID: gameID, PlayerID: playerID, Status: "injured", Reason: &reason}); err != nil {
+			t.Fatalf("set: %v", err)
+		}
+		a, err := repo.SetAvailability(ctx, model.PlayerAvailability{GameID: gameID, PlayerID: playerID, Status: "suspended"})
+		if err != nil || a.Status != "suspended" || a.Reason != nil {
+			t.Fatalf("unexpected replace: %+v %v", a, err)
+		}
+		items, err := repo.ListAvailability(ctx, gameID)
+		if err != nil || len(items) != 1 {
+			t.Fatalf("unexpected list: %+v %v", items, err)
+		}
+		if _, err := repo.SetAvailability(ctx, model.PlayerAvailability{GameID: gameID + 1000, PlayerID: playerID, Status: "active"}); err != repository.ErrConflict {
+			t.Fatalf("expected ErrConflict for a missing game, got %v", err)
+		}
+		if err := repo.DeleteAvailability(ctx, gameID, playerID); err != nil {
+			t.Fatalf("delete: %v", err)
+		}
+		if _, err := repo.GetAvailability(ctx, gameID, playerID); err != repository.ErrNotFound {
+			t.Fatalf("expected ErrNotFound, got %v", err)
+		}
+	})
+
+	t.Run("games_played_and_available", func(t *testing.T) {
+		repo, players, stats, mkPlayer, mkGame, cleanup := makeRepo(t)
+		t.Cleanup(cleanup)
+		ctx := context.Background()
+		playerID, _ := mkPlayer(ctx)
+		var games []int64
+		for i := 0; i < 3; i++ {
+			gameID, err := mkGame(ctx, playerID, time.Date(2025, 11, 5+i, 19, 0, 0, 0, time.UTC))
+			if err != nil {
+				t.Fatalf("create game: %v", err)
+			}
+			games = append(games, gameID)
+		}
+		// Played, sat on the bench with an empty line, and missed injured.
+		if _, err := stats.UpsertStatLine(ctx, model.PlayerStatLine{PlayerID: playerID, GameID: games[0], Points: 10, FieldGoalsMade: 5, FieldGoalsAttempted: 8, MinutesPlayed: 24}); err != nil {
+			t.Fatalf("upsert: %v", err)
+		}
+		if _, err := stats.UpsertStatLine(ctx, model.PlayerStatLine{PlayerID: playerID, GameID: games[1]}); err != nil {
+			t.Fatalf("upsert: %v", err)
+		}
+		if _, err := repo.SetAvailability(ctx, model.PlayerAvailability{GameID: games[1], PlayerID: playerID, Status: "dnp_coach"}); err != nil {
+			t.Fatalf("set: %v", err)
+		}
+		if _, err := repo.SetAvailability(ctx, model.PlayerAvailability{GameID: games[2], PlayerID: playerID, Status: "injured"}); err != nil {
+			t.Fatalf("set: %v", err)
+		}
+		agg, err := players.GetPlayerAggregatedStats(ctx, playerID, nil, nil)
+		if err != nil {
+			t.Fatalf("aggregate: %v", err)
+		}
+		if agg.GamesPlayed != 1 || agg.GamesAvailable != 2 || agg.AvgPoints != 10 {
+			t.Fatalf("unexpected aggregate: %+v", agg)
+		}
+	})
+
+	t.Run("injury_timeline", func(t *testing.T) {
+		repo, _, _, mkPlayer, _, cleanup := makeRepo(t)
+		t.Cleanup(cleanup)
+		ctx := context.Background()
+		playerID, _ := mkPlayer(ctx)
+		old, err := repo.CreateInjury(ctx, model.Injury{PlayerID: playerID, Description: "Hamstring strain", StartDate: time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC)})
+		if err != nil {
+			t.Fatalf("create injury: %v", err)
+		}
+		recent, _ := repo.CreateInjury(ctx, model.Injury{PlayerID: playerID, Description: "Sprained ankle", StartDate: time.Date(2025, 11, 5, 0, 0, 0, 0, time.UTC)})
+		back := time.Date(2025, 1, 30, 0, 0, 0, 0, time.UTC)
+		old.ReturnedOn = &back
+		if _, err := repo.UpdateInjury(ctx, old); err != nil {
+			t.Fatalf("update injury: %v", err)
+		}
+		items, err := repo.ListInjuries(ctx, playerID)
+		if err != nil || len(items) != 2 || items[0].ID != recent.ID || items[1].ReturnedOn == nil {
+			t.Fatalf("unexpected timeline: %+v %v", items, err)
+		}
+		if _, err := repo.GetInjury(ctx, playerID+1000, old.ID); err != repository.ErrNotFound {
+			t.Fatalf("expected ErrNotFound for another player, got %v", err)
+		}
+	})
+}
+
+func RunLineupRepositoryContract(t *testing.T, makeRepo LineupFactory) {
+	t.Helper()
+
+	t.Run("starters_are_replaced", func(t *testing.T) {
+		repo, _, mkGame, cleanup := makeRepo(t)
+		t.Cleanup(cleanup)
+		ctx := context.Background()
+		gameID, homeID, awayID, home, away, err := mkGame(ctx)
+		if err != nil {
+			t.Fatalf("create game: %v", err)
+		}
+		if _, err := repo.ReplaceStarters(ctx, gameID, homeID, home[:5]); err != nil {
+			t.Fatalf("replace: %v", err)
+		}
+		if _, err := repo.ReplaceStarters(ctx, gameID, awayID, away); err != nil {
+			t.Fatalf("replace: %v", err)
+		}
+		if _, err := repo.ReplaceStarters(ctx, gameID, homeID, home[1:]); err != nil {
+			t.Fatalf("replace again: %v", err)
+		}
+		starters, err := repo.ListStarters(ctx, gameID)
+		if err != nil || len(starters) != 10 || starters[0].TeamID != min(homeID, awayID) {
+			t.Fatalf("unexpected starters: %+v %v", starters, err)
+		}
+		if _, err := repo.ReplaceStarters(ctx, gameID+1000, homeID, home[:5]); err != repository.ErrConflict {
+			t.Fatalf("expected ErrConflict for a missing game, got %v", err)
+		}
+	})
+
+	t.Run("stints_and_lineup_totals", func(t *testing.T) {
+		repo, events, mkGame, cleanup := makeRepo(t)
+		t.Cleanup(cleanup)
+		ctx := context.Background()
+		gameID, homeID, awayID, home, away, err := mkGame(ctx)
+		if err != nil {
+			t.Fatalf("create game: %v", err)
+		}
+		for _, st := range []model.LineupStint{
+			{GameID: gameID, TeamID: homeID, Period: 1, StartClock: 720, EndClock: 360, PlayerIDs: home[:5]},
+			{GameID: gameID, TeamID: homeID, Period: 1, StartClock: 360, EndClock: 0, PlayerIDs: home[1:]},
+			{GameID: gameID, TeamID: awayID, Period: 1, StartClock: 720, EndClock: 0, PlayerIDs: away},
+		} {
+			if _, err := repo.CreateStint(ctx, st); err != nil {
+				t.Fatalf("create stint: %v", err)
+			}
+		}
+		// A basket at 6:00, the moment of the substitution, counts for the unit that left.
+		for _, e := range []model.GameEvent{
+			{GameID: gameID, Period: 1, ClockSeconds: 500, TeamID: homeID, PlayerID: home[0], EventType: "two_pt_made"},
+			{GameID: gameID, Period: 1, ClockSeconds: 360, TeamID: homeID, PlayerID: home[1], EventType: "three_pt_made"},
+			{GameID: gameID, Period: 1, ClockSeconds: 200, TeamID: awayID, PlayerID: away[0], EventType: "two_pt_made"},
+			{GameID: gameID, Period: 1, ClockSeconds: 100, TeamID: homeID, PlayerID: home[5], EventType: "turnover"},
+		} {
+			if _, err := events.Create(ctx, e); err != nil {
+				t.Fatalf("create event: %v", err)
+			}
+		}
+		stints, err := repo.ListStints(ctx, gameID)
+		if err != nil || len(stints) != 3 {
+			t.Fatalf("unexpected stints: %+v %v", stints, err)
+		}
+		rows, err := repo.ListTeamLineups(ctx, homeID, "2025-26")
+		if err != nil || len(rows) != 2 {
+			t.Fatalf("unexpected lineups: %+v %v", rows, err)
+		}
+		starters, second := rows[0], rows[1]
+		if second.PlayerIDs[0] == home[0] {
+			starters, second = second, starters
+		}
+		if starters.Minutes != 6 || starters.PointsFor != 5 || starters.PointsAgainst != 0 || starters.OffensivePossessions != 2 {
+			t.Fatalf("unexpected starting unit: %+v", starters)
+		}
+		if second.PointsFor != 0 || second.PointsAgainst != 2 || second.OffensivePossessions != 1 || second.DefensivePossessions != 1 {
+			t.Fatalf("unexpected second unit: %+v", second)
+		}
+		if err := repo.DeleteStint(ctx, gameID, stints[0].ID); err != nil {
+			t.Fatalf("delete: %v", err)
+		}
+		if err := repo.DeleteStint(ctx, gameID, stints[0].ID); err != repository.ErrNotFound {
+			t.Fatalf("expected ErrNotFound, got %v", err)
+		}
+	})
+}
+
+func RunShotRepositoryContract(t *testing.T, makeRepo ShotFactory) {
+	t.Helper()
+
+	t.Run("create_list_count_delete", func(t *testing.T) {
+		repo, mkGames, cleanup := makeRepo(t)
+		t.Cleanup(cleanup)
+		ctx := context.Background()
+		playerID, games, err := mkGames(ctx)
+		if err != nil {
+			t.Fatalf("create games: %v", err)
+		}
+		var ids []int64
+		for _, s := range []model.Shot{
+			{GameID: games[1], PlayerID: playerID, Period: 2, ClockSeconds: 300, X: 1, Y: 2, ShotType: "two_pt", Made: true, Zone: "restricted_area"},
+			{GameID: games[1], PlayerID: playerID, Period: 1, ClockSeconds: 100, X: -22, Y: 1, ShotType: "three_pt", Made: true, Zone: "corner_3"},
+			{GameID: games[1], PlayerID: playerID, Period: 1, ClockSeconds: 600, X: 0, Y: 25, ShotType: "three_pt", Made: false, Zone: "above_the_break_3"},
+		} {
+			out, err := repo.Create(ctx, s)
+			if err != nil {
+				t.Fatalf("create: %v", err)
+			}
+			ids = append(ids, out.ID)
+		}
+		shots, err := repo.ListByGame(ctx, games[1])
+		if err != nil || len(shots) != 3 || shots[0].ID != ids[2] || shots[2].ID != ids[0] {
+			t.Fatalf("unexpected replay order: %+v %v", shots, err)
+		}
+		counts, err := repo.CountByPlayerGame(ctx, playerID, games[1])
+		if err != nil || counts != (model.ShotCounts{Attempts: 3, Makes: 2, ThreeAttempts: 2, ThreeMakes: 1}) {
+			t.Fatalf("unexpected counts: %+v %v", counts, err)
+		}
+		if _, err := repo.Create(ctx, model.Shot{GameID: games[1] + 1000, PlayerID: playerID, Period: 1, ShotType: "two_pt", Zone: "paint"}); err != repository.ErrConflict {
+			t.Fatalf("expected ErrConflict for a missing game, got %v", err)
+		}
+		if err := repo.Delete(ctx, games[0], ids[0]); err != repository.ErrNotFound {
+			t.Fatalf("expected ErrNotFound for another game, got %v", err)
+		}
+		if err := repo.Delete(ctx, games[1], ids[0]); err != nil {
+			t.Fatalf("delete: %v", err)
+		}
+		if err := repo.LockGame(ctx, games[1]); err != nil {
+			t.Fatalf("lock: %v", err)
+		}
+	})
+
+	t.Run("shot_chart_by_season", func(t *testing.T) {
+		repo, mkGames, cleanup := makeRepo(t)
+		t.Cleanup(cleanup)
+		ctx := context.Background()
+		playerID, games, err := mkGames(ctx)
+		if err != nil {
+			t.Fatalf("create games: %v", err)
+		}
+		for _, s := range []model.Shot{
+			{GameID: games[0], PlayerID: playerID, Period: 1, ClockSeconds: 500, X: 3, Y: 5, ShotType: "two_pt", Made: true, Zone: "paint"},
+			{GameID: games[1], PlayerID: playerID, Period: 1, ClockSeconds: 400, X: -3, Y: 6, ShotType: "two_pt", Made: false, Zone: "paint"},
+			{GameID: games[1], PlayerID: playerID, Period: 1, ClockSeconds: 300, X: 15, Y: 10, ShotType: "two_pt", Made: true, Zone: "mid_range"},
+		} {
+			if _, err := repo.Create(ctx, s); err != nil {
+				t.Fatalf("create: %v", err)
+			}
+		}
+		all, err := repo.GetShotChart(ctx, playerID, nil)
+		if err != nil || len(all) != 2 {
+			t.Fatalf("unexpected career chart: %+v %v", all, err)
+		}
+		for _, z := range all {
+			if z.Zone == "paint" && (z.Attempts != 2 || z.Makes != 1) {
+				t.Fatalf("unexpected paint totals: %+v", z)
+			}
+		}
+		season := "2024-25"
+		one, err := repo.GetShotChart(ctx, playerID, &season)
+		if err != nil || len(one) != 1 || one[0] != (model.ShotZoneStats{Zone: "paint", Attempts: 1, Makes: 1}) {
+			t.Fatalf("unexpected season chart: %+v %v", one, err)
+		}
+	})
+}
+
 func RunTeamRepositoryContract(t *testing.T, makeRepo TeamFactory) {
 	t.Helper()
 
@@ -62,33 +610,214 @@ func RunTeamRepositoryContract(t *testing.T, makeRepo TeamFactory) {
 				t.Fatalf("seed: %v", err)
 			}
 		}
-		res, err := repo.List(ctx, repository.Page{Limit: 3, Offset: 0})
-		if err != nil {
-			t.Fatalf("list: %v", err)
+		res, err := repo.List(ctx, repository.Page{Limit: 3, Offset: 0})
+		if err != nil {
+			t.Fatalf("list: %v", err)
+		}
+		if len(res.Items) != 3 || res.Total != 7 {
+			t.Fatalf("unexpected page: len=%d total=%d", len(res.Items), res.Total)
+		}
+		res2, err := repo.List(ctx, repository.Page{Limit: 3, Offset: 3})
+		if err != nil {
+			t.Fatalf("list2: %v", err)
+		}
+		if len(res2.Items) != 3 || res2.Total != 7 {
+			t.Fatalf("unexpected page2: len=%d total=%d", len(res2.Items), res2.Total)
+		}
+	})
+
+	t.Run("create_duplicate_name_conflict", func(t *testing.T) {
+		repo, cleanup := makeRepo(t)
+		t.Cleanup(cleanup)
+		ctx := context.Background()
+		_, err := repo.Create(ctx, model.Team{Name: "Dup"})
+		if err != nil {
+			t.Fatalf("seed: %v", err)
+		}
+		_, err = repo.Create(ctx, model.Team{Name: "Dup"})
+		if err == nil || err != repository.ErrAlreadyExists {
+			t.Fatalf("expected ErrAlreadyExists, got %v", err)
+		}
+	})
+
+	t.Run("update", func(t *testing.T) {
+		repo, cleanup := makeRepo(t)
+		t.Cleanup(cleanup)
+		ctx := context.Background()
+		created, err := repo.Create(ctx, model.Team{Name: "Sonics"})
+		if err != nil {
+			t.Fatalf("seed: %v", err)
+		}
+		if _, err := repo.Create(ctx, model.Team{Name: "Thunder"}); err != nil {
+			t.Fatalf("seed: %v", err)
+		}
+		created.Name = "SuperSonics"
+		updated, err := repo.Update(ctx, created)
+		if err != nil || updated.Name != "SuperSonics" {
+			t.Fatalf("update: %+v err=%v", updated, err)
+		}
+		created.Name = "Thunder"
+		if _, err := repo.Update(ctx, created); err == nil || err != repository.ErrAlreadyExists {
+			t.Fatalf("expected ErrAlreadyExists on rename to a taken name, got %v", err)
+		}
+		if _, err := repo.Update(ctx, model.Team{ID: 999999, Name: "Ghost"}); err == nil || err != repository.ErrNotFound {
+			t.Fatalf("expected ErrNotFound, got %v", err)
+		}
+	})
+
+	t.Run("soft_delete_and_restore", func(t *testing.T) {
+		repo, cleanup := makeRepo(t)
+		t.Cleanup(cleanup)
+		ctx := context.Background()
+		created, err := repo.Create(ctx, model.Team{Name: "Bobcats"})
+		if err != nil {
+			t.Fatalf("seed: %v", err)
+		}
+		if err := repo.Delete(ctx, created.ID); err != nil {
+			t.Fatalf("delete: %v", err)
+		}
+		if err := repo.Delete(ctx, created.ID); err == nil || err != repository.ErrNotFound {
+			t.Fatalf("expected ErrNotFound on second delete, got %v", err)
+		}
+		if _, err := repo.GetByID(ctx, created.ID); err == nil || err != repository.ErrNotFound {
+			t.Fatalf("expected ErrNotFound for a deleted team, got %v", err)
+		}
+		if ok, err := repo.Exists(ctx, created.ID); err != nil || ok {
+			t.Fatalf("expected a deleted team not to exist: ok=%v err=%v", ok, err)
+		}
+		if res, err := repo.List(ctx, repository.Page{Limit: 10}); err != nil || res.Total != 0 {
+			t.Fatalf("expected deleted team to be unlisted: total=%d err=%v", res.Total, err)
+		}
+		if _, err := repo.Update(ctx, created); err == nil || err != repository.ErrNotFound {
+			t.Fatalf("expected ErrNotFound when updating a deleted team, got %v", err)
+		}
+
+		// The name is free while the team is deleted, so restoring it conflicts until the name is released.
+		reused, err := repo.Create(ctx, model.Team{Name: "Bobcats"})
+		if err != nil {
+			t.Fatalf("expected the name of a deleted team to be reusable: %v", err)
+		}
+		if _, err := repo.Restore(ctx, created.ID); err == nil || err != repository.ErrAlreadyExists {
+			t.Fatalf("expected ErrAlreadyExists on restore, got %v", err)
+		}
+		if err := repo.Delete(ctx, reused.ID); err != nil {
+			t.Fatalf("delete reused: %v", err)
+		}
+		restored, err := repo.Restore(ctx, created.ID)
+		if err != nil || restored.ID != created.ID || restored.Name != "Bobcats" {
+			t.Fatalf("restore: %+v err=%v", restored, err)
+		}
+		if _, err := repo.Restore(ctx, created.ID); err == nil || err != repository.ErrNotFound {
+			t.Fatalf("expected ErrNotFound when restoring an active team, got %v", err)
+		}
+	})
+}
+
+func RunStaffRepositoryContract(t *testing.T, makeRepo StaffFactory) {
+	t.Helper()
+
+	t.Run("tenures_and_team_staff", func(t *testing.T) {
+		repo, mkTeam, _, cleanup := makeRepo(t)
+		t.Cleanup(cleanup)
+		ctx := context.Background()
+		teamID, err := mkTeam(ctx, "Staff Team")
+		if err != nil {
+			t.Fatalf("create team: %v", err)
+		}
+		coach, err := repo.Create(ctx, model.Staff{FirstName: "Joe", LastName: "Mazzulla"})
+		if err != nil {
+			t.Fatalf("create staff: %v", err)
+		}
+		other, _ := repo.Create(ctx, model.Staff{FirstName: "Charles", LastName: "Lee"})
+		start := time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC)
+		head, err := repo.CreateTenure(ctx, model.StaffTenure{StaffID: coach.ID, TeamID: teamID, Role: "head_coach", StartDate: start})
+		if err != nil || head.FirstName != "Joe" || head.EndDate != nil {
+			t.Fatalf("create tenure: %+v %v", head, err)
+		}
+		_, err = repo.CreateTenure(ctx, model.StaffTenure{StaffID: other.ID, TeamID: teamID, Role: "head_coach", StartDate: start})
+		if !errors.Is(err, repository.ErrAlreadyExists) || len(repository.AlreadyExistsFields(err)) != 1 || repository.AlreadyExistsFields(err)[0] != "role" {
+			t.Fatalf("expected an AlreadyExistsError on role for a second head coach, got %v", err)
+		}
+		if _, err := repo.CreateTenure(ctx, model.StaffTenure{StaffID: other.ID, TeamID: teamID + 1000, Role: "trainer", StartDate: start}); err != repository.ErrConflict {
+			t.Fatalf("expected ErrConflict for a missing team, got %v", err)
+		}
+		if _, err := repo.CreateTenure(ctx, model.StaffTenure{StaffID: other.ID, TeamID: teamID, Role: "assistant_coach", StartDate: start}); err != nil {
+			t.Fatalf("create assistant tenure: %v", err)
+		}
+		current, err := repo.ListByTeam(ctx, teamID, nil)
+		if err != nil || len(current) != 2 || current[0].StaffID != coach.ID || current[1].Role != "assistant_coach" {
+			t.Fatalf("unexpected staff: %+v %v", current, err)
+		}
+
+		end := time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+		ended, err := repo.EndTenure(ctx, coach.ID, head.ID, end)
+		if err != nil || ended.EndDate == nil || !ended.EndDate.Equal(end) {
+			t.Fatalf("end tenure: %+v %v", ended, err)
 		}
-		if len(res.Items) != 3 || res.Total != 7 {
-			t.Fatalf("unexpected page: len=%d total=%d", len(res.Items), res.Total)
+		if _, err := repo.EndTenure(ctx, coach.ID, head.ID, end); err != repository.ErrNotFound {
+			t.Fatalf("expected ErrNotFound for an ended tenure, got %v", err)
 		}
-		res2, err := repo.List(ctx, repository.Page{Limit: 3, Offset: 3})
-		if err != nil {
-			t.Fatalf("list2: %v", err)
+		current, _ = repo.ListByTeam(ctx, teamID, nil)
+		if len(current) != 1 || current[0].StaffID != other.ID {
+			t.Fatalf("unexpected current staff: %+v", current)
 		}
-		if len(res2.Items) != 3 || res2.Total != 7 {
-			t.Fatalf("unexpected page2: len=%d total=%d", len(res2.Items), res2.Total)
+		asOf := end.AddDate(0, 0, -1)
+		then, err := repo.ListByTeam(ctx, teamID, &asOf)
+		if err != nil || len(then) != 2 || then[0].StaffID != coach.ID {
+			t.Fatalf("unexpected staff as of %v: %+v %v", asOf, then, err)
+		}
+		tenures, err := repo.ListTenures(ctx, coach.ID)
+		if err != nil || len(tenures) != 1 || tenures[0].ID != head.ID {
+			t.Fatalf("unexpected tenures: %+v %v", tenures, err)
 		}
 	})
 
-	t.Run("create_duplicate_name_conflict", func(t *testing.T) {
-		repo, cleanup := makeRepo(t)
+	t.Run("coach_record_by_season", func(t *testing.T) {
+		repo, mkTeam, mkGame, cleanup := makeRepo(t)
 		t.Cleanup(cleanup)
 		ctx := context.Background()
-		_, err := repo.Create(ctx, model.Team{Name: "Dup"})
+		first, _ := mkTeam(ctx, "First Team")
+		second, _ := mkTeam(ctx, "Second Team")
+		rival, err := mkTeam(ctx, "Rival Team")
 		if err != nil {
-			t.Fatalf("seed: %v", err)
+			t.Fatalf("create team: %v", err)
 		}
-		_, err = repo.Create(ctx, model.Team{Name: "Dup"})
-		if err == nil || err != repository.ErrAlreadyExists {
-			t.Fatalf("expected ErrAlreadyExists, got %v", err)
+		coach, _ := repo.Create(ctx, model.Staff{FirstName: "Doc", LastName: "Rivers"})
+		moved := time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+		if _, err := repo.CreateTenure(ctx, model.StaffTenure{StaffID: coach.ID, TeamID: first, Role: "head_coach", StartDate: time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC), EndDate: &moved}); err != nil {
+			t.Fatalf("create tenure: %v", err)
+		}
+		if _, err := repo.CreateTenure(ctx, model.StaffTenure{StaffID: coach.ID, TeamID: second, Role: "head_coach", StartDate: moved}); err != nil {
+			t.Fatalf("create tenure: %v", err)
+		}
+		games := []struct {
+			season     string
+			date       time.Time
+			home, away int64
+			hs, as     int
+		}{
+			{"2024-25", time.Date(2024, 11, 1, 19, 0, 0, 0, time.UTC), first, rival, 110, 100},
+			{"2024-25", time.Date(2025, 2, 1, 19, 0, 0, 0, time.UTC), rival, first, 101, 99},
+			// Played by the first team after the coach left.
+			{"2025-26", time.Date(2025, 10, 25, 19, 0, 0, 0, time.UTC), first, rival, 120, 90},
+			{"2025-26", time.Date(2025, 11, 1, 19, 0, 0, 0, time.UTC), rival, second, 95, 97},
+		}
+		for _, g := range games {
+			if err := mkGame(ctx, g.season, g.date, g.home, g.away, g.hs, g.as); err != nil {
+				t.Fatalf("create game: %v", err)
+			}
+		}
+		seasons, err := repo.ListCoachSeasons(ctx, coach.ID, nil)
+		if err != nil {
+			t.Fatalf("coach seasons: %v", err)
+		}
+		want := []model.CoachSeasonRecord{{Season: "2024-25", Wins: 1, Losses: 1}, {Season: "2025-26", Wins: 1, Losses: 0}}
+		if len(seasons) != len(want) || seasons[0] != want[0] || seasons[1] != want[1] {
+			t.Fatalf("unexpected seasons: %+v", seasons)
+		}
+		playoffs := "playoffs"
+		if none, err := repo.ListCoachSeasons(ctx, coach.ID, &playoffs); err != nil || len(none) != 0 {
+			t.Fatalf("expected no playoff record: %+v %v", none, err)
 		}
 	})
 }
@@ -140,7 +869,7 @@ func RunPlayerRepositoryContract(t *testing.T, makeRepo PlayerFactory) {
 				t.Fatalf("seed player %d: %v", i, err)
 			}
 		}
-		res, err := repo.ListByTeam(ctx, teamID, repository.Page{Limit: 2, Offset: 0})
+		res, err := repo.ListByTeam(ctx, teamID, nil, repository.Page{Limit: 2, Offset: 0})
 		if err != nil {
 			t.Fatalf("list: %v", err)
 		}
@@ -149,6 +878,127 @@ func RunPlayerRepositoryContract(t *testing.T, makeRepo PlayerFactory) {
 		}
 	})
 
+	t.Run("transfer_and_historical_roster", func(t *testing.T) {
+		repo, mkTeam, cleanup := makeRepo(t)
+		t.Cleanup(cleanup)
+		ctx := context.Background()
+		from, err := mkTeam(ctx, "Nets")
+		if err != nil {
+			t.Fatalf("seed team: %v", err)
+		}
+		to, err := mkTeam(ctx, "Knicks")
+		if err != nil {
+			t.Fatalf("seed team: %v", err)
+		}
+		p, err := repo.Create(ctx, model.Player{TeamID: from, FirstName: "Jason", LastName: "Kidd", Position: "PG"})
+		if err != nil {
+			t.Fatalf("create player: %v", err)
+		}
+		on := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+		m, err := repo.Transfer(ctx, p.ID, to, on)
+		if err != nil {
+			t.Fatalf("transfer: %v", err)
+		}
+		if m.TeamID != to || m.StartDate == nil || m.EndDate != nil {
+			t.Fatalf("unexpected membership: %+v", m)
+		}
+		history, err := repo.ListMemberships(ctx, p.ID)
+		if err != nil || len(history) != 2 {
+			t.Fatalf("history: %v len=%d", err, len(history))
+		}
+		if history[0].TeamID != from || history[0].StartDate != nil || history[0].EndDate == nil || !history[0].EndDate.Equal(on) {
+			t.Fatalf("initial membership not closed on transfer date: %+v", history[0])
+		}
+
+		before := on.AddDate(0, 0, -1)
+		cases := []struct {
+			team int64
+			asOf *time.Time
+			want int
+		}{
+			{from, nil, 0},
+			{to, nil, 1},
+			{from, &before, 1},
+			{to, &before, 0},
+			{from, &on, 0}, // the transfer date already belongs to the new team
+			{to, &on, 1},
+		}
+		for i, tc := range cases {
+			res, err := repo.ListByTeam(ctx, tc.team, tc.asOf, repository.Page{Limit: 10})
+			if err != nil {
+				t.Fatalf("case %d: list: %v", i, err)
+			}
+			if res.Total != tc.want {
+				t.Fatalf("case %d: want %d players, got %d", i, tc.want, res.Total)
+			}
+		}
+		if got, err := repo.TeamOn(ctx, p.ID, before); err != nil || got != from {
+			t.Fatalf("team the day before the transfer: got %d err=%v", got, err)
+		}
+		if got, err := repo.TeamOn(ctx, p.ID, on); err != nil || got != to {
+			t.Fatalf("team on the transfer date: got %d err=%v", got, err)
+		}
+		if _, err := repo.TeamOn(ctx, 99999, on); err == nil || err != repository.ErrNotFound {
+			t.Fatalf("expected ErrNotFound for a player without memberships, got %v", err)
+		}
+	})
+
+	t.Run("bio_and_jersey_uniqueness", func(t *testing.T) {
+		repo, mkTeam, cleanup := makeRepo(t)
+		t.Cleanup(cleanup)
+		ctx := context.Background()
+		bulls, err := mkTeam(ctx, "Bulls")
+		if err != nil {
+			t.Fatalf("seed team: %v", err)
+		}
+		lakers, err := mkTeam(ctx, "Lakers")
+		if err != nil {
+			t.Fatalf("seed team: %v", err)
+		}
+		number, height, born := "23", 198, time.Date(1963, 2, 17, 0, 0, 0, 0, time.UTC)
+		mj, err := repo.Create(ctx, model.Player{TeamID: bulls, FirstName: "Michael", LastName: "Jordan", Position: "SG", PlayerBio: model.PlayerBio{
+			JerseyNumber: &number, HeightCm: &height, BirthDate: &born, SecondaryPositions: []string{"SF"},
+		}})
+		if err != nil {
+			t.Fatalf("create player: %v", err)
+		}
+		got, err := repo.GetByID(ctx, mj.ID)
+		if err != nil || *got.JerseyNumber != "23" || *got.HeightCm != 198 || !got.BirthDate.Equal(born) || len(got.SecondaryPositions) != 1 || got.WeightKg != nil {
+			t.Fatalf("unexpected bio: %+v %v", got, err)
+		}
+
+		dup := model.Player{TeamID: bulls, FirstName: "LeBron", LastName: "James", Position: "SF", PlayerBio: model.PlayerBio{JerseyNumber: &number}}
+		_, err = repo.Create(ctx, dup)
+		if !errors.Is(err, repository.ErrAlreadyExists) || len(repository.AlreadyExistsFields(err)) != 1 || repository.AlreadyExistsFields(err)[0] != "jersey_number" {
+			t.Fatalf("expected ErrAlreadyExists on jersey_number, got %v", err)
+		}
+		dup.TeamID = lakers
+		lbj, err := repo.Create(ctx, dup)
+		if err != nil {
+			t.Fatalf("expected the number to be free on another team: %v", err)
+		}
+
+		// A deleted player releases the number; restoring them conflicts while someone else wears it.
+		if err := repo.Delete(ctx, mj.ID); err != nil {
+			t.Fatalf("delete: %v", err)
+		}
+		m, err := repo.Transfer(ctx, lbj.ID, bulls, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+		if err != nil || m.TeamID != bulls {
+			t.Fatalf("transfer: %+v %v", m, err)
+		}
+		moved, _ := repo.GetByID(ctx, lbj.ID)
+		if moved.JerseyNumber != nil {
+			t.Fatalf("expected the number to stay with the old team, got %v", *moved.JerseyNumber)
+		}
+		moved.JerseyNumber = &number
+		if _, err := repo.Update(ctx, moved); err != nil {
+			t.Fatalf("update: %v", err)
+		}
+		if _, err := repo.Restore(ctx, mj.ID); !errors.Is(err, repository.ErrAlreadyExists) {
+			t.Fatalf("expected ErrAlreadyExists on restore, got %v", err)
+		}
+	})
+
 	t.Run("create_fk_violation_conflict", func(t *testing.T) {
 		repo, _, cleanup := makeRepo(t)
 		t.Cleanup(cleanup)
@@ -157,6 +1007,64 @@ func RunPlayerRepositoryContract(t *testing.T, makeRepo PlayerFactory) {
 			t.Fatalf("expected ErrConflict on FK violation, got %v", err)
 		}
 	})
+
+	t.Run("update_keeps_team", func(t *testing.T) {
+		repo, mkTeam, cleanup := makeRepo(t)
+		t.Cleanup(cleanup)
+		ctx := context.Background()
+		teamID, err := mkTeam(ctx, "Spurs")
+		if err != nil {
+			t.Fatalf("seed team: %v", err)
+		}
+		p, err := repo.Create(ctx, model.Player{TeamID: teamID, FirstName: "Tim", LastName: "Dunkan", Position: "PF"})
+		if err != nil {
+			t.Fatalf("create player: %v", err)
+		}
+		p.LastName, p.Position, p.TeamID = "Duncan", "C", 9999999
+		updated, err := repo.Update(ctx, p)
+		if err != nil {
+			t.Fatalf("update: %v", err)
+		}
+		if updated.LastName != "Duncan" || updated.Position != "C" || updated.TeamID != teamID {
+			t.Fatalf("unexpected update: %+v", updated)
+		}
+	})
+
+	t.Run("soft_delete_and_restore", func(t *testing.T) {
+		repo, mkTeam, cleanup := makeRepo(t)
+		t.Cleanup(cleanup)
+		ctx := context.Background()
+		teamID, err := mkTeam(ctx, "Magic")
+		if err != nil {
+			t.Fatalf("seed team: %v", err)
+		}
+		p, err := repo.Create(ctx, model.Player{TeamID: teamID, FirstName: "Penny", LastName: "Hardaway", Position: "PG"})
+		if err != nil {
+			t.Fatalf("create player: %v", err)
+		}
+		if err := repo.Delete(ctx, p.ID); err != nil {
+			t.Fatalf("delete: %v", err)
+		}
+		if _, err := repo.GetByID(ctx, p.ID); err == nil || err != repository.ErrNotFound {
+			t.Fatalf("expected ErrNotFound for a deleted player, got %v", err)
+		}
+		if ok, err := repo.Exists(ctx, p.ID); err != nil || ok {
+			t.Fatalf("expected a deleted player not to exist: ok=%v err=%v", ok, err)
+		}
+		if res, err := repo.ListByTeam(ctx, teamID, nil, repository.Page{Limit: 10}); err != nil || res.Total != 0 {
+			t.Fatalf("expected deleted player off the roster: total=%d err=%v", res.Total, err)
+		}
+		if err := repo.Delete(ctx, p.ID); err == nil || err != repository.ErrNotFound {
+			t.Fatalf("expected ErrNotFound on second delete, got %v", err)
+		}
+		restored, err := repo.Restore(ctx, p.ID)
+		if err != nil || restored.ID != p.ID {
+			t.Fatalf("restore: %+v err=%v", restored, err)
+		}
+		if res, err := repo.ListByTeam(ctx, teamID, nil, repository.Page{Limit: 10}); err != nil || res.Total != 1 {
+			t.Fatalf("expected restored player back on the roster: total=%d err=%v", res.Total, err)
+		}
+	})
 }
 
 func RunGameRepositoryContract(t *testing.T, makeRepo GameFactory) {
@@ -168,7 +1076,7 @@ func RunGameRepositoryContract(t *testing.T, makeRepo GameFactory) {
 		ctx := context.Background()
 		homeID, _ := mkTeam(ctx, "Home")
 		awayID, _ := mkTeam(ctx, "Away")
-		g, err := repo.Create(ctx, model.Game{Season: "2025", Date: time.Now().UTC(), HomeTeamID: homeID, AwayTeamID: awayID, Status: "scheduled"})
+		g, err := repo.Create(ctx, model.Game{Season: "2025-26", Date: time.Now().UTC(), HomeTeamID: homeID, AwayTeamID: awayID, Status: "scheduled"})
 		if err != nil {
 			t.Fatalf("create game: %v", err)
 		}
@@ -179,6 +1087,9 @@ func RunGameRepositoryContract(t *testing.T, makeRepo GameFactory) {
 		if got.ID != g.ID || got.HomeTeamID != homeID || got.AwayTeamID != awayID {
 			t.Fatalf("mismatch: %+v", got)
 		}
+		if got.RuleProfileID == 0 {
+			t.Fatalf("expected the season's rule profile, got %+v", got)
+		}
 		page, err := repo.List(ctx, repository.Page{Limit: 10, Offset: 0})
 		if err != nil {
 			t.Fatalf("list: %v", err)
@@ -196,6 +1107,158 @@ func RunGameRepositoryContract(t *testing.T, makeRepo GameFactory) {
 			t.Fatalf("expected ErrNotFound, got %v", err)
 		}
 	})
+	t.Run("update_score_replaces_line_score", func(t *testing.T) {
+		repo, mkTeam, cleanup := makeRepo(t)
+		t.Cleanup(cleanup)
+		ctx := context.Background()
+		homeID, _ := mkTeam(ctx, "Home")
+		awayID, _ := mkTeam(ctx, "Away")
+		g, err := repo.Create(ctx, model.Game{Season: "2025-26", Date: time.Now().UTC(), HomeTeamID: homeID, AwayTeamID: awayID, Status: "finished"})
+		if err != nil {
+			t.Fatalf("create game: %v", err)
+		}
+		if g.HomeScore != nil || g.AwayScore != nil {
+			t.Fatalf("expected no score on a new game, got %+v", g)
+		}
+		withOT := model.GameScore{HomeScore: 12, AwayScore: 10, Periods: []model.PeriodScore{
+			{Period: 1, HomePoints: 2, AwayPoints: 2}, {Period: 2, HomePoints: 2, AwayPoints: 2},
+			{Period: 3, HomePoints: 2, AwayPoints: 2}, {Period: 4, HomePoints: 2, AwayPoints: 2},
+			{Period: 5, HomePoints: 4, AwayPoints: 2},
+		}}
+		if _, err := repo.UpdateScore(ctx, g.ID, withOT); err != nil {
+			t.Fatalf("update score: %v", err)
+		}
+		regulation := model.GameScore{HomeScore: 8, AwayScore: 7, Periods: withOT.Periods[:4]}
+		regulation.Periods[3].AwayPoints = 1
+		got, err := repo.UpdateScore(ctx, g.ID, regulation)
+		if err != nil {
+			t.Fatalf("update score again: %v", err)
+		}
+		if got.HomeScore == nil || *got.HomeScore != 8 || got.AwayScore == nil || *got.AwayScore != 7 {
+			t.Fatalf("unexpected score: %+v", got)
+		}
+		periods, err := repo.ListPeriodScores(ctx, g.ID)
+		if err != nil {
+			t.Fatalf("list periods: %v", err)
+		}
+		if len(periods) != 4 {
+			t.Fatalf("expected stale overtime to be removed, got %d periods", len(periods))
+		}
+		if played, err := repo.CountPeriodsPlayed(ctx, g.ID); err != nil || played != 4 {
+			t.Fatalf("expected 4 periods played, got %d (%v)", played, err)
+		}
+	})
+
+	t.Run("update_score_not_found", func(t *testing.T) {
+		repo, _, cleanup := makeRepo(t)
+		t.Cleanup(cleanup)
+		_, err := repo.UpdateScore(context.Background(), 7777777, model.GameScore{HomeScore: 1, AwayScore: 0})
+		if err == nil || err != repository.ErrNotFound {
+			t.Fatalf("expected ErrNotFound, got %v", err)
+		}
+	})
+
+	t.Run("update", func(t *testing.T) {
+		repo, mkTeam, cleanup := makeRepo(t)
+		t.Cleanup(cleanup)
+		ctx := context.Background()
+		homeID, _ := mkTeam(ctx, "Home")
+		awayID, _ := mkTeam(ctx, "Away")
+		g, err := repo.Create(ctx, model.Game{Season: "2025-26", Date: time.Now().UTC(), HomeTeamID: homeID, AwayTeamID: awayID, Status: "scheduled"})
+		if err != nil {
+			t.Fatalf("create game: %v", err)
+		}
+		g.Date = g.Date.Add(24 * time.Hour)
+		g.Status = "in_progress" // only Transition changes the status
+		updated, err := repo.Update(ctx, g)
+		if err != nil {
+			t.Fatalf("update: %v", err)
+		}
+		if updated.Status != "scheduled" || !updated.Date.Equal(g.Date) || updated.HomeTeamID != homeID {
+			t.Fatalf("unexpected update: %+v", updated)
+		}
+		g.Phase = "preseason" // the seeded seasons have no preseason
+		if _, err := repo.Update(ctx, g); err == nil || err != repository.ErrConflict {
+			t.Fatalf("expected ErrConflict for a phase the season lacks, got %v", err)
+		}
+	})
+
+	t.Run("transition_records_history", func(t *testing.T) {
+		repo, mkTeam, cleanup := makeRepo(t)
+		t.Cleanup(cleanup)
+		ctx := context.Background()
+		homeID, _ := mkTeam(ctx, "Home")
+		awayID, _ := mkTeam(ctx, "Away")
+		g, err := repo.Create(ctx, model.Game{Season: "2025-26", Date: time.Now().UTC(), HomeTeamID: homeID, AwayTeamID: awayID, Status: "scheduled"})
+		if err != nil {
+			t.Fatalf("create game: %v", err)
+		}
+		tr, err := repo.Transition(ctx, g.ID, "scheduled", "in_progress")
+		if err != nil {
+			t.Fatalf("transition: %v", err)
+		}
+		if tr.ID == 0 || tr.TransitionedAt.IsZero() || tr.ToStatus != "in_progress" {
+			t.Fatalf("unexpected transition: %+v", tr)
+		}
+		// A stale from status means someone else moved the game first.
+		if _, err := repo.Transition(ctx, g.ID, "scheduled", "postponed"); err == nil || err != repository.ErrConflict {
+			t.Fatalf("expected ErrConflict for a stale from status, got %v", err)
+		}
+		if _, err := repo.Transition(ctx, g.ID, "in_progress", "finished"); err != nil {
+			t.Fatalf("transition: %v", err)
+		}
+		got, err := repo.GetByID(ctx, g.ID)
+		if err != nil || got.Status != "finished" {
+			t.Fatalf("expected finished game: %+v err=%v", got, err)
+		}
+		history, err := repo.ListTransitions(ctx, g.ID)
+		if err != nil {
+			t.Fatalf("list transitions: %v", err)
+		}
+		if len(history) != 2 || history[0].FromStatus != "scheduled" || history[1].ToStatus != "finished" {
+			t.Fatalf("unexpected history: %+v", history)
+		}
+	})
+
+	t.Run("soft_delete_and_restore", func(t *testing.T) {
+		repo, mkTeam, cleanup := makeRepo(t)
+		t.Cleanup(cleanup)
+		ctx := context.Background()
+		homeID, _ := mkTeam(ctx, "Home")
+		awayID, _ := mkTeam(ctx, "Away")
+		g, err := repo.Create(ctx, model.Game{Season: "2025-26", Date: time.Now().UTC(), HomeTeamID: homeID, AwayTeamID: awayID, Status: "finished"})
+		if err != nil {
+			t.Fatalf("create game: %v", err)
+		}
+		if _, err := repo.UpdateScore(ctx, g.ID, model.GameScore{HomeScore: 100, AwayScore: 90}); err != nil {
+			t.Fatalf("update score: %v", err)
+		}
+		if err := repo.Delete(ctx, g.ID); err != nil {
+			t.Fatalf("delete: %v", err)
+		}
+		if _, err := repo.GetByID(ctx, g.ID); err == nil || err != repository.ErrNotFound {
+			t.Fatalf("expected ErrNotFound for a deleted game, got %v", err)
+		}
+		if page, err := repo.List(ctx, repository.Page{Limit: 10}); err != nil || page.Total != 0 {
+			t.Fatalf("expected deleted game to be unlisted: total=%d err=%v", page.Total, err)
+		}
+		if _, err := repo.UpdateScore(ctx, g.ID, model.GameScore{HomeScore: 1, AwayScore: 0}); err == nil || err != repository.ErrNotFound {
+			t.Fatalf("expected ErrNotFound when scoring a deleted game, got %v", err)
+		}
+		if _, err := repo.GetBoxScore(ctx, g.ID); err == nil || err != repository.ErrNotFound {
+			t.Fatalf("expected ErrNotFound for the box score of a deleted game, got %v", err)
+		}
+		restored, err := repo.Restore(ctx, g.ID)
+		if err != nil {
+			t.Fatalf("restore: %v", err)
+		}
+		if restored.HomeScore == nil || *restored.HomeScore != 100 {
+			t.Fatalf("expected the score to survive a delete: %+v", restored)
+		}
+		if _, err := repo.Restore(ctx, g.ID); err == nil || err != repository.ErrNotFound {
+			t.Fatalf("expected ErrNotFound when restoring an active game, got %v", err)
+		}
+	})
 }
 
 func RunStatsRepositoryContract(t *testing.T, makeRepo StatsFactory) {
@@ -238,6 +1301,34 @@ func RunStatsRepositoryContract(t *testing.T, makeRepo StatsFactory) {
 		}
 	})
 
+	t.Run("delete_stat_line", func(t *testing.T) {
+		repo, mkPlayer, mkGame, cleanup := makeRepo(t)
+		t.Cleanup(cleanup)
+		ctx := context.Background()
+		pid, err := mkPlayer(ctx)
+		if err != nil {
+			t.Fatalf("mkPlayer: %v", err)
+		}
+		gid, err := mkGame(ctx)
+		if err != nil {
+			t.Fatalf("mkGame: %v", err)
+		}
+		if _, err := repo.UpsertStatLine(ctx, model.PlayerStatLine{PlayerID: pid, GameID: gid}); err != nil {
+			t.Fatalf("upsert: %v", err)
+		}
+		if err := repo.DeleteStatLine(ctx, pid, gid); err != nil {
+			t.Fatalf("delete: %v", err)
+		}
+		// Deleting again is a no-op.
+		if err := repo.DeleteStatLine(ctx, pid, gid); err != nil {
+			t.Fatalf("delete twice: %v", err)
+		}
+		list, err := repo.ListByGame(ctx, gid)
+		if err != nil || len(list) != 0 {
+			t.Fatalf("expected no lines, got %d err=%v", len(list), err)
+		}
+	})
+
 	t.Run("list_empty_ok", func(t *testing.T) {
 		repo, _, mkGame, cleanup := makeRepo(t)
 		t.Cleanup(cleanup)
@@ -256,6 +1347,90 @@ func RunStatsRepositoryContract(t *testing.T, makeRepo StatsFactory) {
 	})
 }
 
+func RunEventRepositoryContract(t *testing.T, makeRepo EventFactory) {
+	t.Helper()
+
+	t.Run("create_list_in_replay_order", func(t *testing.T) {
+		repo, mkGame, cleanup := makeRepo(t)
+		t.Cleanup(cleanup)
+		ctx := context.Background()
+		gid, tid, pid, err := mkGame(ctx)
+		if err != nil {
+			t.Fatalf("mkGame: %v", err)
+		}
+		// Inserted out of order on purpose.
+		for _, e := range []model.GameEvent{
+			{GameID: gid, Period: 2, ClockSeconds: 700, TeamID: tid, PlayerID: pid, EventType: "foul"},
+			{GameID: gid, Period: 1, ClockSeconds: 100, TeamID: tid, PlayerID: pid, EventType: "two_pt_made"},
+			{GameID: gid, Period: 1, ClockSeconds: 500, TeamID: tid, PlayerID: pid, EventType: "assist"},
+		} {
+			if _, err := repo.Create(ctx, e); err != nil {
+				t.Fatalf("create: %v", err)
+			}
+		}
+		list, err := repo.ListByGame(ctx, gid)
+		if err != nil {
+			t.Fatalf("list: %v", err)
+		}
+		if len(list) != 3 || list[0].EventType != "assist" || list[1].EventType != "two_pt_made" || list[2].EventType != "foul" {
+			t.Fatalf("unexpected order: %+v", list)
+		}
+		byPlayer, err := repo.ListByPlayerGame(ctx, pid, gid)
+		if err != nil || len(byPlayer) != 3 {
+			t.Fatalf("list by player: len=%d err=%v", len(byPlayer), err)
+		}
+	})
+
+	t.Run("update_and_delete", func(t *testing.T) {
+		repo, mkGame, cleanup := makeRepo(t)
+		t.Cleanup(cleanup)
+		ctx := context.Background()
+		gid, tid, pid, err := mkGame(ctx)
+		if err != nil {
+			t.Fatalf("mkGame: %v", err)
+		}
+		ev, err := repo.Create(ctx, model.GameEvent{GameID: gid, Period: 1, ClockSeconds: 300, TeamID: tid, PlayerID: pid, EventType: "steal"})
+		if err != nil {
+			t.Fatalf("create: %v", err)
+		}
+		ev.EventType = "block"
+		updated, err := repo.Update(ctx, ev)
+		if err != nil || updated.EventType != "block" {
+			t.Fatalf("update: %+v err=%v", updated, err)
+		}
+		if err := repo.Delete(ctx, ev.ID); err != nil {
+			t.Fatalf("delete: %v", err)
+		}
+		if err := repo.Delete(ctx, ev.ID); err == nil || err != repository.ErrNotFound {
+			t.Fatalf("expected ErrNotFound on second delete, got %v", err)
+		}
+		if _, err := repo.GetByID(ctx, ev.ID); err == nil || err != repository.ErrNotFound {
+			t.Fatalf("expected ErrNotFound, got %v", err)
+		}
+	})
+
+	t.Run("invalid_type_rejected", func(t *testing.T) {
+		repo, mkGame, cleanup := makeRepo(t)
+		t.Cleanup(cleanup)
+		ctx := context.Background()
+		gid, tid, pid, err := mkGame(ctx)
+		if err != nil {
+			t.Fatalf("mkGame: %v", err)
+		}
+		if _, err := repo.Create(ctx, model.GameEvent{GameID: gid, Period: 1, ClockSeconds: 1, TeamID: tid, PlayerID: pid, EventType: "bogus"}); err == nil {
+			t.Fatalf("expected CHECK violation for unknown event type")
+		}
+	})
+
+	t.Run("lock_game_not_found", func(t *testing.T) {
+		repo, _, cleanup := makeRepo(t)
+		t.Cleanup(cleanup)
+		if err := repo.LockGame(context.Background(), 8888888); err == nil || err != repository.ErrNotFound {
+			t.Fatalf("expected ErrNotFound, got %v", err)
+		}
+	})
+}
+
 func RunTxManagerContract(t *testing.T, makeTx TxFactory) {
 	t.Helper()
 
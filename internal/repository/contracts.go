@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/maxviazov/basketball-stats-service/internal/model"
 )
@@ -22,38 +23,294 @@ type TxManager interface {
 	WithinTx(ctx context.Context, fn TxFunc) error
 }
 
+// LeagueRepository declares persistence operations for leagues.
+// It is the only repository that is not scoped by the league in the context.
+type LeagueRepository interface {
+	// Create stores a league; ErrAlreadyExists if the name is taken.
+	Create(ctx context.Context, l model.League) (model.League, error)
+	GetByID(ctx context.Context, id int64) (model.League, error)
+	List(ctx context.Context, p Page) (PageResult[model.League], error)
+}
+
+// VenueRepository declares persistence operations for venues. Like rule profiles, venues are shared by
+// every league and are not scoped by the league in the context.
+type VenueRepository interface {
+	// Create stores a venue; ErrAlreadyExists if the city already has a venue with that name.
+	Create(ctx context.Context, v model.Venue) (model.Venue, error)
+	GetByID(ctx context.Context, id int64) (model.Venue, error)
+	List(ctx context.Context, p Page) (PageResult[model.Venue], error)
+	Update(ctx context.Context, v model.Venue) (model.Venue, error)
+	// Delete removes a venue; ErrConflict while a team or a game still references it.
+	Delete(ctx context.Context, id int64) error
+}
+
 // TeamRepository declares persistence operations for teams.
 // I return domain models and surface domain errors from errors.go rather than PG codes.
+// Deletes are soft: a deleted team is ErrNotFound for every read and write until it is restored.
 type TeamRepository interface {
 	Create(ctx context.Context, t model.Team) (model.Team, error)
 	GetByID(ctx context.Context, id int64) (model.Team, error)
 	List(ctx context.Context, p Page) (PageResult[model.Team], error)
 	Exists(ctx context.Context, id int64) (bool, error)
-	// GetTeamAggregatedStats calculates a team's performance stats, optionally filtered by season.
-	// A nil season returns career stats across all seasons.
-	GetTeamAggregatedStats(ctx context.Context, teamID int64, season *string) (model.TeamAggregatedStats, error)
+	Update(ctx context.Context, t model.Team) (model.Team, error)
+	Delete(ctx context.Context, id int64) error
+	// Restore undeletes a team; ErrNotFound if it is not deleted, ErrAlreadyExists if its name was taken meanwhile.
+	Restore(ctx context.Context, id int64) (model.Team, error)
+	// GetTeamAggregatedStats calculates a team's performance stats, optionally filtered by season and phase.
+	// A nil season returns career stats across all seasons; a nil phase includes every phase.
+	GetTeamAggregatedStats(ctx context.Context, teamID int64, season, phase *string) (model.TeamAggregatedStats, error)
+	// ListStandings returns the unranked record of every team in a season, optionally limited to one phase,
+	// in a single query. Rank, win percentage and games behind are left to the caller.
+	ListStandings(ctx context.Context, season string, phase *string) ([]model.TeamStanding, error)
+	// GetTeamSplits breaks a team's record down by the given split dimensions, in dimension order.
+	GetTeamSplits(ctx context.Context, teamID int64, season, phase *string, dimensions []string) ([]model.TeamSplit, error)
+	// ListAttendance returns home attendance per team and season, optionally limited to one season or team.
+	ListAttendance(ctx context.Context, season *string, teamID *int64) ([]model.TeamAttendance, error)
 }
 
 // PlayerRepository declares persistence operations for players.
+// Deletes are soft; a deleted player's stat lines stay stored but drop out of every aggregate.
 type PlayerRepository interface {
+	// Create stores a player; an AlreadyExistsError on jersey_number if an active teammate wears the number.
 	Create(ctx context.Context, p model.Player) (model.Player, error)
 	GetByID(ctx context.Context, id int64) (model.Player, error)
-	ListByTeam(ctx context.Context, teamID int64, p Page) (PageResult[model.Player], error)
+	// Update stores the name, position and bio of a player; the team only changes through Transfer.
+	// An AlreadyExistsError on jersey_number if an active teammate wears the number.
+	Update(ctx context.Context, p model.Player) (model.Player, error)
+	Delete(ctx context.Context, id int64) error
+	// Restore undeletes a player; ErrNotFound if it is not deleted, an AlreadyExistsError if their
+	// jersey number was taken meanwhile.
+	Restore(ctx context.Context, id int64) (model.Player, error)
+	// ListByTeam returns the roster of a team: the current one for a nil asOf, the historical one otherwise.
+	ListByTeam(ctx context.Context, teamID int64, asOf *time.Time, p Page) (PageResult[model.Player], error)
 	Exists(ctx context.Context, id int64) (bool, error)
-	// GetPlayerAggregatedStats calculates a player's stats, optionally filtered by season.
-	// A nil season returns career stats.
-	GetPlayerAggregatedStats(ctx context.Context, playerID int64, season *string) (model.PlayerAggregatedStats, error)
+	// GetPlayerAggregatedStats calculates a player's stats, optionally filtered by season and phase.
+	// A nil season returns career stats; a nil phase includes every phase.
+	GetPlayerAggregatedStats(ctx context.Context, playerID int64, season, phase *string) (model.PlayerAggregatedStats, error)
+	// GetPlayerSplits breaks a player's aggregates down by the given split dimensions, in dimension order.
+	GetPlayerSplits(ctx context.Context, playerID int64, season, phase *string, dimensions []string) ([]model.PlayerSplit, error)
+	// ListMemberships returns a player's roster history, oldest first.
+	ListMemberships(ctx context.Context, playerID int64) ([]model.Membership, error)
+	// TeamOn returns the team a player was a member of on the given day; ErrNotFound if they were on no roster.
+	TeamOn(ctx context.Context, playerID int64, on time.Time) (int64, error)
+	// Transfer ends the open membership on the given date and starts a new one with teamID. The player leaves
+	// their jersey number behind. Callers should run it inside a transaction since it touches more than one table.
+	Transfer(ctx context.Context, playerID, teamID int64, on time.Time) (model.Membership, error)
+}
+
+// AvailabilityRepository stores per-game availability records and injury timelines.
+// Both are reached through the player, so every method only sees players of the league in context.
+type AvailabilityRepository interface {
+	// SetAvailability inserts or replaces a player's record for a game; ErrConflict if the game or the player
+	// is not in the league.
+	SetAvailability(ctx context.Context, a model.PlayerAvailability) (model.PlayerAvailability, error)
+	// GetAvailability returns a player's record for a game; ErrNotFound if none was recorded.
+	GetAvailability(ctx context.Context, gameID, playerID int64) (model.PlayerAvailability, error)
+	ListAvailability(ctx context.Context, gameID int64) ([]model.PlayerAvailability, error)
+	// DeleteAvailability removes a record; ErrNotFound if none was recorded.
+	DeleteAvailability(ctx context.Context, gameID, playerID int64) error
+	CreateInjury(ctx context.Context, inj model.Injury) (model.Injury, error)
+	// GetInjury returns an injury of the given player; ErrNotFound for another player's injury.
+	GetInjury(ctx context.Context, playerID, injuryID int64) (model.Injury, error)
+	UpdateInjury(ctx context.Context, inj model.Injury) (model.Injury, error)
+	// ListInjuries returns a player's injury timeline, most recent first.
+	ListInjuries(ctx context.Context, playerID int64) ([]model.Injury, error)
+}
+
+// LeadersRepository ranks players by a single box score column.
+type LeadersRepository interface {
+	// ListLeaders ranks qualified players by q.Stat, which must pass IsLeaderStat.
+	ListLeaders(ctx context.Context, q model.LeaderQuery) ([]model.LeaderEntry, error)
+}
+
+// AchievementRepository stores the feats detected from stat lines.
+// Both write methods replace what was stored before, so a corrected line also revokes feats it no longer qualifies for.
+type AchievementRepository interface {
+	// ReplaceGameFeats replaces a player's single-game feats for a game; nil feats revokes them all.
+	ReplaceGameFeats(ctx context.Context, playerID, gameID int64, feats []model.Achievement) error
+	// SyncMilestones recomputes a player's career milestones from all their stat lines in game date order.
+	// Callers should run it in the transaction that changed the lines.
+	SyncMilestones(ctx context.Context, playerID int64, milestones []model.CareerMilestone) error
+	// ListByPlayer returns a player's achievements, most recent game first.
+	ListByPlayer(ctx context.Context, playerID int64, p Page) (PageResult[model.Achievement], error)
+	// List returns achievements across the league, most recent game first.
+	List(ctx context.Context, f model.AchievementFilter, p Page) (PageResult[model.Achievement], error)
+}
+
+// SearchRepository finds teams and players by name.
+type SearchRepository interface {
+	// Search ranks teams and players whose names are similar to q, best match first; a nil kind searches both.
+	Search(ctx context.Context, q string, kind *string, p Page) (PageResult[model.SearchResult], error)
+}
+
+// SeasonRepository declares persistence operations for seasons and their phases.
+type SeasonRepository interface {
+	// Create stores a season with its phases; callers should run it inside a transaction.
+	// A zero RuleProfileID means NBA rules; ErrConflict if the profile does not exist.
+	Create(ctx context.Context, s model.Season) (model.Season, error)
+	GetByID(ctx context.Context, id int64) (model.Season, error)
+	GetByName(ctx context.Context, name string) (model.Season, error)
+	List(ctx context.Context, p Page) (PageResult[model.Season], error)
+}
+
+// OfficialRepository declares persistence operations for officials and their game assignments.
+type OfficialRepository interface {
+	Create(ctx context.Context, o model.Official) (model.Official, error)
+	GetByID(ctx context.Context, id int64) (model.Official, error)
+	List(ctx context.Context, p Page) (PageResult[model.Official], error)
+	// Assign puts an official on a game's crew or changes their role on it. ErrAlreadyExists if the official
+	// already works another game that day or the game already has a crew chief; ErrConflict if the game or
+	// the official is not in the league.
+	Assign(ctx context.Context, gameID, officialID int64, role string) (model.GameOfficial, error)
+	// Unassign takes an official off a game's crew; ErrNotFound if they were not on it.
+	Unassign(ctx context.Context, gameID, officialID int64) error
+	// ListByGame returns a game's crew, crew chief first.
+	ListByGame(ctx context.Context, gameID int64) ([]model.GameOfficial, error)
+	// GamesOn returns the ids of the games an official is assigned to on a calendar day in UTC,
+	// leaving out postponed, cancelled and deleted games.
+	GamesOn(ctx context.Context, officialID int64, day time.Time) ([]int64, error)
+	// ListGames returns the games an official was assigned to, most recent first.
+	ListGames(ctx context.Context, officialID int64, p Page) (PageResult[model.OfficialGame], error)
+	// GetSummary totals the fouls called in the finished games an official worked; a nil season means career.
+	GetSummary(ctx context.Context, officialID int64, season *string) (model.OfficialSummary, error)
+}
+
+// StaffRepository declares persistence operations for coaches and trainers and their tenures with teams.
+type StaffRepository interface {
+	Create(ctx context.Context, s model.Staff) (model.Staff, error)
+	GetByID(ctx context.Context, id int64) (model.Staff, error)
+	List(ctx context.Context, p Page) (PageResult[model.Staff], error)
+	// Lock takes row locks on the staff member and the team so concurrent tenure writes for either see each
+	// other. It only has an effect inside a transaction; ErrNotFound if the staff member is not in the league.
+	// A missing team is left to the caller's own check.
+	Lock(ctx context.Context, staffID, teamID int64) error
+	// CreateTenure stores a tenure; ErrConflict if the team is not in the league, and an AlreadyExistsError
+	// naming role if the team already has an open head coach tenure.
+	CreateTenure(ctx context.Context, t model.StaffTenure) (model.StaffTenure, error)
+	// EndTenure sets the end date of a staff member's current tenure; ErrNotFound if they have no such tenure
+	// or it has already ended.
+	EndTenure(ctx context.Context, staffID, tenureID int64, end time.Time) (model.StaffTenure, error)
+	// ListTenures returns a staff member's tenures, oldest first.
+	ListTenures(ctx context.Context, staffID int64) ([]model.StaffTenure, error)
+	// ListTeamTenures returns every tenure on a team's staff, oldest first.
+	ListTeamTenures(ctx context.Context, teamID int64) ([]model.StaffTenure, error)
+	// ListByTeam returns a team's staff on a date, or its current staff when asOf is nil, head coach first.
+	ListByTeam(ctx context.Context, teamID int64, asOf *time.Time) ([]model.StaffTenure, error)
+	// ListCoachSeasons counts the wins and losses of the finished games a staff member's teams played during
+	// their head coach tenures, one row per season in season order. A nil phase includes every phase.
+	ListCoachSeasons(ctx context.Context, staffID int64, phase *string) ([]model.CoachSeasonRecord, error)
+}
+
+// RuleProfileRepository declares persistence operations for competition rule profiles.
+// Profiles are shared by every league.
+type RuleProfileRepository interface {
+	// Create stores a profile; ErrAlreadyExists if the name is taken.
+	Create(ctx context.Context, p model.RuleProfile) (model.RuleProfile, error)
+	GetByID(ctx context.Context, id int64) (model.RuleProfile, error)
+	List(ctx context.Context, p Page) (PageResult[model.RuleProfile], error)
 }
 
 // GameRepository declares persistence operations for games.
+// Deletes are soft; a deleted game drops out of results, standings and every aggregate.
 type GameRepository interface {
+	// Create stores a game in the season named by g.Season; ErrConflict if that season or the rule profile does not exist.
+	// A zero RuleProfileID takes the season's profile.
 	Create(ctx context.Context, g model.Game) (model.Game, error)
 	GetByID(ctx context.Context, id int64) (model.Game, error)
 	List(ctx context.Context, p Page) (PageResult[model.Game], error)
+	// Update stores the date and phase of a game; ErrConflict if its season has no such phase.
+	Update(ctx context.Context, g model.Game) (model.Game, error)
+	Delete(ctx context.Context, id int64) error
+	// Restore undeletes a game; ErrNotFound if it is not deleted.
+	Restore(ctx context.Context, id int64) (model.Game, error)
+	// Transition moves a game from one status to another and records the move.
+	// ErrConflict if the game is no longer in the from status. Callers should run it inside a transaction.
+	Transition(ctx context.Context, id int64, from, to string) (model.GameTransition, error)
+	// ListTransitions returns the status history of a game, oldest first.
+	ListTransitions(ctx context.Context, gameID int64) ([]model.GameTransition, error)
+	// UpdateScore stores the official final score and replaces the line score of a game.
+	// Callers should run it inside a transaction since it touches more than one table.
+	UpdateScore(ctx context.Context, id int64, s model.GameScore) (model.Game, error)
+	ListPeriodScores(ctx context.Context, gameID int64) ([]model.PeriodScore, error)
+	// CountPeriodsPlayed returns the latest period reached by the line score or the event stream of a game,
+	// the same count the player_stats rules trigger uses for overtimes.
+	CountPeriodsPlayed(ctx context.Context, gameID int64) (int, error)
+	// GetPlayerPointTotals sums player_stats points per side of a game, used to reconcile the official score.
+	GetPlayerPointTotals(ctx context.Context, gameID int64) (home int, away int, err error)
+	// GetBoxScore loads the game with both team names and every player line joined with its player, starters first.
+	// Team totals and percentages are left to the caller.
+	GetBoxScore(ctx context.Context, gameID int64) (model.BoxScore, error)
 }
 
 // StatsRepository declares operations for player stat lines per game.
 type StatsRepository interface {
 	UpsertStatLine(ctx context.Context, s model.PlayerStatLine) (model.PlayerStatLine, error)
 	ListByGame(ctx context.Context, gameID int64) ([]model.PlayerStatLine, error)
+	// ListByPlayer returns a player's game log, most recent game first.
+	ListByPlayer(ctx context.Context, playerID int64, f model.GameLogFilter, p Page) (PageResult[model.GameLogEntry], error)
+	// DeleteStatLine removes a player's line for a game; deleting a missing line is not an error.
+	DeleteStatLine(ctx context.Context, playerID, gameID int64) error
+}
+
+// EventRepository declares persistence operations for play-by-play events.
+type EventRepository interface {
+	Create(ctx context.Context, e model.GameEvent) (model.GameEvent, error)
+	GetByID(ctx context.Context, id int64) (model.GameEvent, error)
+	Update(ctx context.Context, e model.GameEvent) (model.GameEvent, error)
+	Delete(ctx context.Context, id int64) error
+	// ListByGame returns events in replay order: period ascending, clock descending.
+	ListByGame(ctx context.Context, gameID int64) ([]model.GameEvent, error)
+	ListByPlayerGame(ctx context.Context, playerID, gameID int64) ([]model.GameEvent, error)
+	// LockGame takes a row lock on the game so concurrent event writes rebuild lines from a consistent stream.
+	// It only has an effect inside a transaction.
+	LockGame(ctx context.Context, gameID int64) error
+}
+
+// ShotRepository stores charted shots. Shots are reached through the game, so every method only sees
+// games of the league in context.
+type ShotRepository interface {
+	// Create stores a shot; ErrConflict if the game or the player is not in the league.
+	Create(ctx context.Context, s model.Shot) (model.Shot, error)
+	// ListByGame returns a game's shots in replay order: period ascending, clock descending.
+	ListByGame(ctx context.Context, gameID int64) ([]model.Shot, error)
+	// Delete removes a shot of the game; ErrNotFound if the game has no such shot.
+	Delete(ctx context.Context, gameID, shotID int64) error
+	CountByPlayerGame(ctx context.Context, playerID, gameID int64) (model.ShotCounts, error)
+	// LockGame takes a row lock on the game so concurrent shot writes count each other. It only has an effect
+	// inside a transaction.
+	LockGame(ctx context.Context, gameID int64) error
+	// GetShotChart totals a player's shots per zone, optionally limited to a season. Zones without
+	// attempts are omitted and percentages are left to the caller.
+	GetShotChart(ctx context.Context, playerID int64, season *string) ([]model.ShotZoneStats, error)
+}
+
+// LineupRepository stores starters and five-man lineup stints. Both are reached through the game,
+// so every method only sees games of the league in context.
+type LineupRepository interface {
+	// ReplaceStarters sets a team's starters for a game, dropping any recorded before; ErrConflict if the game
+	// is not in the league.
+	ReplaceStarters(ctx context.Context, gameID, teamID int64, playerIDs []int64) ([]model.GameStarter, error)
+	ListStarters(ctx context.Context, gameID int64) ([]model.GameStarter, error)
+	// CreateStint stores a stint; ErrConflict if the game is not in the league.
+	CreateStint(ctx context.Context, st model.LineupStint) (model.LineupStint, error)
+	// ListStints returns a game's stints by team, period and clock, earliest first.
+	ListStints(ctx context.Context, gameID int64) ([]model.LineupStint, error)
+	// DeleteStint removes a stint of the game; ErrNotFound if the game has no such stint.
+	DeleteStint(ctx context.Context, gameID, stintID int64) error
+	// LockGame takes a row lock on the game so concurrent stint writes see each other. It only has an effect
+	// inside a transaction.
+	LockGame(ctx context.Context, gameID int64) error
+	// ListTeamLineups sums a team's stints per five-man unit over the games of a season,
+	// most minutes first. Ratings are left to the caller.
+	ListTeamLineups(ctx context.Context, teamID int64, season string) ([]model.LineupStats, error)
+}
+
+// MetricsRepository provides the raw season totals advanced metrics are computed from.
+// It deliberately returns sums, not ratios, so every formula lives in one place in the service layer.
+type MetricsRepository interface {
+	// ListSeasonTotals returns one row per player with at least one stat line in the season,
+	// each paired with the season totals of the player's team.
+	ListSeasonTotals(ctx context.Context, season string) ([]model.PlayerSeasonTotals, error)
+	// ListPlayerSeasonLines returns a player's stat lines of the season in game date order.
+	ListPlayerSeasonLines(ctx context.Context, playerID int64, season string) ([]model.PlayerGameLine, error)
 }
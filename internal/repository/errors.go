@@ -2,6 +2,7 @@ package repository
 
 import (
 	"errors"
+	"strings"
 
 	"github.com/jackc/pgerrcode"
 	"github.com/jackc/pgx/v5/pgconn"
@@ -14,6 +15,33 @@ var (
 	ErrConflict      = errors.New("conflict")
 )
 
+// AlreadyExistsError is an ErrAlreadyExists that names the request fields whose values collided,
+// so clients learn what to change. Only unique indexes listed in uniqueFields produce it.
+type AlreadyExistsError struct {
+	Constraint string
+	Fields     []string
+}
+
+func (e *AlreadyExistsError) Error() string {
+	return ErrAlreadyExists.Error() + ": " + strings.Join(e.Fields, ", ")
+}
+func (e *AlreadyExistsError) Unwrap() error { return ErrAlreadyExists }
+
+// uniqueFields maps unique indexes to the fields a client sets to hit them.
+var uniqueFields = map[string][]string{
+	"ux_players_team_jersey_active":       {"jersey_number"},
+	"ux_staff_tenures_head_coach_current": {"role"},
+}
+
+// AlreadyExistsFields returns the colliding fields carried by err, or nil for a bare ErrAlreadyExists.
+func AlreadyExistsFields(err error) []string {
+	var e *AlreadyExistsError
+	if errors.As(err, &e) {
+		return e.Fields
+	}
+	return nil
+}
+
 // MapPgError translates common Postgres error codes to domain errors.
 // I only map what I expect to handle explicitly at higher layers; everything else passes through.
 func MapPgError(err error) error {
@@ -24,6 +52,9 @@ func MapPgError(err error) error {
 	if errors.As(err, &pgErr) {
 		switch pgErr.Code {
 		case pgerrcode.UniqueViolation:
+			if fields, ok := uniqueFields[pgErr.ConstraintName]; ok {
+				return &AlreadyExistsError{Constraint: pgErr.ConstraintName, Fields: fields}
+			}
 			return ErrAlreadyExists
 		case pgerrcode.ForeignKeyViolation:
 			return ErrConflict
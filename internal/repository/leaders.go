@@ -0,0 +1,18 @@
+package repository
+
+// leaderColumns whitelists the player_stats columns a leaderboard can rank by.
+// Implementations interpolate the column name into their query, so nothing outside this set may reach it.
+var leaderColumns = map[string]bool{
+	"points": true, "rebounds": true, "offensive_rebounds": true, "defensive_rebounds": true,
+	"assists": true, "steals": true, "blocks": true, "fouls": true, "turnovers": true,
+	"field_goals_made": true, "field_goals_attempted": true,
+	"three_pointers_made": true, "three_pointers_attempted": true,
+	"free_throws_made": true, "free_throws_attempted": true,
+	"minutes_played": true,
+}
+
+// IsLeaderStat reports whether a leaderboard can rank by stat. Services validate input against it
+// and LeadersRepository implementations guard their query with it.
+func IsLeaderStat(stat string) bool {
+	return leaderColumns[stat]
+}
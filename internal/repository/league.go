@@ -0,0 +1,24 @@
+package repository
+
+import "context"
+
+// DefaultLeagueID is the league seeded by migration 013. Data from before leagues existed lives there,
+// and calls made without a league scope read and write it.
+const DefaultLeagueID int64 = 1
+
+type leagueKey struct{}
+
+// WithLeague scopes every repository call made with the returned context to one league.
+// I carry the league in the context, like the transaction, so no repository signature has to change
+// and a call site cannot forget to pass it.
+func WithLeague(ctx context.Context, leagueID int64) context.Context {
+	return context.WithValue(ctx, leagueKey{}, leagueID)
+}
+
+// LeagueID returns the league the context is scoped to, or DefaultLeagueID when it is not scoped.
+func LeagueID(ctx context.Context) int64 {
+	if id, ok := ctx.Value(leagueKey{}).(int64); ok && id > 0 {
+		return id
+	}
+	return DefaultLeagueID
+}
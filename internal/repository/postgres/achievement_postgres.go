@@ -0,0 +1,176 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/maxviazov/basketball-stats-service/internal/model"
+	"github.com/maxviazov/basketball-stats-service/internal/repository"
+)
+
+type achievementRepository struct{ pool *pgxpool.Pool }
+
+func NewAchievementRepository(pool *pgxpool.Pool) repository.AchievementRepository {
+	return &achievementRepository{pool: pool}
+}
+
+// achievementColumns is the projection for model.Achievement over player_achievements a joined with games g;
+// keep it in sync with scanAchievement.
+const achievementColumns = `a.id, a.player_id, a.game_id, a.kind, a.value, a.milestone, g.date, g.season, a.created_at`
+
+func scanAchievement(row pgx.Row, a *model.Achievement, extra ...any) error {
+	dest := []any{&a.ID, &a.PlayerID, &a.GameID, &a.Kind, &a.Value, &a.Milestone, &a.GameDate, &a.Season, &a.CreatedAt}
+	return row.Scan(append(dest, extra...)...)
+}
+
+func (r *achievementRepository) ReplaceGameFeats(ctx context.Context, playerID, gameID int64, feats []model.Achievement) error {
+	if err := ensurePool(r.pool); err != nil {
+		return err
+	}
+	exec := getQ(ctx, r.pool)
+	if _, err := exec.Exec(ctx,
+		`DELETE FROM player_achievements
+		 WHERE player_id = $1 AND game_id = $2 AND NOT milestone
+		   AND game_id IN (SELECT id FROM games WHERE league_id = $3)`,
+		playerID, gameID, repository.LeagueID(ctx),
+	); err != nil {
+		return repository.MapPgError(err)
+	}
+	if len(feats) == 0 {
+		return nil
+	}
+	kinds := make([]string, 0, len(feats))
+	values := make([]int32, 0, len(feats))
+	for _, f := range feats {
+		kinds = append(kinds, f.Kind)
+		values = append(values, int32(f.Value))
+	}
+	if _, err := exec.Exec(ctx,
+		`INSERT INTO player_achievements (player_id, game_id, kind, value)
+		 SELECT $1, g.id, f.kind, f.value
+		 FROM UNNEST($3::TEXT[], $4::INT[]) AS f(kind, value)
+		 INNER JOIN games g ON g.id = $2 AND g.league_id = $5`,
+		playerID, gameID, kinds, values, repository.LeagueID(ctx),
+	); err != nil {
+		return repository.MapPgError(err)
+	}
+	return nil
+}
+
+// SyncMilestones runs a running total per stat over the player's lines in game order and records every milestone
+// in the first game that reached it. Milestones are deleted and re-inserted, since correcting an old line can move
+// a milestone to a later game or revoke it.
+func (r *achievementRepository) SyncMilestones(ctx context.Context, playerID int64, milestones []model.CareerMilestone) error {
+	if err := ensurePool(r.pool); err != nil {
+		return err
+	}
+	exec := getQ(ctx, r.pool)
+	if _, err := exec.Exec(ctx,
+		`DELETE FROM player_achievements
+		 WHERE player_id = $1 AND milestone AND player_id IN (SELECT id FROM players WHERE league_id = $2)`,
+		playerID, repository.LeagueID(ctx),
+	); err != nil {
+		return repository.MapPgError(err)
+	}
+	if len(milestones) == 0 {
+		return nil
+	}
+	stats := make([]string, 0, len(milestones))
+	thresholds := make([]int32, 0, len(milestones))
+	for _, m := range milestones {
+		stats = append(stats, m.Stat)
+		thresholds = append(thresholds, int32(m.Threshold))
+	}
+	if _, err := exec.Exec(ctx,
+		`WITH running AS (
+			SELECT ps.game_id,
+				SUM(ps.points) OVER w AS points,
+				SUM(ps.rebounds) OVER w AS rebounds,
+				SUM(ps.assists) OVER w AS assists,
+				ROW_NUMBER() OVER w AS seq
+			FROM player_stats ps
+			INNER JOIN games g ON g.id = ps.game_id AND g.league_id = $4 AND g.deleted_at IS NULL
+			WHERE ps.player_id = $1
+			WINDOW w AS (ORDER BY g.date, g.id)
+		),
+		reached AS (
+			SELECT DISTINCT ON (m.stat, m.threshold) m.stat, m.threshold, r.game_id
+			FROM UNNEST($2::TEXT[], $3::INT[]) AS m(stat, threshold)
+			INNER JOIN running r ON CASE m.stat
+				WHEN 'points' THEN r.points
+				WHEN 'rebounds' THEN r.rebounds
+				WHEN 'assists' THEN r.assists
+			END >= m.threshold
+			ORDER BY m.stat, m.threshold, r.seq
+		)
+		INSERT INTO player_achievements (player_id, game_id, kind, value, milestone)
+		SELECT $1, game_id, 'career_' || stat, threshold, TRUE
+		FROM reached`,
+		playerID, stats, thresholds, repository.LeagueID(ctx),
+	); err != nil {
+		return repository.MapPgError(err)
+	}
+	return nil
+}
+
+func (r *achievementRepository) ListByPlayer(ctx context.Context, playerID int64, p repository.Page) (repository.PageResult[model.Achievement], error) {
+	if err := ensurePool(r.pool); err != nil {
+		return repository.PageResult[model.Achievement]{}, err
+	}
+	limit, offset := sanitizeLimitOffset(p.Limit, p.Offset)
+	exec := getQ(ctx, r.pool)
+	rows, err := exec.Query(ctx,
+		`SELECT `+achievementColumns+`, COUNT(*) OVER() AS total
+		 FROM player_achievements a
+		 INNER JOIN games g ON g.id = a.game_id AND g.league_id = $4 AND g.deleted_at IS NULL
+		 WHERE a.player_id = $1
+		 ORDER BY g.date DESC, a.game_id DESC, a.id
+		 LIMIT $2 OFFSET $3`,
+		playerID, limit, offset, repository.LeagueID(ctx),
+	)
+	if err != nil {
+		return repository.PageResult[model.Achievement]{}, repository.MapPgError(err)
+	}
+	defer rows.Close()
+	return collectAchievements(rows, limit)
+}
+
+func (r *achievementRepository) List(ctx context.Context, f model.AchievementFilter, p repository.Page) (repository.PageResult[model.Achievement], error) {
+	if err := ensurePool(r.pool); err != nil {
+		return repository.PageResult[model.Achievement]{}, err
+	}
+	limit, offset := sanitizeLimitOffset(p.Limit, p.Offset)
+	exec := getQ(ctx, r.pool)
+	rows, err := exec.Query(ctx,
+		`SELECT `+achievementColumns+`, COUNT(*) OVER() AS total
+		 FROM player_achievements a
+		 INNER JOIN games g ON g.id = a.game_id AND g.league_id = $5 AND g.deleted_at IS NULL
+		 INNER JOIN players p ON p.id = a.player_id AND p.deleted_at IS NULL
+		 WHERE ($1::TEXT IS NULL OR g.season = $1) AND ($2::TEXT IS NULL OR a.kind = $2)
+		 ORDER BY g.date DESC, a.game_id DESC, a.id
+		 LIMIT $3 OFFSET $4`,
+		f.Season, f.Kind, limit, offset, repository.LeagueID(ctx),
+	)
+	if err != nil {
+		return repository.PageResult[model.Achievement]{}, repository.MapPgError(err)
+	}
+	defer rows.Close()
+	return collectAchievements(rows, limit)
+}
+
+func collectAchievements(rows pgx.Rows, limit int) (repository.PageResult[model.Achievement], error) {
+	res := repository.PageResult[model.Achievement]{Items: make([]model.Achievement, 0, limit)}
+	for rows.Next() {
+		var it model.Achievement
+		var total int
+		if err := scanAchievement(rows, &it, &total); err != nil {
+			return repository.PageResult[model.Achievement]{}, repository.MapPgError(err)
+		}
+		res.Items = append(res.Items, it)
+		res.Total = total
+	}
+	return res, nil
+}
+
+var _ repository.AchievementRepository = (*achievementRepository)(nil)
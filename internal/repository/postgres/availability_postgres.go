@@ -0,0 +1,216 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/maxviazov/basketball-stats-service/internal/model"
+	"github.com/maxviazov/basketball-stats-service/internal/repository"
+)
+
+type availabilityRepository struct{ pool *pgxpool.Pool }
+
+func NewAvailabilityRepository(pool *pgxpool.Pool) repository.AvailabilityRepository {
+	return &availabilityRepository{pool: pool}
+}
+
+const availabilityColumns = `game_id, player_id, status, reason, created_at, updated_at`
+
+func scanAvailability(row pgx.Row, a *model.PlayerAvailability) error {
+	return row.Scan(&a.GameID, &a.PlayerID, &a.Status, &a.Reason, &a.CreatedAt, &a.UpdatedAt)
+}
+
+const injuryColumns = `id, player_id, description, start_date, expected_return_date, returned_on, created_at, updated_at`
+
+func scanInjury(row pgx.Row, inj *model.Injury) error {
+	return row.Scan(&inj.ID, &inj.PlayerID, &inj.Description, &inj.StartDate, &inj.ExpectedReturnDate, &inj.ReturnedOn, &inj.CreatedAt, &inj.UpdatedAt)
+}
+
+// SetAvailability upserts in one statement; selecting the game and the player from the league turns
+// a reference into another league into no row, reported like a dangling foreign key.
+func (r *availabilityRepository) SetAvailability(ctx context.Context, a model.PlayerAvailability) (model.PlayerAvailability, error) {
+	if err := ensurePool(r.pool); err != nil {
+		return model.PlayerAvailability{}, err
+	}
+	exec := getQ(ctx, r.pool)
+	row := exec.QueryRow(ctx,
+		`INSERT INTO player_game_availability (game_id, player_id, status, reason)
+		 SELECT g.id, p.id, $3, $4
+		 FROM games g
+		 INNER JOIN players p ON p.id = $2 AND p.league_id = g.league_id AND p.deleted_at IS NULL
+		 WHERE g.id = $1 AND g.league_id = $5 AND g.deleted_at IS NULL
+		 ON CONFLICT (game_id, player_id) DO UPDATE SET status = EXCLUDED.status, reason = EXCLUDED.reason, updated_at = NOW()
+		 RETURNING `+availabilityColumns,
+		a.GameID, a.PlayerID, a.Status, a.Reason, repository.LeagueID(ctx),
+	)
+	var out model.PlayerAvailability
+	if err := scanAvailability(row, &out); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.PlayerAvailability{}, repository.ErrConflict
+		}
+		return model.PlayerAvailability{}, repository.MapPgError(err)
+	}
+	return out, nil
+}
+
+func (r *availabilityRepository) GetAvailability(ctx context.Context, gameID, playerID int64) (model.PlayerAvailability, error) {
+	if err := ensurePool(r.pool); err != nil {
+		return model.PlayerAvailability{}, err
+	}
+	exec := getQ(ctx, r.pool)
+	row := exec.QueryRow(ctx,
+		`SELECT `+availabilityColumns+`
+		 FROM player_game_availability
+		 WHERE game_id = $1 AND player_id = $2 AND player_id IN (SELECT id FROM players WHERE league_id = $3)`,
+		gameID, playerID, repository.LeagueID(ctx),
+	)
+	var out model.PlayerAvailability
+	if err := scanAvailability(row, &out); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.PlayerAvailability{}, repository.ErrNotFound
+		}
+		return model.PlayerAvailability{}, repository.MapPgError(err)
+	}
+	return out, nil
+}
+
+func (r *availabilityRepository) ListAvailability(ctx context.Context, gameID int64) ([]model.PlayerAvailability, error) {
+	if err := ensurePool(r.pool); err != nil {
+		return nil, err
+	}
+	exec := getQ(ctx, r.pool)
+	rows, err := exec.Query(ctx,
+		`SELECT `+availabilityColumns+`
+		 FROM player_game_availability
+		 WHERE game_id = $1 AND player_id IN (SELECT id FROM players WHERE league_id = $2)
+		 ORDER BY player_id`, gameID, repository.LeagueID(ctx),
+	)
+	if err != nil {
+		return nil, repository.MapPgError(err)
+	}
+	defer rows.Close()
+	res := make([]model.PlayerAvailability, 0, 16)
+	for rows.Next() {
+		var it model.PlayerAvailability
+		if err := scanAvailability(rows, &it); err != nil {
+			return nil, repository.MapPgError(err)
+		}
+		res = append(res, it)
+	}
+	return res, nil
+}
+
+func (r *availabilityRepository) DeleteAvailability(ctx context.Context, gameID, playerID int64) error {
+	if err := ensurePool(r.pool); err != nil {
+		return err
+	}
+	exec := getQ(ctx, r.pool)
+	tag, err := exec.Exec(ctx,
+		`DELETE FROM player_game_availability
+		 WHERE game_id = $1 AND player_id = $2 AND player_id IN (SELECT id FROM players WHERE league_id = $3)`,
+		gameID, playerID, repository.LeagueID(ctx),
+	)
+	if err != nil {
+		return repository.MapPgError(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return repository.ErrNotFound
+	}
+	return nil
+}
+
+func (r *availabilityRepository) CreateInjury(ctx context.Context, inj model.Injury) (model.Injury, error) {
+	if err := ensurePool(r.pool); err != nil {
+		return model.Injury{}, err
+	}
+	exec := getQ(ctx, r.pool)
+	row := exec.QueryRow(ctx,
+		`INSERT INTO player_injuries (player_id, description, start_date, expected_return_date, returned_on)
+		 SELECT p.id, $2, $3, $4, $5
+		 FROM players p
+		 WHERE p.id = $1 AND p.league_id = $6 AND p.deleted_at IS NULL
+		 RETURNING `+injuryColumns,
+		inj.PlayerID, inj.Description, inj.StartDate, inj.ExpectedReturnDate, inj.ReturnedOn, repository.LeagueID(ctx),
+	)
+	var out model.Injury
+	if err := scanInjury(row, &out); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Injury{}, repository.ErrConflict
+		}
+		return model.Injury{}, repository.MapPgError(err)
+	}
+	return out, nil
+}
+
+func (r *availabilityRepository) GetInjury(ctx context.Context, playerID, injuryID int64) (model.Injury, error) {
+	if err := ensurePool(r.pool); err != nil {
+		return model.Injury{}, err
+	}
+	exec := getQ(ctx, r.pool)
+	row := exec.QueryRow(ctx,
+		`SELECT `+injuryColumns+`
+		 FROM player_injuries
+		 WHERE id = $1 AND player_id = $2 AND player_id IN (SELECT id FROM players WHERE league_id = $3)`,
+		injuryID, playerID, repository.LeagueID(ctx),
+	)
+	var out model.Injury
+	if err := scanInjury(row, &out); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Injury{}, repository.ErrNotFound
+		}
+		return model.Injury{}, repository.MapPgError(err)
+	}
+	return out, nil
+}
+
+func (r *availabilityRepository) UpdateInjury(ctx context.Context, inj model.Injury) (model.Injury, error) {
+	if err := ensurePool(r.pool); err != nil {
+		return model.Injury{}, err
+	}
+	exec := getQ(ctx, r.pool)
+	row := exec.QueryRow(ctx,
+		`UPDATE player_injuries
+		 SET description = $3, expected_return_date = $4, returned_on = $5, updated_at = NOW()
+		 WHERE id = $1 AND player_id = $2 AND player_id IN (SELECT id FROM players WHERE league_id = $6)
+		 RETURNING `+injuryColumns,
+		inj.ID, inj.PlayerID, inj.Description, inj.ExpectedReturnDate, inj.ReturnedOn, repository.LeagueID(ctx),
+	)
+	var out model.Injury
+	if err := scanInjury(row, &out); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Injury{}, repository.ErrNotFound
+		}
+		return model.Injury{}, repository.MapPgError(err)
+	}
+	return out, nil
+}
+
+func (r *availabilityRepository) ListInjuries(ctx context.Context, playerID int64) ([]model.Injury, error) {
+	if err := ensurePool(r.pool); err != nil {
+		return nil, err
+	}
+	exec := getQ(ctx, r.pool)
+	rows, err := exec.Query(ctx,
+		`SELECT `+injuryColumns+`
+		 FROM player_injuries
+		 WHERE player_id = $1 AND player_id IN (SELECT id FROM players WHERE league_id = $2)
+		 ORDER BY start_date DESC, id DESC`, playerID, repository.LeagueID(ctx),
+	)
+	if err != nil {
+		return nil, repository.MapPgError(err)
+	}
+	defer rows.Close()
+	res := make([]model.Injury, 0, 4)
+	for rows.Next() {
+		var it model.Injury
+		if err := scanInjury(rows, &it); err != nil {
+			return nil, repository.MapPgError(err)
+		}
+		res = append(res, it)
+	}
+	return res, nil
+}
+
+var _ repository.AvailabilityRepository = (*availabilityRepository)(nil)
@@ -0,0 +1,170 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/maxviazov/basketball-stats-service/internal/model"
+	"github.com/maxviazov/basketball-stats-service/internal/repository"
+)
+
+type eventRepository struct{ pool *pgxpool.Pool }
+
+func NewEventRepository(pool *pgxpool.Pool) repository.EventRepository {
+	return &eventRepository{pool: pool}
+}
+
+// eventColumns is the canonical projection for model.GameEvent; keep it in sync with scanEvent.
+const eventColumns = `id, game_id, period, clock_seconds, team_id, player_id, event_type, created_at, updated_at`
+
+// eventOrder is the replay order of a game's event stream.
+const eventOrder = `ORDER BY period, clock_seconds DESC, id`
+
+func scanEvent(row pgx.Row, e *model.GameEvent) error {
+	return row.Scan(&e.ID, &e.GameID, &e.Period, &e.ClockSeconds, &e.TeamID, &e.PlayerID, &e.EventType, &e.CreatedAt, &e.UpdatedAt)
+}
+
+// Create only adds an event to a game of the league; any other game is reported as ErrConflict.
+func (r *eventRepository) Create(ctx context.Context, e model.GameEvent) (model.GameEvent, error) {
+	if err := ensurePool(r.pool); err != nil {
+		return model.GameEvent{}, err
+	}
+	exec := getQ(ctx, r.pool)
+	row := exec.QueryRow(ctx,
+		`INSERT INTO game_events (game_id, period, clock_seconds, team_id, player_id, event_type)
+		 SELECT g.id, $2, $3, $4, $5, $6 FROM games g WHERE g.id = $1 AND g.league_id = $7
+		 RETURNING `+eventColumns,
+		e.GameID, e.Period, e.ClockSeconds, e.TeamID, e.PlayerID, e.EventType, repository.LeagueID(ctx),
+	)
+	var out model.GameEvent
+	if err := scanEvent(row, &out); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.GameEvent{}, repository.ErrConflict
+		}
+		return model.GameEvent{}, repository.MapPgError(err)
+	}
+	return out, nil
+}
+
+func (r *eventRepository) GetByID(ctx context.Context, id int64) (model.GameEvent, error) {
+	if err := ensurePool(r.pool); err != nil {
+		return model.GameEvent{}, err
+	}
+	exec := getQ(ctx, r.pool)
+	row := exec.QueryRow(ctx,
+		`SELECT `+eventColumns+` FROM game_events WHERE id = $1 AND game_id IN (SELECT id FROM games WHERE league_id = $2)`,
+		id, repository.LeagueID(ctx),
+	)
+	var out model.GameEvent
+	if err := scanEvent(row, &out); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.GameEvent{}, repository.ErrNotFound
+		}
+		return model.GameEvent{}, repository.MapPgError(err)
+	}
+	return out, nil
+}
+
+// Update rewrites every mutable field of an event; the game it belongs to cannot change.
+func (r *eventRepository) Update(ctx context.Context, e model.GameEvent) (model.GameEvent, error) {
+	if err := ensurePool(r.pool); err != nil {
+		return model.GameEvent{}, err
+	}
+	exec := getQ(ctx, r.pool)
+	row := exec.QueryRow(ctx,
+		`UPDATE game_events
+		 SET period = $2, clock_seconds = $3, team_id = $4, player_id = $5, event_type = $6, updated_at = NOW()
+		 WHERE id = $1 AND game_id IN (SELECT id FROM games WHERE league_id = $7)
+		 RETURNING `+eventColumns,
+		e.ID, e.Period, e.ClockSeconds, e.TeamID, e.PlayerID, e.EventType, repository.LeagueID(ctx),
+	)
+	var out model.GameEvent
+	if err := scanEvent(row, &out); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.GameEvent{}, repository.ErrNotFound
+		}
+		return model.GameEvent{}, repository.MapPgError(err)
+	}
+	return out, nil
+}
+
+func (r *eventRepository) Delete(ctx context.Context, id int64) error {
+	if err := ensurePool(r.pool); err != nil {
+		return err
+	}
+	exec := getQ(ctx, r.pool)
+	tag, err := exec.Exec(ctx,
+		`DELETE FROM game_events WHERE id = $1 AND game_id IN (SELECT id FROM games WHERE league_id = $2)`,
+		id, repository.LeagueID(ctx),
+	)
+	if err != nil {
+		return repository.MapPgError(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return repository.ErrNotFound
+	}
+	return nil
+}
+
+func (r *eventRepository) ListByGame(ctx context.Context, gameID int64) ([]model.GameEvent, error) {
+	return r.list(ctx,
+		`SELECT `+eventColumns+` FROM game_events
+		 WHERE game_id = $1 AND game_id IN (SELECT id FROM games WHERE league_id = $2) `+eventOrder,
+		gameID, repository.LeagueID(ctx),
+	)
+}
+
+func (r *eventRepository) ListByPlayerGame(ctx context.Context, playerID, gameID int64) ([]model.GameEvent, error) {
+	return r.list(ctx,
+		`SELECT `+eventColumns+` FROM game_events
+		 WHERE player_id = $1 AND game_id = $2 AND game_id IN (SELECT id FROM games WHERE league_id = $3) `+eventOrder,
+		playerID, gameID, repository.LeagueID(ctx),
+	)
+}
+
+func (r *eventRepository) list(ctx context.Context, query string, args ...any) ([]model.GameEvent, error) {
+	if err := ensurePool(r.pool); err != nil {
+		return nil, err
+	}
+	exec := getQ(ctx, r.pool)
+	rows, err := exec.Query(ctx, query, args...)
+	if err != nil {
+		return nil, repository.MapPgError(err)
+	}
+	defer rows.Close()
+	res := make([]model.GameEvent, 0, 32)
+	for rows.Next() {
+		var it model.GameEvent
+		if err := scanEvent(rows, &it); err != nil {
+			return nil, repository.MapPgError(err)
+		}
+		res = append(res, it)
+	}
+	return res, nil
+}
+
+// LockGame takes FOR UPDATE on the game row; concurrent writers for the same game queue behind it.
+func (r *eventRepository) LockGame(ctx context.Context, gameID int64) error {
+	if err := ensurePool(r.pool); err != nil {
+		return err
+	}
+	return lockGame(ctx, getQ(ctx, r.pool), gameID)
+}
+
+func lockGame(ctx context.Context, exec q, gameID int64) error {
+	var id int64
+	if err := exec.QueryRow(ctx,
+		`SELECT id FROM games WHERE id = $1 AND league_id = $2 AND deleted_at IS NULL FOR UPDATE`,
+		gameID, repository.LeagueID(ctx),
+	).Scan(&id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return repository.ErrNotFound
+		}
+		return repository.MapPgError(err)
+	}
+	return nil
+}
+
+var _ repository.EventRepository = (*eventRepository)(nil)
@@ -3,6 +3,7 @@ package postgres
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -16,19 +17,56 @@ func NewGameRepository(pool *pgxpool.Pool) repository.GameRepository {
 	return &gameRepository{pool: pool}
 }
 
+// gameColumns is the canonical projection for model.Game; keep it in sync with scanGame.
+// The venue timezone is read along so scanGame can render the local tip-off time.
+const gameColumns = `id, season, season_id, phase, date, home_team_id, away_team_id, status, rule_profile_id, venue_id, attendance, home_score, away_score, created_at, updated_at,
+	(SELECT v.timezone FROM venues v WHERE v.id = venue_id) AS venue_timezone`
+
+// scanGame reads a row produced with gameColumns; extra destinations are appended after the game fields.
+func scanGame(row pgx.Row, g *model.Game, extra ...any) error {
+	var tz *string
+	dest := []any{&g.ID, &g.Season, &g.SeasonID, &g.Phase, &g.Date, &g.HomeTeamID, &g.AwayTeamID, &g.Status, &g.RuleProfileID, &g.VenueID, &g.Attendance, &g.HomeScore, &g.AwayScore, &g.CreatedAt, &g.UpdatedAt, &tz}
+	if err := row.Scan(append(dest, extra...)...); err != nil {
+		return err
+	}
+	g.LocalTipOff = nil
+	if tz != nil {
+		// Timezones are validated on the way in; a name the runtime cannot load just leaves the field empty.
+		if loc, err := time.LoadLocation(*tz); err == nil {
+			local := g.Date.In(loc)
+			g.LocalTipOff = &local
+		}
+	}
+	return nil
+}
+
+// Create resolves the season by name within the league, so a game can only be created in a season that exists there.
+// Teams of another league fail the composite foreign keys and are reported as ErrConflict.
+// An empty phase defaults to the regular season, a zero rule profile to the season's and a nil venue
+// to the home team's.
 func (r *gameRepository) Create(ctx context.Context, g model.Game) (model.Game, error) {
 	if err := ensurePool(r.pool); err != nil {
 		return model.Game{}, err
 	}
+	phase := g.Phase
+	if phase == "" {
+		phase = "regular"
+	}
 	exec := getQ(ctx, r.pool)
 	row := exec.QueryRow(ctx,
-		`INSERT INTO games (season, date, home_team_id, away_team_id, status)
-		 VALUES ($1, $2, $3, $4, $5)
-		 RETURNING id, season, date, home_team_id, away_team_id, status, created_at, updated_at`,
-		g.Season, g.Date, g.HomeTeamID, g.AwayTeamID, g.Status,
+		`INSERT INTO games (league_id, season, season_id, phase, date, home_team_id, away_team_id, status, rule_profile_id, venue_id, attendance)
+		 SELECT s.league_id, s.name, s.id, $2, $3, $4, $5, $6, COALESCE($7, s.rule_profile_id),
+		        COALESCE($9, (SELECT t.home_venue_id FROM teams t WHERE t.id = $4)), $10
+		 FROM seasons s WHERE s.name = $1 AND s.league_id = $8
+		 RETURNING `+gameColumns,
+		g.Season, phase, g.Date, g.HomeTeamID, g.AwayTeamID, g.Status, nullableID(g.RuleProfileID), repository.LeagueID(ctx), g.VenueID, g.Attendance,
 	)
 	var out model.Game
-	if err := row.Scan(&out.ID, &out.Season, &out.Date, &out.HomeTeamID, &out.AwayTeamID, &out.Status, &out.CreatedAt, &out.UpdatedAt); err != nil {
+	if err := scanGame(row, &out); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			// Same outcome as a dangling reference: the season does not exist.
+			return model.Game{}, repository.ErrConflict
+		}
 		return model.Game{}, repository.MapPgError(err)
 	}
 	return out, nil
@@ -40,11 +78,11 @@ func (r *gameRepository) GetByID(ctx context.Context, id int64) (model.Game, err
 	}
 	exec := getQ(ctx, r.pool)
 	row := exec.QueryRow(ctx,
-		`SELECT id, season, date, home_team_id, away_team_id, status, created_at, updated_at
-		 FROM games WHERE id = $1`, id,
+		`SELECT `+gameColumns+`
+		 FROM games WHERE id = $1 AND league_id = $2 AND deleted_at IS NULL`, id, repository.LeagueID(ctx),
 	)
 	var out model.Game
-	if err := row.Scan(&out.ID, &out.Season, &out.Date, &out.HomeTeamID, &out.AwayTeamID, &out.Status, &out.CreatedAt, &out.UpdatedAt); err != nil {
+	if err := scanGame(row, &out); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return model.Game{}, repository.ErrNotFound
 		}
@@ -60,11 +98,12 @@ func (r *gameRepository) List(ctx context.Context, p repository.Page) (repositor
 	limit, offset := sanitizeLimitOffset(p.Limit, p.Offset)
 	exec := getQ(ctx, r.pool)
 	rows, err := exec.Query(ctx,
-		`SELECT id, season, date, home_team_id, away_team_id, status, created_at, updated_at, COUNT(*) OVER() AS total
+		`SELECT `+gameColumns+`, COUNT(*) OVER() AS total
 		 FROM games
+		 WHERE league_id = $1 AND deleted_at IS NULL
 		 ORDER BY date DESC, id DESC
-		 LIMIT $1 OFFSET $2`,
-		limit, offset,
+		 LIMIT $2 OFFSET $3`,
+		repository.LeagueID(ctx), limit, offset,
 	)
 	if err != nil {
 		return repository.PageResult[model.Game]{}, repository.MapPgError(err)
@@ -74,7 +113,7 @@ func (r *gameRepository) List(ctx context.Context, p repository.Page) (repositor
 	for rows.Next() {
 		var it model.Game
 		var total int
-		if err := rows.Scan(&it.ID, &it.Season, &it.Date, &it.HomeTeamID, &it.AwayTeamID, &it.Status, &it.CreatedAt, &it.UpdatedAt, &total); err != nil {
+		if err := scanGame(rows, &it, &total); err != nil {
 			return repository.PageResult[model.Game]{}, repository.MapPgError(err)
 		}
 		res.Items = append(res.Items, it)
@@ -83,4 +122,302 @@ func (r *gameRepository) List(ctx context.Context, p repository.Page) (repositor
 	return res, nil
 }
 
+// Update stores the schedule, venue and attendance of a game. The season_phases foreign key rejects a phase
+// the season does not have with ErrConflict. The status is left to Transition.
+func (r *gameRepository) Update(ctx context.Context, g model.Game) (model.Game, error) {
+	if err := ensurePool(r.pool); err != nil {
+		return model.Game{}, err
+	}
+	exec := getQ(ctx, r.pool)
+	row := exec.QueryRow(ctx,
+		`UPDATE games SET date = $2, phase = $3, venue_id = $5, attendance = $6, updated_at = NOW()
+		 WHERE id = $1 AND league_id = $4 AND deleted_at IS NULL
+		 RETURNING `+gameColumns,
+		g.ID, g.Date, g.Phase, repository.LeagueID(ctx), g.VenueID, g.Attendance,
+	)
+	var out model.Game
+	if err := scanGame(row, &out); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Game{}, repository.ErrNotFound
+		}
+		return model.Game{}, repository.MapPgError(err)
+	}
+	return out, nil
+}
+
+// Delete marks a game as deleted. Its score, events and stat lines are kept so a restore brings them back.
+func (r *gameRepository) Delete(ctx context.Context, id int64) error {
+	if err := ensurePool(r.pool); err != nil {
+		return err
+	}
+	exec := getQ(ctx, r.pool)
+	tag, err := exec.Exec(ctx,
+		`UPDATE games SET deleted_at = NOW(), updated_at = NOW() WHERE id = $1 AND league_id = $2 AND deleted_at IS NULL`,
+		id, repository.LeagueID(ctx),
+	)
+	if err != nil {
+		return repository.MapPgError(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return repository.ErrNotFound
+	}
+	return nil
+}
+
+func (r *gameRepository) Restore(ctx context.Context, id int64) (model.Game, error) {
+	if err := ensurePool(r.pool); err != nil {
+		return model.Game{}, err
+	}
+	exec := getQ(ctx, r.pool)
+	row := exec.QueryRow(ctx,
+		`UPDATE games SET deleted_at = NULL, updated_at = NOW()
+		 WHERE id = $1 AND league_id = $2 AND deleted_at IS NOT NULL
+		 RETURNING `+gameColumns, id, repository.LeagueID(ctx),
+	)
+	var out model.Game
+	if err := scanGame(row, &out); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Game{}, repository.ErrNotFound
+		}
+		return model.Game{}, repository.MapPgError(err)
+	}
+	return out, nil
+}
+
+// Transition changes the status only if the game still has the status the caller validated against,
+// so two concurrent transitions cannot both succeed.
+func (r *gameRepository) Transition(ctx context.Context, id int64, from, to string) (model.GameTransition, error) {
+	if err := ensurePool(r.pool); err != nil {
+		return model.GameTransition{}, err
+	}
+	exec := getQ(ctx, r.pool)
+	tag, err := exec.Exec(ctx,
+		`UPDATE games SET status = $3, updated_at = NOW()
+		 WHERE id = $1 AND status = $2 AND league_id = $4 AND deleted_at IS NULL`,
+		id, from, to, repository.LeagueID(ctx),
+	)
+	if err != nil {
+		return model.GameTransition{}, repository.MapPgError(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return model.GameTransition{}, repository.ErrConflict
+	}
+	out := model.GameTransition{GameID: id, FromStatus: from, ToStatus: to}
+	err = exec.QueryRow(ctx,
+		`INSERT INTO game_status_transitions (game_id, from_status, to_status)
+		 VALUES ($1, $2, $3)
+		 RETURNING id, transitioned_at`,
+		id, from, to,
+	).Scan(&out.ID, &out.TransitionedAt)
+	if err != nil {
+		return model.GameTransition{}, repository.MapPgError(err)
+	}
+	return out, nil
+}
+
+func (r *gameRepository) ListTransitions(ctx context.Context, gameID int64) ([]model.GameTransition, error) {
+	if err := ensurePool(r.pool); err != nil {
+		return nil, err
+	}
+	exec := getQ(ctx, r.pool)
+	rows, err := exec.Query(ctx,
+		`SELECT id, game_id, from_status, to_status, transitioned_at
+		 FROM game_status_transitions
+		 WHERE game_id = $1 AND game_id IN (SELECT id FROM games WHERE league_id = $2)
+		 ORDER BY transitioned_at, id`, gameID, repository.LeagueID(ctx),
+	)
+	if err != nil {
+		return nil, repository.MapPgError(err)
+	}
+	defer rows.Close()
+	res := make([]model.GameTransition, 0, 3)
+	for rows.Next() {
+		var it model.GameTransition
+		if err := rows.Scan(&it.ID, &it.GameID, &it.FromStatus, &it.ToStatus, &it.TransitionedAt); err != nil {
+			return nil, repository.MapPgError(err)
+		}
+		res = append(res, it)
+	}
+	return res, rows.Err()
+}
+
+// UpdateScore writes the official score and replaces the whole line score.
+// The line score is replaced rather than merged so a corrected submission never leaves stale overtimes behind.
+func (r *gameRepository) UpdateScore(ctx context.Context, id int64, s model.GameScore) (model.Game, error) {
+	if err := ensurePool(r.pool); err != nil {
+		return model.Game{}, err
+	}
+	exec := getQ(ctx, r.pool)
+	row := exec.QueryRow(ctx,
+		`UPDATE games SET home_score = $2, away_score = $3, updated_at = NOW()
+		 WHERE id = $1 AND league_id = $4 AND deleted_at IS NULL
+		 RETURNING `+gameColumns,
+		id, s.HomeScore, s.AwayScore, repository.LeagueID(ctx),
+	)
+	var out model.Game
+	if err := scanGame(row, &out); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Game{}, repository.ErrNotFound
+		}
+		return model.Game{}, repository.MapPgError(err)
+	}
+
+	if _, err := exec.Exec(ctx, `DELETE FROM game_period_scores WHERE game_id = $1`, id); err != nil {
+		return model.Game{}, repository.MapPgError(err)
+	}
+	if len(s.Periods) > 0 {
+		periods := make([]int32, 0, len(s.Periods))
+		home := make([]int32, 0, len(s.Periods))
+		away := make([]int32, 0, len(s.Periods))
+		for _, p := range s.Periods {
+			periods = append(periods, int32(p.Period))
+			home = append(home, int32(p.HomePoints))
+			away = append(away, int32(p.AwayPoints))
+		}
+		if _, err := exec.Exec(ctx,
+			`INSERT INTO game_period_scores (game_id, period, home_points, away_points)
+			 SELECT $1, p.period, p.home_points, p.away_points
+			 FROM UNNEST($2::INT[], $3::INT[], $4::INT[]) AS p(period, home_points, away_points)`,
+			id, periods, home, away,
+		); err != nil {
+			return model.Game{}, repository.MapPgError(err)
+		}
+	}
+	out.LineScore = append([]model.PeriodScore(nil), s.Periods...)
+	return out, nil
+}
+
+func (r *gameRepository) ListPeriodScores(ctx context.Context, gameID int64) ([]model.PeriodScore, error) {
+	if err := ensurePool(r.pool); err != nil {
+		return nil, err
+	}
+	exec := getQ(ctx, r.pool)
+	rows, err := exec.Query(ctx,
+		`SELECT period, home_points, away_points
+		 FROM game_period_scores
+		 WHERE game_id = $1 AND game_id IN (SELECT id FROM games WHERE league_id = $2)
+		 ORDER BY period`, gameID, repository.LeagueID(ctx),
+	)
+	if err != nil {
+		return nil, repository.MapPgError(err)
+	}
+	defer rows.Close()
+	res := make([]model.PeriodScore, 0, 4)
+	for rows.Next() {
+		var it model.PeriodScore
+		if err := rows.Scan(&it.Period, &it.HomePoints, &it.AwayPoints); err != nil {
+			return nil, repository.MapPgError(err)
+		}
+		res = append(res, it)
+	}
+	return res, nil
+}
+
+func (r *gameRepository) CountPeriodsPlayed(ctx context.Context, gameID int64) (int, error) {
+	if err := ensurePool(r.pool); err != nil {
+		return 0, err
+	}
+	exec := getQ(ctx, r.pool)
+	var played int
+	if err := exec.QueryRow(ctx,
+		`SELECT GREATEST(
+			(SELECT COUNT(*) FROM game_period_scores WHERE game_id = g.id),
+			(SELECT COALESCE(MAX(period), 0) FROM game_events WHERE game_id = g.id)
+		 )
+		 FROM games g
+		 WHERE g.id = $1 AND g.league_id = $2`, gameID, repository.LeagueID(ctx),
+	).Scan(&played); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, repository.ErrNotFound
+		}
+		return 0, repository.MapPgError(err)
+	}
+	return played, nil
+}
+
+// GetPlayerPointTotals sums player points for each side of the game.
+// Players are attributed to a side through their membership on the game date; lines for players on neither team are ignored.
+func (r *gameRepository) GetPlayerPointTotals(ctx context.Context, gameID int64) (int, int, error) {
+	if err := ensurePool(r.pool); err != nil {
+		return 0, 0, err
+	}
+	exec := getQ(ctx, r.pool)
+	var home, away int
+	err := exec.QueryRow(ctx,
+		`SELECT
+			COALESCE(SUM(ps.points) FILTER (WHERE pgt.team_id = g.home_team_id), 0) AS home_points,
+			COALESCE(SUM(ps.points) FILTER (WHERE pgt.team_id = g.away_team_id), 0) AS away_points
+		 FROM games g
+		 LEFT JOIN player_stats ps ON ps.game_id = g.id
+		 LEFT JOIN player_game_teams pgt ON pgt.player_id = ps.player_id AND pgt.game_id = ps.game_id
+		 WHERE g.id = $1 AND g.league_id = $2 AND g.deleted_at IS NULL
+		 GROUP BY g.id`, gameID, repository.LeagueID(ctx),
+	).Scan(&home, &away)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, 0, repository.ErrNotFound
+		}
+		return 0, 0, repository.MapPgError(err)
+	}
+	return home, away, nil
+}
+
+// GetBoxScore reads the game header and every stat line of the game joined with its player in two queries,
+// however many players took part. A line is attributed to the player's team on the game date,
+// falling back to the current team for lines recorded before memberships were tracked. Ages are taken on the
+// game's UTC calendar date, the day rosters are checked against.
+func (r *gameRepository) GetBoxScore(ctx context.Context, gameID int64) (model.BoxScore, error) {
+	if err := ensurePool(r.pool); err != nil {
+		return model.BoxScore{}, err
+	}
+	exec := getQ(ctx, r.pool)
+	var out model.BoxScore
+	row := exec.QueryRow(ctx,
+		`SELECT `+gameColumns+`, home_name, away_name
+		 FROM (SELECT g.*, ht.name AS home_name, at.name AS away_name
+		       FROM games g
+		       INNER JOIN teams ht ON ht.id = g.home_team_id
+		       INNER JOIN teams at ON at.id = g.away_team_id
+		       WHERE g.id = $1 AND g.league_id = $2 AND g.deleted_at IS NULL) g`, gameID, repository.LeagueID(ctx),
+	)
+	if err := scanGame(row, &out.Game, &out.Home.Name, &out.Away.Name); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.BoxScore{}, repository.ErrNotFound
+		}
+		return model.BoxScore{}, repository.MapPgError(err)
+	}
+	out.Home.TeamID, out.Away.TeamID = out.Game.HomeTeamID, out.Game.AwayTeamID
+	out.Home.Players, out.Away.Players = []model.BoxScoreLine{}, []model.BoxScoreLine{}
+
+	rows, err := exec.Query(ctx,
+		`SELECT `+statLineColumns+`, first_name, last_name, position, team_id, starter, birth_date
+		 FROM (SELECT l.*, p.first_name, p.last_name, p.position, COALESCE(pgt.team_id, p.team_id) AS team_id, p.birth_date,
+		              gs.player_id IS NOT NULL AS starter
+		       FROM (SELECT `+statLineColumns+` FROM player_stats WHERE game_id = $1) l
+		       INNER JOIN players p ON p.id = l.player_id AND p.deleted_at IS NULL
+		       LEFT JOIN player_game_teams pgt ON pgt.player_id = l.player_id AND pgt.game_id = l.game_id
+		       LEFT JOIN game_starters gs ON gs.game_id = l.game_id AND gs.player_id = l.player_id) b
+		 ORDER BY starter DESC, minutes_played DESC, points DESC, id`, gameID,
+	)
+	if err != nil {
+		return model.BoxScore{}, repository.MapPgError(err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var it model.BoxScoreLine
+		var birthDate *time.Time
+		if err := scanStatLine(rows, &it.PlayerStatLine, &it.FirstName, &it.LastName, &it.Position, &it.TeamID, &it.Starter, &birthDate); err != nil {
+			return model.BoxScore{}, repository.MapPgError(err)
+		}
+		it.Age = model.AgeOn(birthDate, out.Game.Date.UTC())
+		switch it.TeamID {
+		case out.Home.TeamID:
+			out.Home.Players = append(out.Home.Players, it)
+		case out.Away.TeamID:
+			out.Away.Players = append(out.Away.Players, it)
+		}
+	}
+	return out, nil
+}
+
 var _ repository.GameRepository = (*gameRepository)(nil)
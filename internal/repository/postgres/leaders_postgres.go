@@ -0,0 +1,74 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/maxviazov/basketball-stats-service/internal/model"
+	"github.com/maxviazov/basketball-stats-service/internal/repository"
+)
+
+type leadersRepository struct{ pool *pgxpool.Pool }
+
+func NewLeadersRepository(pool *pgxpool.Pool) repository.LeadersRepository {
+	return &leadersRepository{pool: pool}
+}
+
+// ListLeaders sums the stat per player over the same player_stats ⨝ games join as the player aggregates,
+// drops players below the games threshold and ranks the rest with RANK(), so equal values share a rank.
+// Games are games played, so DNP lines neither qualify a player nor dilute their per-game value.
+// Per-game values are rounded before ranking, which makes players tied on the displayed average tied in rank.
+func (r *leadersRepository) ListLeaders(ctx context.Context, q model.LeaderQuery) ([]model.LeaderEntry, error) {
+	if err := ensurePool(r.pool); err != nil {
+		return nil, err
+	}
+	if !repository.IsLeaderStat(q.Stat) {
+		return nil, fmt.Errorf("leaders: unsupported stat %q", q.Stat)
+	}
+	limit, _ := sanitizeLimitOffset(q.Limit, 0)
+
+	query := `
+		WITH totals AS (
+			SELECT ps.player_id, COUNT(*) FILTER (WHERE ` + playedLine + `) AS games, SUM(ps.` + q.Stat + `)::NUMERIC AS total
+			FROM player_stats ps
+			INNER JOIN games g ON ps.game_id = g.id AND g.league_id = $6 AND g.deleted_at IS NULL
+			INNER JOIN players p ON p.id = ps.player_id AND p.deleted_at IS NULL
+			WHERE ($1::TEXT IS NULL OR g.season = $1) AND ($2::TEXT IS NULL OR g.phase = $2)
+			GROUP BY ps.player_id
+			HAVING COUNT(*) FILTER (WHERE ` + playedLine + `) >= GREATEST($3, 1)
+		),
+		ranked AS (
+			SELECT
+				player_id, games, value,
+				RANK() OVER (ORDER BY value DESC) AS rank
+			FROM (
+				SELECT player_id, games, CASE WHEN $4 THEN ROUND(total / games, 2) ELSE total END AS value
+				FROM totals
+			) v
+		)
+		SELECT r.rank, p.id, p.first_name, p.last_name, p.team_id, r.games, r.value::FLOAT8
+		FROM ranked r
+		INNER JOIN players p ON p.id = r.player_id
+		WHERE r.rank <= $5
+		ORDER BY r.rank, p.last_name, p.first_name, p.id
+	`
+
+	exec := getQ(ctx, r.pool)
+	rows, err := exec.Query(ctx, query, q.Season, q.Phase, q.MinGames, q.PerGame, limit, repository.LeagueID(ctx))
+	if err != nil {
+		return nil, repository.MapPgError(err)
+	}
+	defer rows.Close()
+	res := make([]model.LeaderEntry, 0, limit)
+	for rows.Next() {
+		var it model.LeaderEntry
+		if err := rows.Scan(&it.Rank, &it.PlayerID, &it.FirstName, &it.LastName, &it.TeamID, &it.GamesPlayed, &it.Value); err != nil {
+			return nil, repository.MapPgError(err)
+		}
+		res = append(res, it)
+	}
+	return res, nil
+}
+
+var _ repository.LeadersRepository = (*leadersRepository)(nil)
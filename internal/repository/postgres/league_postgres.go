@@ -0,0 +1,82 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/maxviazov/basketball-stats-service/internal/model"
+	"github.com/maxviazov/basketball-stats-service/internal/repository"
+)
+
+type leagueRepository struct{ pool *pgxpool.Pool }
+
+func NewLeagueRepository(pool *pgxpool.Pool) repository.LeagueRepository {
+	return &leagueRepository{pool: pool}
+}
+
+const leagueColumns = `id, name, created_at, updated_at`
+
+func scanLeague(row pgx.Row, l *model.League, extra ...any) error {
+	dest := []any{&l.ID, &l.Name, &l.CreatedAt, &l.UpdatedAt}
+	return row.Scan(append(dest, extra...)...)
+}
+
+func (r *leagueRepository) Create(ctx context.Context, l model.League) (model.League, error) {
+	if err := ensurePool(r.pool); err != nil {
+		return model.League{}, err
+	}
+	exec := getQ(ctx, r.pool)
+	var out model.League
+	if err := scanLeague(exec.QueryRow(ctx, `INSERT INTO leagues (name) VALUES ($1) RETURNING `+leagueColumns, l.Name), &out); err != nil {
+		return model.League{}, repository.MapPgError(err)
+	}
+	return out, nil
+}
+
+func (r *leagueRepository) GetByID(ctx context.Context, id int64) (model.League, error) {
+	if err := ensurePool(r.pool); err != nil {
+		return model.League{}, err
+	}
+	exec := getQ(ctx, r.pool)
+	var out model.League
+	if err := scanLeague(exec.QueryRow(ctx, `SELECT `+leagueColumns+` FROM leagues WHERE id = $1`, id), &out); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.League{}, repository.ErrNotFound
+		}
+		return model.League{}, repository.MapPgError(err)
+	}
+	return out, nil
+}
+
+func (r *leagueRepository) List(ctx context.Context, p repository.Page) (repository.PageResult[model.League], error) {
+	if err := ensurePool(r.pool); err != nil {
+		return repository.PageResult[model.League]{}, err
+	}
+	limit, offset := sanitizeLimitOffset(p.Limit, p.Offset)
+	exec := getQ(ctx, r.pool)
+	rows, err := exec.Query(ctx,
+		`SELECT `+leagueColumns+`, COUNT(*) OVER() AS total
+		 FROM leagues
+		 ORDER BY id
+		 LIMIT $1 OFFSET $2`, limit, offset,
+	)
+	if err != nil {
+		return repository.PageResult[model.League]{}, repository.MapPgError(err)
+	}
+	defer rows.Close()
+	res := repository.PageResult[model.League]{Items: make([]model.League, 0, limit)}
+	for rows.Next() {
+		var it model.League
+		var total int
+		if err := scanLeague(rows, &it, &total); err != nil {
+			return repository.PageResult[model.League]{}, repository.MapPgError(err)
+		}
+		res.Items = append(res.Items, it)
+		res.Total = total
+	}
+	return res, nil
+}
+
+var _ repository.LeagueRepository = (*leagueRepository)(nil)
@@ -0,0 +1,230 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/maxviazov/basketball-stats-service/internal/model"
+	"github.com/maxviazov/basketball-stats-service/internal/repository"
+)
+
+type lineupRepository struct{ pool *pgxpool.Pool }
+
+func NewLineupRepository(pool *pgxpool.Pool) repository.LineupRepository {
+	return &lineupRepository{pool: pool}
+}
+
+const stintColumns = `id, game_id, team_id, period, start_clock, end_clock, player_ids, created_at`
+
+func scanStint(row pgx.Row, st *model.LineupStint) error {
+	return row.Scan(&st.ID, &st.GameID, &st.TeamID, &st.Period, &st.StartClock, &st.EndClock, &st.PlayerIDs, &st.CreatedAt)
+}
+
+// ReplaceStarters deletes and inserts in two statements, so callers run it inside a transaction.
+func (r *lineupRepository) ReplaceStarters(ctx context.Context, gameID, teamID int64, playerIDs []int64) ([]model.GameStarter, error) {
+	if err := ensurePool(r.pool); err != nil {
+		return nil, err
+	}
+	exec := getQ(ctx, r.pool)
+	if _, err := exec.Exec(ctx,
+		`DELETE FROM game_starters
+		 WHERE game_id = $1 AND team_id = $2 AND game_id IN (SELECT id FROM games WHERE league_id = $3)`,
+		gameID, teamID, repository.LeagueID(ctx),
+	); err != nil {
+		return nil, repository.MapPgError(err)
+	}
+	rows, err := exec.Query(ctx,
+		`INSERT INTO game_starters (game_id, team_id, player_id)
+		 SELECT g.id, $2, pid
+		 FROM games g, unnest($3::int[]) AS pid
+		 WHERE g.id = $1 AND g.league_id = $4 AND g.deleted_at IS NULL
+		 RETURNING game_id, team_id, player_id`,
+		gameID, teamID, playerIDs, repository.LeagueID(ctx),
+	)
+	if err != nil {
+		return nil, repository.MapPgError(err)
+	}
+	defer rows.Close()
+	res := make([]model.GameStarter, 0, len(playerIDs))
+	for rows.Next() {
+		var it model.GameStarter
+		if err := rows.Scan(&it.GameID, &it.TeamID, &it.PlayerID); err != nil {
+			return nil, repository.MapPgError(err)
+		}
+		res = append(res, it)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, repository.MapPgError(err)
+	}
+	if len(res) < len(playerIDs) {
+		return nil, repository.ErrConflict
+	}
+	return res, nil
+}
+
+func (r *lineupRepository) ListStarters(ctx context.Context, gameID int64) ([]model.GameStarter, error) {
+	if err := ensurePool(r.pool); err != nil {
+		return nil, err
+	}
+	exec := getQ(ctx, r.pool)
+	rows, err := exec.Query(ctx,
+		`SELECT game_id, team_id, player_id
+		 FROM game_starters
+		 WHERE game_id = $1 AND game_id IN (SELECT id FROM games WHERE league_id = $2)
+		 ORDER BY team_id, player_id`, gameID, repository.LeagueID(ctx),
+	)
+	if err != nil {
+		return nil, repository.MapPgError(err)
+	}
+	defer rows.Close()
+	res := make([]model.GameStarter, 0, 10)
+	for rows.Next() {
+		var it model.GameStarter
+		if err := rows.Scan(&it.GameID, &it.TeamID, &it.PlayerID); err != nil {
+			return nil, repository.MapPgError(err)
+		}
+		res = append(res, it)
+	}
+	return res, nil
+}
+
+func (r *lineupRepository) CreateStint(ctx context.Context, st model.LineupStint) (model.LineupStint, error) {
+	if err := ensurePool(r.pool); err != nil {
+		return model.LineupStint{}, err
+	}
+	exec := getQ(ctx, r.pool)
+	row := exec.QueryRow(ctx,
+		`INSERT INTO lineup_stints (game_id, team_id, period, start_clock, end_clock, player_ids)
+		 SELECT g.id, $2, $3, $4, $5, $6
+		 FROM games g
+		 WHERE g.id = $1 AND g.league_id = $7 AND g.deleted_at IS NULL
+		 RETURNING `+stintColumns,
+		st.GameID, st.TeamID, st.Period, st.StartClock, st.EndClock, st.PlayerIDs, repository.LeagueID(ctx),
+	)
+	var out model.LineupStint
+	if err := scanStint(row, &out); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.LineupStint{}, repository.ErrConflict
+		}
+		return model.LineupStint{}, repository.MapPgError(err)
+	}
+	return out, nil
+}
+
+func (r *lineupRepository) ListStints(ctx context.Context, gameID int64) ([]model.LineupStint, error) {
+	if err := ensurePool(r.pool); err != nil {
+		return nil, err
+	}
+	exec := getQ(ctx, r.pool)
+	rows, err := exec.Query(ctx,
+		`SELECT `+stintColumns+`
+		 FROM lineup_stints
+		 WHERE game_id = $1 AND game_id IN (SELECT id FROM games WHERE league_id = $2)
+		 ORDER BY team_id, period, start_clock DESC, id`, gameID, repository.LeagueID(ctx),
+	)
+	if err != nil {
+		return nil, repository.MapPgError(err)
+	}
+	defer rows.Close()
+	res := make([]model.LineupStint, 0, 16)
+	for rows.Next() {
+		var it model.LineupStint
+		if err := scanStint(rows, &it); err != nil {
+			return nil, repository.MapPgError(err)
+		}
+		res = append(res, it)
+	}
+	return res, nil
+}
+
+func (r *lineupRepository) DeleteStint(ctx context.Context, gameID, stintID int64) error {
+	if err := ensurePool(r.pool); err != nil {
+		return err
+	}
+	exec := getQ(ctx, r.pool)
+	tag, err := exec.Exec(ctx,
+		`DELETE FROM lineup_stints
+		 WHERE id = $1 AND game_id = $2 AND game_id IN (SELECT id FROM games WHERE league_id = $3)`,
+		stintID, gameID, repository.LeagueID(ctx),
+	)
+	if err != nil {
+		return repository.MapPgError(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return repository.ErrNotFound
+	}
+	return nil
+}
+
+func (r *lineupRepository) LockGame(ctx context.Context, gameID int64) error {
+	if err := ensurePool(r.pool); err != nil {
+		return err
+	}
+	return lockGame(ctx, getQ(ctx, r.pool), gameID)
+}
+
+// ListTeamLineups credits each stint with the events of its game and period whose clock falls in
+// [end_clock, start_clock): a play at the exact second of a substitution counts for the unit that left.
+// Possessions use the usual box score estimate, FGA + 0.44 * FTA - OREB + TOV, per side.
+func (r *lineupRepository) ListTeamLineups(ctx context.Context, teamID int64, season string) ([]model.LineupStats, error) {
+	if err := ensurePool(r.pool); err != nil {
+		return nil, err
+	}
+	exec := getQ(ctx, r.pool)
+	rows, err := exec.Query(ctx,
+		`WITH stints AS (
+			SELECT s.id, s.game_id, s.team_id, s.period, s.start_clock, s.end_clock, s.player_ids
+			FROM lineup_stints s
+			INNER JOIN games g ON g.id = s.game_id
+			WHERE s.team_id = $1 AND g.season = $2 AND g.league_id = $3 AND g.deleted_at IS NULL
+		),
+		plays AS (
+			SELECT st.id,
+				e.team_id = st.team_id AS own,
+				CASE e.event_type WHEN 'two_pt_made' THEN 2 WHEN 'three_pt_made' THEN 3 WHEN 'free_throw_made' THEN 1 ELSE 0 END AS points,
+				CASE
+					WHEN e.event_type IN ('two_pt_made', 'two_pt_missed', 'three_pt_made', 'three_pt_missed', 'turnover') THEN 1
+					WHEN e.event_type IN ('free_throw_made', 'free_throw_missed') THEN 0.44
+					WHEN e.event_type = 'offensive_rebound' THEN -1
+					ELSE 0
+				END AS possessions
+			FROM stints st
+			INNER JOIN game_events e ON e.game_id = st.game_id AND e.period = st.period
+				AND e.clock_seconds >= st.end_clock AND e.clock_seconds < st.start_clock
+		),
+		per_stint AS (
+			SELECT st.id, st.game_id, st.player_ids, st.start_clock - st.end_clock AS seconds,
+				COALESCE(SUM(p.points) FILTER (WHERE p.own), 0) AS points_for,
+				COALESCE(SUM(p.points) FILTER (WHERE NOT p.own), 0) AS points_against,
+				COALESCE(SUM(p.possessions) FILTER (WHERE p.own), 0) AS off_poss,
+				COALESCE(SUM(p.possessions) FILTER (WHERE NOT p.own), 0) AS def_poss
+			FROM stints st
+			LEFT JOIN plays p ON p.id = st.id
+			GROUP BY st.id, st.game_id, st.player_ids, st.start_clock, st.end_clock
+		)
+		SELECT player_ids, COUNT(DISTINCT game_id), SUM(seconds) / 60.0,
+			SUM(points_for), SUM(points_against), SUM(off_poss), SUM(def_poss)
+		FROM per_stint
+		GROUP BY player_ids
+		ORDER BY SUM(seconds) DESC, player_ids`,
+		teamID, season, repository.LeagueID(ctx),
+	)
+	if err != nil {
+		return nil, repository.MapPgError(err)
+	}
+	defer rows.Close()
+	res := make([]model.LineupStats, 0, 16)
+	for rows.Next() {
+		var it model.LineupStats
+		if err := rows.Scan(&it.PlayerIDs, &it.Games, &it.Minutes, &it.PointsFor, &it.PointsAgainst,
+			&it.OffensivePossessions, &it.DefensivePossessions); err != nil {
+			return nil, repository.MapPgError(err)
+		}
+		res = append(res, it)
+	}
+	return res, nil
+}
+
+var _ repository.LineupRepository = (*lineupRepository)(nil)
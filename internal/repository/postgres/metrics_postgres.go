@@ -0,0 +1,123 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/maxviazov/basketball-stats-service/internal/model"
+	"github.com/maxviazov/basketball-stats-service/internal/repository"
+)
+
+type metricsRepository struct{ pool *pgxpool.Pool }
+
+func NewMetricsRepository(pool *pgxpool.Pool) repository.MetricsRepository {
+	return &metricsRepository{pool: pool}
+}
+
+// statTotalsColumns aggregates the season_lines CTE into model.StatTotals; keep it in sync with statTotalsDest.
+// Games are those with a line showing the player, or for a team any of its players, on the floor.
+const statTotalsColumns = `COUNT(DISTINCT game_id) FILTER (WHERE played), COALESCE(SUM(minutes_played), 0)::FLOAT8,
+	COALESCE(SUM(points), 0), COALESCE(SUM(field_goals_made), 0), COALESCE(SUM(field_goals_attempted), 0),
+	COALESCE(SUM(three_pointers_made), 0), COALESCE(SUM(three_pointers_attempted), 0),
+	COALESCE(SUM(free_throws_made), 0), COALESCE(SUM(free_throws_attempted), 0),
+	COALESCE(SUM(offensive_rebounds), 0), COALESCE(SUM(defensive_rebounds), 0), COALESCE(SUM(rebounds), 0),
+	COALESCE(SUM(assists), 0), COALESCE(SUM(steals), 0), COALESCE(SUM(blocks), 0),
+	COALESCE(SUM(turnovers), 0), COALESCE(SUM(fouls), 0)`
+
+func statTotalsDest(t *model.StatTotals) []any {
+	return []any{
+		&t.Games, &t.Minutes,
+		&t.Points, &t.FieldGoalsMade, &t.FieldGoalsAttempted,
+		&t.ThreePointersMade, &t.ThreePointersAttempted,
+		&t.FreeThrowsMade, &t.FreeThrowsAttempted,
+		&t.OffensiveRebounds, &t.DefensiveRebounds, &t.Rebounds,
+		&t.Assists, &t.Steals, &t.Blocks,
+		&t.Turnovers, &t.Fouls,
+	}
+}
+
+// ListSeasonTotals aggregates every stat line of a season twice in one pass: per player and per team.
+// A line belongs to the team the player was a member of on the game date.
+func (r *metricsRepository) ListSeasonTotals(ctx context.Context, season string) ([]model.PlayerSeasonTotals, error) {
+	if err := ensurePool(r.pool); err != nil {
+		return nil, err
+	}
+	exec := getQ(ctx, r.pool)
+	rows, err := exec.Query(ctx,
+		`WITH season_lines AS (
+			SELECT ps.*, pgt.team_id, `+playedLine+` AS played, rp.periods * rp.period_minutes AS game_minutes
+			FROM player_stats ps
+			JOIN player_game_teams pgt ON pgt.player_id = ps.player_id AND pgt.game_id = ps.game_id
+			JOIN games g ON g.id = ps.game_id
+			JOIN rule_profiles rp ON rp.id = g.rule_profile_id
+			WHERE g.season = $1 AND g.league_id = $2
+		),
+		-- A player traded mid-season gets one row per team, each rated against the team they played for.
+		player_totals AS (
+			SELECT player_id, team_id, `+statTotalsColumns+`
+			FROM season_lines GROUP BY player_id, team_id
+		),
+		team_totals AS (
+			SELECT team_id, `+statTotalsColumns+`
+			FROM season_lines GROUP BY team_id
+		),
+		-- Regulation minutes over the same games team_totals counts, each game once.
+		team_game_minutes AS (
+			SELECT team_id, SUM(game_minutes)::FLOAT8 AS game_minutes
+			FROM (SELECT DISTINCT team_id, game_id, game_minutes FROM season_lines WHERE played) tg
+			GROUP BY team_id
+		)
+		SELECT pt.*, tt.*, COALESCE(tgm.game_minutes, 0)
+		FROM player_totals pt
+		JOIN team_totals tt ON tt.team_id = pt.team_id
+		LEFT JOIN team_game_minutes tgm ON tgm.team_id = pt.team_id
+		ORDER BY pt.player_id`, season, repository.LeagueID(ctx),
+	)
+	if err != nil {
+		return nil, repository.MapPgError(err)
+	}
+	defer rows.Close()
+	res := make([]model.PlayerSeasonTotals, 0, 64)
+	for rows.Next() {
+		var it model.PlayerSeasonTotals
+		var teamID int64
+		dest := append([]any{&it.PlayerID, &it.TeamID}, statTotalsDest(&it.Player)...)
+		dest = append(dest, &teamID)
+		dest = append(dest, statTotalsDest(&it.Team)...)
+		dest = append(dest, &it.TeamGameMinutes)
+		if err := rows.Scan(dest...); err != nil {
+			return nil, repository.MapPgError(err)
+		}
+		res = append(res, it)
+	}
+	return res, nil
+}
+
+func (r *metricsRepository) ListPlayerSeasonLines(ctx context.Context, playerID int64, season string) ([]model.PlayerGameLine, error) {
+	if err := ensurePool(r.pool); err != nil {
+		return nil, err
+	}
+	exec := getQ(ctx, r.pool)
+	rows, err := exec.Query(ctx,
+		`SELECT l.*, g.date
+		 FROM (SELECT `+statLineColumns+` FROM player_stats WHERE player_id = $1) l
+		 JOIN games g ON g.id = l.game_id
+		 WHERE g.season = $2 AND g.league_id = $3 AND g.deleted_at IS NULL
+		 ORDER BY g.date, g.id`, playerID, season, repository.LeagueID(ctx),
+	)
+	if err != nil {
+		return nil, repository.MapPgError(err)
+	}
+	defer rows.Close()
+	res := make([]model.PlayerGameLine, 0, 82)
+	for rows.Next() {
+		var it model.PlayerGameLine
+		if err := scanStatLine(rows, &it.PlayerStatLine, &it.GameDate); err != nil {
+			return nil, repository.MapPgError(err)
+		}
+		res = append(res, it)
+	}
+	return res, nil
+}
+
+var _ repository.MetricsRepository = (*metricsRepository)(nil)
@@ -0,0 +1,263 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/maxviazov/basketball-stats-service/internal/model"
+	"github.com/maxviazov/basketball-stats-service/internal/repository"
+)
+
+type officialRepository struct{ pool *pgxpool.Pool }
+
+func NewOfficialRepository(pool *pgxpool.Pool) repository.OfficialRepository {
+	return &officialRepository{pool: pool}
+}
+
+const officialColumns = `id, first_name, last_name, created_at, updated_at`
+
+func scanOfficial(row pgx.Row, o *model.Official, extra ...any) error {
+	dest := []any{&o.ID, &o.FirstName, &o.LastName, &o.CreatedAt, &o.UpdatedAt}
+	return row.Scan(append(dest, extra...)...)
+}
+
+func (r *officialRepository) Create(ctx context.Context, o model.Official) (model.Official, error) {
+	if err := ensurePool(r.pool); err != nil {
+		return model.Official{}, err
+	}
+	exec := getQ(ctx, r.pool)
+	row := exec.QueryRow(ctx,
+		`INSERT INTO officials (league_id, first_name, last_name) VALUES ($1, $2, $3)
+		 RETURNING `+officialColumns,
+		repository.LeagueID(ctx), o.FirstName, o.LastName,
+	)
+	var out model.Official
+	if err := scanOfficial(row, &out); err != nil {
+		return model.Official{}, repository.MapPgError(err)
+	}
+	return out, nil
+}
+
+func (r *officialRepository) GetByID(ctx context.Context, id int64) (model.Official, error) {
+	if err := ensurePool(r.pool); err != nil {
+		return model.Official{}, err
+	}
+	exec := getQ(ctx, r.pool)
+	row := exec.QueryRow(ctx,
+		`SELECT `+officialColumns+` FROM officials WHERE id = $1 AND league_id = $2`, id, repository.LeagueID(ctx),
+	)
+	var out model.Official
+	if err := scanOfficial(row, &out); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Official{}, repository.ErrNotFound
+		}
+		return model.Official{}, repository.MapPgError(err)
+	}
+	return out, nil
+}
+
+func (r *officialRepository) List(ctx context.Context, p repository.Page) (repository.PageResult[model.Official], error) {
+	if err := ensurePool(r.pool); err != nil {
+		return repository.PageResult[model.Official]{}, err
+	}
+	limit, offset := sanitizeLimitOffset(p.Limit, p.Offset)
+	exec := getQ(ctx, r.pool)
+	rows, err := exec.Query(ctx,
+		`SELECT `+officialColumns+`, COUNT(*) OVER() AS total
+		 FROM officials
+		 WHERE league_id = $1
+		 ORDER BY last_name, first_name, id
+		 LIMIT $2 OFFSET $3`,
+		repository.LeagueID(ctx), limit, offset,
+	)
+	if err != nil {
+		return repository.PageResult[model.Official]{}, repository.MapPgError(err)
+	}
+	defer rows.Close()
+	res := repository.PageResult[model.Official]{Items: make([]model.Official, 0, limit)}
+	for rows.Next() {
+		var it model.Official
+		var total int
+		if err := scanOfficial(rows, &it, &total); err != nil {
+			return repository.PageResult[model.Official]{}, repository.MapPgError(err)
+		}
+		res.Items = append(res.Items, it)
+		res.Total = total
+	}
+	return res, nil
+}
+
+// Assign inserts or re-roles an assignment in one statement. The game day is taken from the game itself,
+// so the unique index on active (official_id, game_day) pairs is what finally rules out double-booking.
+func (r *officialRepository) Assign(ctx context.Context, gameID, officialID int64, role string) (model.GameOfficial, error) {
+	if err := ensurePool(r.pool); err != nil {
+		return model.GameOfficial{}, err
+	}
+	exec := getQ(ctx, r.pool)
+	out := model.GameOfficial{GameID: gameID, OfficialID: officialID}
+	err := exec.QueryRow(ctx,
+		`WITH assigned AS (
+			INSERT INTO game_officials (game_id, official_id, role, game_day)
+			SELECT g.id, o.id, $3, (g.date AT TIME ZONE 'UTC')::DATE
+			FROM games g
+			INNER JOIN officials o ON o.id = $2 AND o.league_id = g.league_id
+			WHERE g.id = $1 AND g.league_id = $4 AND g.deleted_at IS NULL
+			ON CONFLICT (game_id, official_id) DO UPDATE SET role = EXCLUDED.role
+			RETURNING official_id, role, created_at
+		 )
+		 SELECT a.role, o.first_name, o.last_name, a.created_at
+		 FROM assigned a INNER JOIN officials o ON o.id = a.official_id`,
+		gameID, officialID, role, repository.LeagueID(ctx),
+	).Scan(&out.Role, &out.FirstName, &out.LastName, &out.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			// Same outcome as a dangling reference: the game or the official is not in this league.
+			return model.GameOfficial{}, repository.ErrConflict
+		}
+		return model.GameOfficial{}, repository.MapPgError(err)
+	}
+	return out, nil
+}
+
+func (r *officialRepository) Unassign(ctx context.Context, gameID, officialID int64) error {
+	if err := ensurePool(r.pool); err != nil {
+		return err
+	}
+	exec := getQ(ctx, r.pool)
+	tag, err := exec.Exec(ctx,
+		`DELETE FROM game_officials
+		 WHERE game_id = $1 AND official_id = $2
+		   AND official_id IN (SELECT id FROM officials WHERE league_id = $3)`,
+		gameID, officialID, repository.LeagueID(ctx),
+	)
+	if err != nil {
+		return repository.MapPgError(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return repository.ErrNotFound
+	}
+	return nil
+}
+
+func (r *officialRepository) ListByGame(ctx context.Context, gameID int64) ([]model.GameOfficial, error) {
+	if err := ensurePool(r.pool); err != nil {
+		return nil, err
+	}
+	exec := getQ(ctx, r.pool)
+	rows, err := exec.Query(ctx,
+		`SELECT ga.game_id, ga.official_id, ga.role, o.first_name, o.last_name, ga.created_at
+		 FROM game_officials ga
+		 INNER JOIN officials o ON o.id = ga.official_id AND o.league_id = $2
+		 WHERE ga.game_id = $1
+		 ORDER BY CASE ga.role WHEN 'crew_chief' THEN 0 WHEN 'referee' THEN 1 ELSE 2 END, o.last_name, o.id`,
+		gameID, repository.LeagueID(ctx),
+	)
+	if err != nil {
+		return nil, repository.MapPgError(err)
+	}
+	defer rows.Close()
+	res := make([]model.GameOfficial, 0, 3)
+	for rows.Next() {
+		var it model.GameOfficial
+		if err := rows.Scan(&it.GameID, &it.OfficialID, &it.Role, &it.FirstName, &it.LastName, &it.CreatedAt); err != nil {
+			return nil, repository.MapPgError(err)
+		}
+		res = append(res, it)
+	}
+	return res, rows.Err()
+}
+
+func (r *officialRepository) GamesOn(ctx context.Context, officialID int64, day time.Time) ([]int64, error) {
+	if err := ensurePool(r.pool); err != nil {
+		return nil, err
+	}
+	exec := getQ(ctx, r.pool)
+	rows, err := exec.Query(ctx,
+		`SELECT ga.game_id
+		 FROM game_officials ga
+		 INNER JOIN officials o ON o.id = ga.official_id AND o.league_id = $3
+		 WHERE ga.official_id = $1 AND ga.game_day = $2::DATE AND ga.active
+		 ORDER BY ga.game_id`,
+		officialID, day.Format(time.DateOnly), repository.LeagueID(ctx),
+	)
+	if err != nil {
+		return nil, repository.MapPgError(err)
+	}
+	defer rows.Close()
+	var ids []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, repository.MapPgError(err)
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+func (r *officialRepository) ListGames(ctx context.Context, officialID int64, p repository.Page) (repository.PageResult[model.OfficialGame], error) {
+	if err := ensurePool(r.pool); err != nil {
+		return repository.PageResult[model.OfficialGame]{}, err
+	}
+	limit, offset := sanitizeLimitOffset(p.Limit, p.Offset)
+	exec := getQ(ctx, r.pool)
+	rows, err := exec.Query(ctx,
+		`SELECT `+gameColumns+`, role, COUNT(*) OVER() AS total
+		 FROM (SELECT g.*, ga.role
+		       FROM games g
+		       INNER JOIN game_officials ga ON ga.game_id = g.id
+		       WHERE ga.official_id = $1 AND g.league_id = $2 AND g.deleted_at IS NULL) g
+		 ORDER BY date DESC, id DESC
+		 LIMIT $3 OFFSET $4`,
+		officialID, repository.LeagueID(ctx), limit, offset,
+	)
+	if err != nil {
+		return repository.PageResult[model.OfficialGame]{}, repository.MapPgError(err)
+	}
+	defer rows.Close()
+	res := repository.PageResult[model.OfficialGame]{Items: make([]model.OfficialGame, 0, limit)}
+	for rows.Next() {
+		var it model.OfficialGame
+		var total int
+		if err := scanGame(rows, &it.Game, &it.Role, &total); err != nil {
+			return repository.PageResult[model.OfficialGame]{}, repository.MapPgError(err)
+		}
+		res.Items = append(res.Items, it)
+		res.Total = total
+	}
+	return res, nil
+}
+
+// GetSummary sums player_stats.fouls per finished game the official worked. Lines of deleted players are left
+// out as in every other aggregate, and games without any stat line do not count towards the average.
+func (r *officialRepository) GetSummary(ctx context.Context, officialID int64, season *string) (model.OfficialSummary, error) {
+	if err := ensurePool(r.pool); err != nil {
+		return model.OfficialSummary{}, err
+	}
+	exec := getQ(ctx, r.pool)
+	out := model.OfficialSummary{OfficialID: officialID, Season: season}
+	err := exec.QueryRow(ctx,
+		`WITH per_game AS (
+			SELECT g.id, SUM(ps.fouls) AS fouls
+			FROM games g
+			INNER JOIN game_officials ga ON ga.game_id = g.id
+			INNER JOIN player_stats ps ON ps.game_id = g.id
+			INNER JOIN players p ON p.id = ps.player_id AND p.deleted_at IS NULL
+			WHERE ga.official_id = $1 AND g.league_id = $3 AND g.status = 'finished' AND g.deleted_at IS NULL
+			  AND ($2::TEXT IS NULL OR g.season = $2)
+			GROUP BY g.id
+		 )
+		 SELECT COUNT(*), COALESCE(SUM(fouls), 0)::BIGINT, COALESCE(ROUND(AVG(fouls), 2), 0)::FLOAT8
+		 FROM per_game`,
+		officialID, season, repository.LeagueID(ctx),
+	).Scan(&out.Games, &out.Fouls, &out.FoulsPerGame)
+	if err != nil {
+		return model.OfficialSummary{}, repository.MapPgError(err)
+	}
+	return out, nil
+}
+
+var _ repository.OfficialRepository = (*officialRepository)(nil)
@@ -3,6 +3,7 @@ package postgres
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -16,19 +17,48 @@ func NewPlayerRepository(pool *pgxpool.Pool) repository.PlayerRepository {
 	return &playerRepository{pool: pool}
 }
 
+// playerColumns is the canonical projection for model.Player; keep it in sync with scanPlayer.
+const playerColumns = `id, team_id, first_name, last_name, position,
+	jersey_number, height_cm, weight_kg, birth_date, nationality, handedness, secondary_positions, created_at, updated_at`
+
+// scanPlayer reads a row produced with playerColumns; extra destinations are appended after the player fields.
+func scanPlayer(row pgx.Row, p *model.Player, extra ...any) error {
+	dest := []any{&p.ID, &p.TeamID, &p.FirstName, &p.LastName, &p.Position,
+		&p.JerseyNumber, &p.HeightCm, &p.WeightKg, &p.BirthDate, &p.Nationality, &p.Handedness, &p.SecondaryPositions,
+		&p.CreatedAt, &p.UpdatedAt}
+	return row.Scan(append(dest, extra...)...)
+}
+
+const membershipColumns = `id, player_id, team_id, start_date, end_date, created_at`
+
+func scanMembership(row pgx.Row, m *model.Membership) error {
+	return row.Scan(&m.ID, &m.PlayerID, &m.TeamID, &m.StartDate, &m.EndDate, &m.CreatedAt)
+}
+
+// Create registers the player together with an open-ended initial membership in a single statement.
+// A team of another league fails the composite foreign key and is reported as ErrConflict; a jersey number
+// already worn on the team is an AlreadyExistsError naming jersey_number.
 func (r *playerRepository) Create(ctx context.Context, p model.Player) (model.Player, error) {
 	if err := ensurePool(r.pool); err != nil {
 		return model.Player{}, err
 	}
 	exec := getQ(ctx, r.pool)
 	row := exec.QueryRow(ctx,
-		`INSERT INTO players (team_id, first_name, last_name, position)
-		 VALUES ($1, $2, $3, $4)
-		 RETURNING id, team_id, first_name, last_name, position, created_at, updated_at`,
-		p.TeamID, p.FirstName, p.LastName, p.Position,
+		`WITH created AS (
+			INSERT INTO players (league_id, team_id, first_name, last_name, position,
+				jersey_number, height_cm, weight_kg, birth_date, nationality, handedness, secondary_positions)
+			VALUES ($5, $1, $2, $3, $4, $6, $7, $8, $9, $10, $11, $12)
+			RETURNING `+playerColumns+`
+		), membership AS (
+			INSERT INTO player_team_memberships (player_id, team_id)
+			SELECT id, team_id FROM created
+		)
+		SELECT `+playerColumns+` FROM created`,
+		p.TeamID, p.FirstName, p.LastName, p.Position, repository.LeagueID(ctx),
+		p.JerseyNumber, p.HeightCm, p.WeightKg, p.BirthDate, p.Nationality, p.Handedness, secondaryPositions(p.SecondaryPositions),
 	)
 	var out model.Player
-	if err := row.Scan(&out.ID, &out.TeamID, &out.FirstName, &out.LastName, &out.Position, &out.CreatedAt, &out.UpdatedAt); err != nil {
+	if err := scanPlayer(row, &out); err != nil {
 		return model.Player{}, repository.MapPgError(err)
 	}
 	return out, nil
@@ -40,11 +70,36 @@ func (r *playerRepository) GetByID(ctx context.Context, id int64) (model.Player,
 	}
 	exec := getQ(ctx, r.pool)
 	row := exec.QueryRow(ctx,
-		`SELECT id, team_id, first_name, last_name, position, created_at, updated_at
-		 FROM players WHERE id = $1`, id,
+		`SELECT `+playerColumns+`
+		 FROM players WHERE id = $1 AND league_id = $2 AND deleted_at IS NULL`, id, repository.LeagueID(ctx),
+	)
+	var out model.Player
+	if err := scanPlayer(row, &out); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Player{}, repository.ErrNotFound
+		}
+		return model.Player{}, repository.MapPgError(err)
+	}
+	return out, nil
+}
+
+// Update stores a player's name, position and bio. Deleted players are left alone and reported as ErrNotFound.
+func (r *playerRepository) Update(ctx context.Context, p model.Player) (model.Player, error) {
+	if err := ensurePool(r.pool); err != nil {
+		return model.Player{}, err
+	}
+	exec := getQ(ctx, r.pool)
+	row := exec.QueryRow(ctx,
+		`UPDATE players SET first_name = $2, last_name = $3, position = $4,
+			jersey_number = $6, height_cm = $7, weight_kg = $8, birth_date = $9, nationality = $10, handedness = $11,
+			secondary_positions = $12, updated_at = NOW()
+		 WHERE id = $1 AND league_id = $5 AND deleted_at IS NULL
+		 RETURNING `+playerColumns,
+		p.ID, p.FirstName, p.LastName, p.Position, repository.LeagueID(ctx),
+		p.JerseyNumber, p.HeightCm, p.WeightKg, p.BirthDate, p.Nationality, p.Handedness, secondaryPositions(p.SecondaryPositions),
 	)
 	var out model.Player
-	if err := row.Scan(&out.ID, &out.TeamID, &out.FirstName, &out.LastName, &out.Position, &out.CreatedAt, &out.UpdatedAt); err != nil {
+	if err := scanPlayer(row, &out); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return model.Player{}, repository.ErrNotFound
 		}
@@ -53,18 +108,67 @@ func (r *playerRepository) GetByID(ctx context.Context, id int64) (model.Player,
 	return out, nil
 }
 
-func (r *playerRepository) ListByTeam(ctx context.Context, teamID int64, p repository.Page) (repository.PageResult[model.Player], error) {
+// Delete marks a player as deleted. Memberships, stat lines and events are kept so a restore brings them back.
+func (r *playerRepository) Delete(ctx context.Context, id int64) error {
+	if err := ensurePool(r.pool); err != nil {
+		return err
+	}
+	exec := getQ(ctx, r.pool)
+	tag, err := exec.Exec(ctx,
+		`UPDATE players SET deleted_at = NOW(), updated_at = NOW() WHERE id = $1 AND league_id = $2 AND deleted_at IS NULL`,
+		id, repository.LeagueID(ctx),
+	)
+	if err != nil {
+		return repository.MapPgError(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return repository.ErrNotFound
+	}
+	return nil
+}
+
+// Restore undeletes a player; a jersey number taken on the team meanwhile is an AlreadyExistsError.
+func (r *playerRepository) Restore(ctx context.Context, id int64) (model.Player, error) {
+	if err := ensurePool(r.pool); err != nil {
+		return model.Player{}, err
+	}
+	exec := getQ(ctx, r.pool)
+	row := exec.QueryRow(ctx,
+		`UPDATE players SET deleted_at = NULL, updated_at = NOW()
+		 WHERE id = $1 AND league_id = $2 AND deleted_at IS NOT NULL
+		 RETURNING `+playerColumns, id, repository.LeagueID(ctx),
+	)
+	var out model.Player
+	if err := scanPlayer(row, &out); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Player{}, repository.ErrNotFound
+		}
+		return model.Player{}, repository.MapPgError(err)
+	}
+	return out, nil
+}
+
+// ListByTeam lists a team's roster through memberships: the current one when asOf is nil,
+// otherwise the memberships valid on that date.
+func (r *playerRepository) ListByTeam(ctx context.Context, teamID int64, asOf *time.Time, p repository.Page) (repository.PageResult[model.Player], error) {
 	if err := ensurePool(r.pool); err != nil {
 		return repository.PageResult[model.Player]{}, err
 	}
 	limit, offset := sanitizeLimitOffset(p.Limit, p.Offset)
 	exec := getQ(ctx, r.pool)
 	rows, err := exec.Query(ctx,
-		`SELECT id, team_id, first_name, last_name, position, created_at, updated_at, COUNT(*) OVER() AS total
-		 FROM players WHERE team_id = $1
-		 ORDER BY id
-		 LIMIT $2 OFFSET $3`,
-		teamID, limit, offset,
+		`SELECT p.id, p.team_id, p.first_name, p.last_name, p.position,
+			p.jersey_number, p.height_cm, p.weight_kg, p.birth_date, p.nationality, p.handedness, p.secondary_positions,
+			p.created_at, p.updated_at, COUNT(*) OVER() AS total
+		 FROM players p
+		 JOIN player_team_memberships m ON m.player_id = p.id
+		 WHERE m.team_id = $1 AND p.league_id = $5 AND p.deleted_at IS NULL
+		   AND CASE WHEN $2::DATE IS NULL THEN m.end_date IS NULL
+		            ELSE (m.start_date IS NULL OR m.start_date <= $2::DATE) AND (m.end_date IS NULL OR $2::DATE < m.end_date)
+		       END
+		 ORDER BY p.id
+		 LIMIT $3 OFFSET $4`,
+		teamID, asOf, limit, offset, repository.LeagueID(ctx),
 	)
 	if err != nil {
 		return repository.PageResult[model.Player]{}, repository.MapPgError(err)
@@ -74,7 +178,7 @@ func (r *playerRepository) ListByTeam(ctx context.Context, teamID int64, p repos
 	for rows.Next() {
 		var it model.Player
 		var total int
-		if err := rows.Scan(&it.ID, &it.TeamID, &it.FirstName, &it.LastName, &it.Position, &it.CreatedAt, &it.UpdatedAt, &total); err != nil {
+		if err := scanPlayer(rows, &it, &total); err != nil {
 			return repository.PageResult[model.Player]{}, repository.MapPgError(err)
 		}
 		res.Items = append(res.Items, it)
@@ -83,6 +187,108 @@ func (r *playerRepository) ListByTeam(ctx context.Context, teamID int64, p repos
 	return res, nil
 }
 
+func (r *playerRepository) ListMemberships(ctx context.Context, playerID int64) ([]model.Membership, error) {
+	if err := ensurePool(r.pool); err != nil {
+		return nil, err
+	}
+	exec := getQ(ctx, r.pool)
+	rows, err := exec.Query(ctx,
+		`SELECT `+membershipColumns+`
+		 FROM player_team_memberships
+		 WHERE player_id = $1 AND player_id IN (SELECT id FROM players WHERE league_id = $2)
+		 ORDER BY start_date NULLS FIRST, id`, playerID, repository.LeagueID(ctx),
+	)
+	if err != nil {
+		return nil, repository.MapPgError(err)
+	}
+	defer rows.Close()
+	res := make([]model.Membership, 0, 4)
+	for rows.Next() {
+		var it model.Membership
+		if err := scanMembership(rows, &it); err != nil {
+			return nil, repository.MapPgError(err)
+		}
+		res = append(res, it)
+	}
+	return res, nil
+}
+
+// TeamOn uses the same half-open membership window as the historical roster and the player_game_teams view,
+// so a player traded on a game day belongs to the new team for that game.
+func (r *playerRepository) TeamOn(ctx context.Context, playerID int64, on time.Time) (int64, error) {
+	if err := ensurePool(r.pool); err != nil {
+		return 0, err
+	}
+	exec := getQ(ctx, r.pool)
+	var teamID int64
+	err := exec.QueryRow(ctx,
+		`SELECT m.team_id
+		 FROM player_team_memberships m
+		 JOIN players p ON p.id = m.player_id AND p.league_id = $3 AND p.deleted_at IS NULL
+		 WHERE m.player_id = $1
+		   AND (m.start_date IS NULL OR m.start_date <= $2::DATE)
+		   AND (m.end_date IS NULL OR $2::DATE < m.end_date)
+		 ORDER BY m.start_date DESC NULLS LAST
+		 LIMIT 1`,
+		playerID, on, repository.LeagueID(ctx),
+	).Scan(&teamID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, repository.ErrNotFound
+		}
+		return 0, repository.MapPgError(err)
+	}
+	return teamID, nil
+}
+
+// Transfer moves players.team_id to the new team, closes the player's open membership on the given date and opens
+// one with the new team from that date. The jersey number stays with the old team, so the player arrives without one.
+// The player row goes first, so a player of another league is ErrNotFound and
+// a team of another league fails the composite foreign key before any membership changes. It touches three
+// statements, so callers run it inside a transaction.
+func (r *playerRepository) Transfer(ctx context.Context, playerID, teamID int64, on time.Time) (model.Membership, error) {
+	if err := ensurePool(r.pool); err != nil {
+		return model.Membership{}, err
+	}
+	exec := getQ(ctx, r.pool)
+	tag, err := exec.Exec(ctx,
+		`UPDATE players SET team_id = $2, jersey_number = NULL, updated_at = NOW()
+		 WHERE id = $1 AND league_id = $3 AND deleted_at IS NULL`,
+		playerID, teamID, repository.LeagueID(ctx),
+	)
+	if err != nil {
+		return model.Membership{}, repository.MapPgError(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return model.Membership{}, repository.ErrNotFound
+	}
+	if _, err := exec.Exec(ctx,
+		`UPDATE player_team_memberships SET end_date = $2 WHERE player_id = $1 AND end_date IS NULL`,
+		playerID, on,
+	); err != nil {
+		return model.Membership{}, repository.MapPgError(err)
+	}
+	row := exec.QueryRow(ctx,
+		`INSERT INTO player_team_memberships (player_id, team_id, start_date)
+		 VALUES ($1, $2, $3)
+		 RETURNING `+membershipColumns,
+		playerID, teamID, on,
+	)
+	var out model.Membership
+	if err := scanMembership(row, &out); err != nil {
+		return model.Membership{}, repository.MapPgError(err)
+	}
+	return out, nil
+}
+
+// secondaryPositions stores a missing list as an empty array, as the column is NOT NULL.
+func secondaryPositions(v []string) []string {
+	if v == nil {
+		return []string{}
+	}
+	return v
+}
+
 // Exists performs a lightweight check to see if a player with the given ID exists.
 func (r *playerRepository) Exists(ctx context.Context, id int64) (bool, error) {
 	if err := ensurePool(r.pool); err != nil {
@@ -90,55 +296,95 @@ func (r *playerRepository) Exists(ctx context.Context, id int64) (bool, error) {
 	}
 	var exists bool
 	exec := getQ(ctx, r.pool)
-	err := exec.QueryRow(ctx, `SELECT EXISTS(SELECT 1 FROM players WHERE id = $1)`, id).Scan(&exists)
+	err := exec.QueryRow(ctx,
+		`SELECT EXISTS(SELECT 1 FROM players WHERE id = $1 AND league_id = $2 AND deleted_at IS NULL)`,
+		id, repository.LeagueID(ctx),
+	).Scan(&exists)
 	if err != nil {
 		return false, repository.MapPgError(err)
 	}
 	return exists, nil
 }
 
+// playedLine is true for a player_stats row aliased ps that shows the player got on the floor.
+// A line of zeros, as kept for a DNP, is not a game played. Mirrors model.PlayerStatLine.Played.
+const playedLine = `(ps.minutes_played > 0 OR ps.points > 0 OR ps.rebounds > 0 OR ps.assists > 0 OR ps.steals > 0
+		OR ps.blocks > 0 OR ps.turnovers > 0 OR ps.fouls > 0 OR ps.field_goals_attempted > 0 OR ps.free_throws_attempted > 0)`
+
+// availableLine is true when the player could play: an availability record decides when there is one,
+// otherwise a stat line counts as being in uniform. Expects player_game_availability aliased a.
+const availableLine = `CASE WHEN a.status IS NOT NULL THEN a.status IN ('active', 'dnp_coach') ELSE ps.id IS NOT NULL END`
+
+// playerAggregateColumns is the projection for model.PlayerAggregatedStats over rows aliased ps that carry
+// the player_stats columns plus the booleans played and available; keep it in sync with scanPlayerAggregate.
+const playerAggregateColumns = `
+		COUNT(*) FILTER (WHERE ps.played) AS games_played,
+		COUNT(*) FILTER (WHERE ps.available) AS games_available,
+		COALESCE(SUM(ps.points), 0) AS total_points,
+		COALESCE(SUM(ps.rebounds), 0) AS total_rebounds,
+		COALESCE(SUM(ps.assists), 0) AS total_assists,
+		COALESCE(SUM(ps.steals), 0) AS total_steals,
+		COALESCE(SUM(ps.blocks), 0) AS total_blocks,
+		COALESCE(ROUND(AVG(ps.points) FILTER (WHERE ps.played), 2), 0) AS avg_points,
+		COALESCE(ROUND(AVG(ps.rebounds) FILTER (WHERE ps.played), 2), 0) AS avg_rebounds,
+		COALESCE(ROUND(AVG(ps.assists) FILTER (WHERE ps.played), 2), 0) AS avg_assists,
+		COALESCE(SUM(ps.field_goals_made), 0) AS total_fgm,
+		COALESCE(SUM(ps.field_goals_attempted), 0) AS total_fga,
+		COALESCE(SUM(ps.three_pointers_made), 0) AS total_3pm,
+		COALESCE(SUM(ps.three_pointers_attempted), 0) AS total_3pa,
+		COALESCE(SUM(ps.free_throws_made), 0) AS total_ftm,
+		COALESCE(SUM(ps.free_throws_attempted), 0) AS total_fta,
+		COALESCE(SUM(ps.offensive_rebounds), 0) AS total_oreb,
+		COALESCE(SUM(ps.defensive_rebounds), 0) AS total_dreb,
+		-- Percentages: NULLIF turns "no attempts" into NULL, which COALESCE reports as 0.
+		COALESCE(ROUND(SUM(ps.field_goals_made)::NUMERIC / NULLIF(SUM(ps.field_goals_attempted), 0), 3), 0) AS fg_pct,
+		COALESCE(ROUND(SUM(ps.three_pointers_made)::NUMERIC / NULLIF(SUM(ps.three_pointers_attempted), 0), 3), 0) AS three_pt_pct,
+		COALESCE(ROUND(SUM(ps.free_throws_made)::NUMERIC / NULLIF(SUM(ps.free_throws_attempted), 0), 3), 0) AS ft_pct,
+		-- eFG% = (FGM + 0.5 * 3PM) / FGA
+		COALESCE(ROUND((SUM(ps.field_goals_made) + 0.5 * SUM(ps.three_pointers_made)) / NULLIF(SUM(ps.field_goals_attempted), 0), 3), 0) AS efg_pct,
+		-- TS% = PTS / (2 * (FGA + 0.44 * FTA))
+		COALESCE(ROUND(SUM(ps.points) / NULLIF(2 * (SUM(ps.field_goals_attempted) + 0.44 * SUM(ps.free_throws_attempted)), 0), 3), 0) AS ts_pct`
+
+// scanPlayerAggregate reads a row produced with playerAggregateColumns; extra destinations are appended after the stats.
+func scanPlayerAggregate(row pgx.Row, stats *model.PlayerAggregatedStats, extra ...any) error {
+	dest := []any{
+		&stats.GamesPlayed, &stats.GamesAvailable, &stats.TotalPoints, &stats.TotalRebounds, &stats.TotalAssists, &stats.TotalSteals, &stats.TotalBlocks,
+		&stats.AvgPoints, &stats.AvgRebounds, &stats.AvgAssists,
+		&stats.TotalFieldGoalsMade, &stats.TotalFieldGoalsAttempted, &stats.TotalThreePointersMade, &stats.TotalThreePointersAttempted,
+		&stats.TotalFreeThrowsMade, &stats.TotalFreeThrowsAttempted, &stats.TotalOffensiveRebounds, &stats.TotalDefensiveRebounds,
+		&stats.FieldGoalPct, &stats.ThreePointPct, &stats.FreeThrowPct, &stats.EffectiveFGPct, &stats.TrueShootingPct,
+	}
+	return row.Scan(append(dest, extra...)...)
+}
+
 // GetPlayerAggregatedStats calculates and returns a player's aggregated statistics.
-// It can filter stats by a specific season. If season is nil, it calculates career stats.
-// The query joins player_stats with games to filter by season and aggregates the results.
-func (r *playerRepository) GetPlayerAggregatedStats(ctx context.Context, playerID int64, season *string) (model.PlayerAggregatedStats, error) {
+// It can filter stats by a specific season and phase. If season is nil, it calculates career stats;
+// a nil phase includes preseason and playoff games.
+// Every game with a stat line or an availability record for the player is one row, so games the player
+// was available for without getting a line still count towards games_available.
+func (r *playerRepository) GetPlayerAggregatedStats(ctx context.Context, playerID int64, season, phase *string) (model.PlayerAggregatedStats, error) {
 	if err := ensurePool(r.pool); err != nil {
 		return model.PlayerAggregatedStats{}, err
 	}
 
 	query := `
-		SELECT
-			COALESCE(COUNT(ps.id), 0) AS games_played,
-			COALESCE(SUM(ps.points), 0) AS total_points,
-			COALESCE(SUM(ps.rebounds), 0) AS total_rebounds,
-			COALESCE(SUM(ps.assists), 0) AS total_assists,
-			COALESCE(SUM(ps.steals), 0) AS total_steals,
-			COALESCE(SUM(ps.blocks), 0) AS total_blocks,
-			COALESCE(ROUND(AVG(ps.points), 2), 0) AS avg_points,
-			COALESCE(ROUND(AVG(ps.rebounds), 2), 0) AS avg_rebounds,
-			COALESCE(ROUND(AVG(ps.assists), 2), 0) AS avg_assists
-		FROM
-			player_stats ps
-		INNER JOIN games g ON ps.game_id = g.id
-		WHERE
-			ps.player_id = $1 AND ($2::TEXT IS NULL OR g.season = $2)
+		SELECT ` + playerAggregateColumns + `
+		FROM (
+			SELECT ps.*, COALESCE(` + playedLine + `, FALSE) AS played, ` + availableLine + ` AS available
+			FROM games g
+			LEFT JOIN player_stats ps ON ps.game_id = g.id AND ps.player_id = $1
+			LEFT JOIN player_game_availability a ON a.game_id = g.id AND a.player_id = $1
+			WHERE g.deleted_at IS NULL AND g.league_id = $4
+				AND ($2::TEXT IS NULL OR g.season = $2) AND ($3::TEXT IS NULL OR g.phase = $3)
+				AND (ps.id IS NOT NULL OR a.game_id IS NOT NULL)
+		) ps
 	`
 
 	exec := getQ(ctx, r.pool)
-	row := exec.QueryRow(ctx, query, playerID, season)
+	row := exec.QueryRow(ctx, query, playerID, season, phase, repository.LeagueID(ctx))
 
 	var stats model.PlayerAggregatedStats
-	err := row.Scan(
-		&stats.GamesPlayed,
-		&stats.TotalPoints,
-		&stats.TotalRebounds,
-		&stats.TotalAssists,
-		&stats.TotalSteals,
-		&stats.TotalBlocks,
-		&stats.AvgPoints,
-		&stats.AvgRebounds,
-		&stats.AvgAssists,
-	)
-	if err != nil {
+	if err := scanPlayerAggregate(row, &stats); err != nil {
 		return model.PlayerAggregatedStats{}, repository.MapPgError(err)
 	}
 
@@ -0,0 +1,94 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/maxviazov/basketball-stats-service/internal/model"
+	"github.com/maxviazov/basketball-stats-service/internal/repository"
+)
+
+type ruleProfileRepository struct{ pool *pgxpool.Pool }
+
+func NewRuleProfileRepository(pool *pgxpool.Pool) repository.RuleProfileRepository {
+	return &ruleProfileRepository{pool: pool}
+}
+
+const ruleProfileColumns = `id, name, period_minutes, periods, overtime_minutes, foul_limit, roster_size, created_at, updated_at`
+
+func scanRuleProfile(row pgx.Row, r *model.RuleProfile, extra ...any) error {
+	dest := []any{&r.ID, &r.Name, &r.PeriodMinutes, &r.Periods, &r.OvertimeMinutes, &r.FoulLimit, &r.RosterSize, &r.CreatedAt, &r.UpdatedAt}
+	return row.Scan(append(dest, extra...)...)
+}
+
+// nullableID passes a zero id as NULL, so the query can COALESCE it to a default.
+func nullableID(id int64) *int64 {
+	if id == 0 {
+		return nil
+	}
+	return &id
+}
+
+func (r *ruleProfileRepository) Create(ctx context.Context, p model.RuleProfile) (model.RuleProfile, error) {
+	if err := ensurePool(r.pool); err != nil {
+		return model.RuleProfile{}, err
+	}
+	exec := getQ(ctx, r.pool)
+	row := exec.QueryRow(ctx,
+		`INSERT INTO rule_profiles (name, period_minutes, periods, overtime_minutes, foul_limit, roster_size)
+		 VALUES ($1, $2, $3, $4, $5, $6)
+		 RETURNING `+ruleProfileColumns,
+		p.Name, p.PeriodMinutes, p.Periods, p.OvertimeMinutes, p.FoulLimit, p.RosterSize,
+	)
+	var out model.RuleProfile
+	if err := scanRuleProfile(row, &out); err != nil {
+		return model.RuleProfile{}, repository.MapPgError(err)
+	}
+	return out, nil
+}
+
+func (r *ruleProfileRepository) GetByID(ctx context.Context, id int64) (model.RuleProfile, error) {
+	if err := ensurePool(r.pool); err != nil {
+		return model.RuleProfile{}, err
+	}
+	exec := getQ(ctx, r.pool)
+	var out model.RuleProfile
+	if err := scanRuleProfile(exec.QueryRow(ctx, `SELECT `+ruleProfileColumns+` FROM rule_profiles WHERE id = $1`, id), &out); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.RuleProfile{}, repository.ErrNotFound
+		}
+		return model.RuleProfile{}, repository.MapPgError(err)
+	}
+	return out, nil
+}
+
+func (r *ruleProfileRepository) List(ctx context.Context, p repository.Page) (repository.PageResult[model.RuleProfile], error) {
+	if err := ensurePool(r.pool); err != nil {
+		return repository.PageResult[model.RuleProfile]{}, err
+	}
+	limit, offset := sanitizeLimitOffset(p.Limit, p.Offset)
+	exec := getQ(ctx, r.pool)
+	rows, err := exec.Query(ctx,
+		`SELECT `+ruleProfileColumns+`, COUNT(*) OVER() AS total
+		 FROM rule_profiles
+		 ORDER BY id
+		 LIMIT $1 OFFSET $2`, limit, offset,
+	)
+	if err != nil {
+		return repository.PageResult[model.RuleProfile]{}, repository.MapPgError(err)
+	}
+	defer rows.Close()
+	res := repository.PageResult[model.RuleProfile]{Items: make([]model.RuleProfile, 0, limit)}
+	for rows.Next() {
+		var it model.RuleProfile
+		var total int
+		if err := scanRuleProfile(rows, &it, &total); err != nil {
+			return repository.PageResult[model.RuleProfile]{}, repository.MapPgError(err)
+		}
+		res.Items = append(res.Items, it)
+		res.Total = total
+	}
+	return res, nil
+}
@@ -0,0 +1,64 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/maxviazov/basketball-stats-service/internal/model"
+	"github.com/maxviazov/basketball-stats-service/internal/repository"
+)
+
+type searchRepository struct{ pool *pgxpool.Pool }
+
+func NewSearchRepository(pool *pgxpool.Pool) repository.SearchRepository {
+	return &searchRepository{pool: pool}
+}
+
+// Search matches names through search_key, the same expression the trigram indexes are built on.
+// A name matches when it is similar to the query as a whole (%) or contains a word similar to it (<%),
+// so both "lebron jmaes" and a lone "lebron" find LeBron James; the score is the better of the two similarities.
+func (r *searchRepository) Search(ctx context.Context, q string, kind *string, p repository.Page) (repository.PageResult[model.SearchResult], error) {
+	if err := ensurePool(r.pool); err != nil {
+		return repository.PageResult[model.SearchResult]{}, err
+	}
+	limit, offset := sanitizeLimitOffset(p.Limit, p.Offset)
+	exec := getQ(ctx, r.pool)
+	rows, err := exec.Query(ctx,
+		`WITH q AS (SELECT search_key($1) AS key),
+		candidates AS (
+			SELECT 'team' AS type, t.id, t.name, NULL::INT AS team_id, search_key(t.name) AS key
+			FROM teams t, q
+			WHERE t.league_id = $5 AND t.deleted_at IS NULL AND ($2::TEXT IS NULL OR $2 = 'team')
+				AND (search_key(t.name) % q.key OR q.key <% search_key(t.name))
+			UNION ALL
+			SELECT 'player', p.id, p.first_name || ' ' || p.last_name, p.team_id, search_key(p.first_name || ' ' || p.last_name)
+			FROM players p, q
+			WHERE p.league_id = $5 AND p.deleted_at IS NULL AND ($2::TEXT IS NULL OR $2 = 'player')
+				AND (search_key(p.first_name || ' ' || p.last_name) % q.key OR q.key <% search_key(p.first_name || ' ' || p.last_name))
+		)
+		SELECT c.type, c.id, c.name, c.team_id,
+			GREATEST(similarity(c.key, q.key), word_similarity(q.key, c.key))::FLOAT8 AS score,
+			COUNT(*) OVER() AS total
+		FROM candidates c, q
+		ORDER BY score DESC, c.name, c.type, c.id
+		LIMIT $3 OFFSET $4`,
+		q, kind, limit, offset, repository.LeagueID(ctx),
+	)
+	if err != nil {
+		return repository.PageResult[model.SearchResult]{}, repository.MapPgError(err)
+	}
+	defer rows.Close()
+	res := repository.PageResult[model.SearchResult]{Items: make([]model.SearchResult, 0, limit)}
+	for rows.Next() {
+		var it model.SearchResult
+		var total int
+		if err := rows.Scan(&it.Type, &it.ID, &it.Name, &it.TeamID, &it.Score, &total); err != nil {
+			return repository.PageResult[model.SearchResult]{}, repository.MapPgError(err)
+		}
+		res.Items = append(res.Items, it)
+		res.Total = total
+	}
+	return res, nil
+}
+
+var _ repository.SearchRepository = (*searchRepository)(nil)
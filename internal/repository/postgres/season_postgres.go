@@ -0,0 +1,150 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/maxviazov/basketball-stats-service/internal/model"
+	"github.com/maxviazov/basketball-stats-service/internal/repository"
+)
+
+type seasonRepository struct{ pool *pgxpool.Pool }
+
+func NewSeasonRepository(pool *pgxpool.Pool) repository.SeasonRepository {
+	return &seasonRepository{pool: pool}
+}
+
+const seasonColumns = `id, name, start_date, end_date, rule_profile_id, created_at, updated_at`
+
+func scanSeason(row pgx.Row, s *model.Season, extra ...any) error {
+	dest := []any{&s.ID, &s.Name, &s.StartDate, &s.EndDate, &s.RuleProfileID, &s.CreatedAt, &s.UpdatedAt}
+	return row.Scan(append(dest, extra...)...)
+}
+
+// Create inserts the season and its phases; callers run it inside a transaction.
+// A season without a rule profile plays under NBA rules; an unknown profile is ErrConflict.
+func (r *seasonRepository) Create(ctx context.Context, s model.Season) (model.Season, error) {
+	if err := ensurePool(r.pool); err != nil {
+		return model.Season{}, err
+	}
+	exec := getQ(ctx, r.pool)
+	row := exec.QueryRow(ctx,
+		`INSERT INTO seasons (league_id, name, start_date, end_date, rule_profile_id)
+		 VALUES ($5, $1, $2, $3, COALESCE($4, (SELECT id FROM rule_profiles WHERE name = 'nba')))
+		 RETURNING `+seasonColumns,
+		s.Name, s.StartDate, s.EndDate, nullableID(s.RuleProfileID), repository.LeagueID(ctx),
+	)
+	var out model.Season
+	if err := scanSeason(row, &out); err != nil {
+		return model.Season{}, repository.MapPgError(err)
+	}
+	for _, p := range s.Phases {
+		if _, err := exec.Exec(ctx,
+			`INSERT INTO season_phases (season_id, phase, start_date, end_date) VALUES ($1, $2, $3, $4)`,
+			out.ID, p.Phase, p.StartDate, p.EndDate,
+		); err != nil {
+			return model.Season{}, repository.MapPgError(err)
+		}
+	}
+	phases, err := r.listPhases(ctx, out.ID)
+	if err != nil {
+		return model.Season{}, err
+	}
+	out.Phases = phases
+	return out, nil
+}
+
+func (r *seasonRepository) GetByID(ctx context.Context, id int64) (model.Season, error) {
+	return r.getOne(ctx, `SELECT `+seasonColumns+` FROM seasons WHERE id = $1 AND league_id = $2`, id)
+}
+
+func (r *seasonRepository) GetByName(ctx context.Context, name string) (model.Season, error) {
+	return r.getOne(ctx, `SELECT `+seasonColumns+` FROM seasons WHERE name = $1 AND league_id = $2`, name)
+}
+
+// getOne runs a single-season query whose second parameter is the league.
+func (r *seasonRepository) getOne(ctx context.Context, query string, arg any) (model.Season, error) {
+	if err := ensurePool(r.pool); err != nil {
+		return model.Season{}, err
+	}
+	exec := getQ(ctx, r.pool)
+	var out model.Season
+	if err := scanSeason(exec.QueryRow(ctx, query, arg, repository.LeagueID(ctx)), &out); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Season{}, repository.ErrNotFound
+		}
+		return model.Season{}, repository.MapPgError(err)
+	}
+	phases, err := r.listPhases(ctx, out.ID)
+	if err != nil {
+		return model.Season{}, err
+	}
+	out.Phases = phases
+	return out, nil
+}
+
+func (r *seasonRepository) List(ctx context.Context, p repository.Page) (repository.PageResult[model.Season], error) {
+	if err := ensurePool(r.pool); err != nil {
+		return repository.PageResult[model.Season]{}, err
+	}
+	limit, offset := sanitizeLimitOffset(p.Limit, p.Offset)
+	exec := getQ(ctx, r.pool)
+	rows, err := exec.Query(ctx,
+		`SELECT `+seasonColumns+`, COUNT(*) OVER() AS total
+		 FROM seasons
+		 WHERE league_id = $1
+		 ORDER BY start_date DESC, id DESC
+		 LIMIT $2 OFFSET $3`,
+		repository.LeagueID(ctx), limit, offset,
+	)
+	if err != nil {
+		return repository.PageResult[model.Season]{}, repository.MapPgError(err)
+	}
+	res := repository.PageResult[model.Season]{Items: make([]model.Season, 0, limit)}
+	for rows.Next() {
+		var it model.Season
+		var total int
+		if err := scanSeason(rows, &it, &total); err != nil {
+			rows.Close()
+			return repository.PageResult[model.Season]{}, repository.MapPgError(err)
+		}
+		res.Items = append(res.Items, it)
+		res.Total = total
+	}
+	rows.Close()
+	// Phases are loaded after the page is read; a connection can only run one query at a time.
+	for i := range res.Items {
+		phases, err := r.listPhases(ctx, res.Items[i].ID)
+		if err != nil {
+			return repository.PageResult[model.Season]{}, err
+		}
+		res.Items[i].Phases = phases
+	}
+	return res, nil
+}
+
+func (r *seasonRepository) listPhases(ctx context.Context, seasonID int64) ([]model.SeasonPhase, error) {
+	exec := getQ(ctx, r.pool)
+	rows, err := exec.Query(ctx,
+		`SELECT phase, start_date, end_date
+		 FROM season_phases WHERE season_id = $1
+		 ORDER BY start_date, phase`, seasonID,
+	)
+	if err != nil {
+		return nil, repository.MapPgError(err)
+	}
+	defer rows.Close()
+	res := make([]model.SeasonPhase, 0, 3)
+	for rows.Next() {
+		var it model.SeasonPhase
+		if err := rows.Scan(&it.Phase, &it.StartDate, &it.EndDate); err != nil {
+			return nil, repository.MapPgError(err)
+		}
+		res = append(res, it)
+	}
+	return res, nil
+}
+
+var _ repository.SeasonRepository = (*seasonRepository)(nil)
@@ -0,0 +1,149 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/maxviazov/basketball-stats-service/internal/model"
+	"github.com/maxviazov/basketball-stats-service/internal/repository"
+)
+
+type shotRepository struct{ pool *pgxpool.Pool }
+
+func NewShotRepository(pool *pgxpool.Pool) repository.ShotRepository {
+	return &shotRepository{pool: pool}
+}
+
+const shotColumns = `id, game_id, player_id, period, clock_seconds, x, y, shot_type, made, zone, created_at`
+
+func scanShot(row pgx.Row, s *model.Shot) error {
+	return row.Scan(&s.ID, &s.GameID, &s.PlayerID, &s.Period, &s.ClockSeconds, &s.X, &s.Y, &s.ShotType, &s.Made, &s.Zone, &s.CreatedAt)
+}
+
+// Create selects the game and the player from the league, so a reference into another league inserts
+// no row and is reported like a dangling foreign key.
+func (r *shotRepository) Create(ctx context.Context, s model.Shot) (model.Shot, error) {
+	if err := ensurePool(r.pool); err != nil {
+		return model.Shot{}, err
+	}
+	exec := getQ(ctx, r.pool)
+	row := exec.QueryRow(ctx,
+		`INSERT INTO shots (game_id, player_id, period, clock_seconds, x, y, shot_type, made, zone)
+		 SELECT g.id, p.id, $3, $4, $5, $6, $7, $8, $9
+		 FROM games g
+		 INNER JOIN players p ON p.id = $2 AND p.league_id = g.league_id AND p.deleted_at IS NULL
+		 WHERE g.id = $1 AND g.league_id = $10 AND g.deleted_at IS NULL
+		 RETURNING `+shotColumns,
+		s.GameID, s.PlayerID, s.Period, s.ClockSeconds, s.X, s.Y, s.ShotType, s.Made, s.Zone, repository.LeagueID(ctx),
+	)
+	var out model.Shot
+	if err := scanShot(row, &out); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Shot{}, repository.ErrConflict
+		}
+		return model.Shot{}, repository.MapPgError(err)
+	}
+	return out, nil
+}
+
+func (r *shotRepository) ListByGame(ctx context.Context, gameID int64) ([]model.Shot, error) {
+	if err := ensurePool(r.pool); err != nil {
+		return nil, err
+	}
+	exec := getQ(ctx, r.pool)
+	rows, err := exec.Query(ctx,
+		`SELECT `+shotColumns+`
+		 FROM shots
+		 WHERE game_id = $1 AND game_id IN (SELECT id FROM games WHERE league_id = $2)
+		 ORDER BY period, clock_seconds DESC, id`, gameID, repository.LeagueID(ctx),
+	)
+	if err != nil {
+		return nil, repository.MapPgError(err)
+	}
+	defer rows.Close()
+	res := make([]model.Shot, 0, 64)
+	for rows.Next() {
+		var it model.Shot
+		if err := scanShot(rows, &it); err != nil {
+			return nil, repository.MapPgError(err)
+		}
+		res = append(res, it)
+	}
+	return res, nil
+}
+
+func (r *shotRepository) Delete(ctx context.Context, gameID, shotID int64) error {
+	if err := ensurePool(r.pool); err != nil {
+		return err
+	}
+	exec := getQ(ctx, r.pool)
+	tag, err := exec.Exec(ctx,
+		`DELETE FROM shots
+		 WHERE id = $1 AND game_id = $2 AND game_id IN (SELECT id FROM games WHERE league_id = $3)`,
+		shotID, gameID, repository.LeagueID(ctx),
+	)
+	if err != nil {
+		return repository.MapPgError(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return repository.ErrNotFound
+	}
+	return nil
+}
+
+func (r *shotRepository) CountByPlayerGame(ctx context.Context, playerID, gameID int64) (model.ShotCounts, error) {
+	if err := ensurePool(r.pool); err != nil {
+		return model.ShotCounts{}, err
+	}
+	exec := getQ(ctx, r.pool)
+	var out model.ShotCounts
+	if err := exec.QueryRow(ctx,
+		`SELECT COUNT(*), COUNT(*) FILTER (WHERE made),
+			COUNT(*) FILTER (WHERE shot_type = 'three_pt'), COUNT(*) FILTER (WHERE shot_type = 'three_pt' AND made)
+		 FROM shots
+		 WHERE player_id = $1 AND game_id = $2 AND game_id IN (SELECT id FROM games WHERE league_id = $3)`,
+		playerID, gameID, repository.LeagueID(ctx),
+	).Scan(&out.Attempts, &out.Makes, &out.ThreeAttempts, &out.ThreeMakes); err != nil {
+		return model.ShotCounts{}, repository.MapPgError(err)
+	}
+	return out, nil
+}
+
+func (r *shotRepository) LockGame(ctx context.Context, gameID int64) error {
+	if err := ensurePool(r.pool); err != nil {
+		return err
+	}
+	return lockGame(ctx, getQ(ctx, r.pool), gameID)
+}
+
+func (r *shotRepository) GetShotChart(ctx context.Context, playerID int64, season *string) ([]model.ShotZoneStats, error) {
+	if err := ensurePool(r.pool); err != nil {
+		return nil, err
+	}
+	exec := getQ(ctx, r.pool)
+	rows, err := exec.Query(ctx,
+		`SELECT s.zone, COUNT(*), COUNT(*) FILTER (WHERE s.made)
+		 FROM shots s
+		 INNER JOIN games g ON g.id = s.game_id AND g.deleted_at IS NULL
+		 WHERE s.player_id = $1 AND g.league_id = $2 AND ($3::TEXT IS NULL OR g.season = $3)
+		 GROUP BY s.zone`,
+		playerID, repository.LeagueID(ctx), season,
+	)
+	if err != nil {
+		return nil, repository.MapPgError(err)
+	}
+	defer rows.Close()
+	res := make([]model.ShotZoneStats, 0, 5)
+	for rows.Next() {
+		var it model.ShotZoneStats
+		if err := rows.Scan(&it.Zone, &it.Attempts, &it.Makes); err != nil {
+			return nil, repository.MapPgError(err)
+		}
+		res = append(res, it)
+	}
+	return res, nil
+}
+
+var _ repository.ShotRepository = (*shotRepository)(nil)
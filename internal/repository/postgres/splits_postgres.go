@@ -0,0 +1,121 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/maxviazov/basketball-stats-service/internal/model"
+	"github.com/maxviazov/basketball-stats-service/internal/repository"
+)
+
+// splitBuckets unfolds every game row into one row per split dimension, so a single GROUP BY
+// produces all requested splits at once. The source must expose date, is_home, result, opponent_id and rest_days.
+// A NULL key, such as the result of a game without an official score, keeps the game out of that dimension only.
+const splitBuckets = `
+		CROSS JOIN LATERAL (VALUES
+			('location', CASE WHEN is_home THEN 'home' ELSE 'away' END),
+			('result', result),
+			('month', TO_CHAR(date, 'YYYY-MM')),
+			('opponent', opponent_id::TEXT),
+			('rest', CASE WHEN rest_days IS NULL THEN 'first' WHEN rest_days >= 3 THEN '3+' ELSE rest_days::TEXT END)
+		) AS d(dimension, key)
+		WHERE d.dimension = ANY($4::TEXT[]) AND d.key IS NOT NULL
+		GROUP BY d.dimension, d.key
+		ORDER BY ARRAY_POSITION($4::TEXT[], d.dimension), d.key`
+
+// GetPlayerSplits aggregates a player's lines per split bucket. Lines are attributed to the player's team on the
+// game date, as elsewhere; rest days count the calendar days since the player's previous game in the filtered set,
+// so the first game of the range falls into the "first" bucket and back-to-backs into "0".
+// Buckets are built from stat lines, so games_available only counts games the player has a line in.
+func (r *playerRepository) GetPlayerSplits(ctx context.Context, playerID int64, season, phase *string, dimensions []string) ([]model.PlayerSplit, error) {
+	if err := ensurePool(r.pool); err != nil {
+		return nil, err
+	}
+
+	query := `
+		WITH lines AS (
+			SELECT ps.*, g.date, ` + playedLine + ` AS played, ` + availableLine + ` AS available,
+				t.team_id = g.home_team_id AS is_home,
+				CASE WHEN t.team_id = g.home_team_id THEN g.away_team_id ELSE g.home_team_id END AS opponent_id,
+				CASE WHEN gr.game_id IS NULL THEN NULL WHEN gr.winner_id = t.team_id THEN 'W' ELSE 'L' END AS result,
+				GREATEST(g.date::DATE - LAG(g.date::DATE) OVER (ORDER BY g.date, g.id) - 1, 0) AS rest_days
+			FROM player_stats ps
+			INNER JOIN games g ON g.id = ps.game_id AND g.deleted_at IS NULL
+			INNER JOIN players p ON p.id = ps.player_id
+			LEFT JOIN player_game_teams pgt ON pgt.player_id = ps.player_id AND pgt.game_id = ps.game_id
+			CROSS JOIN LATERAL (SELECT COALESCE(pgt.team_id, p.team_id) AS team_id) t
+			LEFT JOIN game_results gr ON gr.game_id = g.id
+			LEFT JOIN player_game_availability a ON a.game_id = ps.game_id AND a.player_id = ps.player_id
+			WHERE ps.player_id = $1 AND g.league_id = $5
+				AND ($2::TEXT IS NULL OR g.season = $2) AND ($3::TEXT IS NULL OR g.phase = $3)
+		)
+		SELECT ` + playerAggregateColumns + `, d.dimension, d.key
+		FROM lines ps` + splitBuckets
+
+	exec := getQ(ctx, r.pool)
+	rows, err := exec.Query(ctx, query, playerID, season, phase, dimensions, repository.LeagueID(ctx))
+	if err != nil {
+		return nil, repository.MapPgError(err)
+	}
+	defer rows.Close()
+	res := make([]model.PlayerSplit, 0, 16)
+	for rows.Next() {
+		var it model.PlayerSplit
+		if err := scanPlayerAggregate(rows, &it.PlayerAggregatedStats, &it.Dimension, &it.Key); err != nil {
+			return nil, repository.MapPgError(err)
+		}
+		res = append(res, it)
+	}
+	return res, nil
+}
+
+// GetTeamSplits aggregates a team's results per split bucket. Like GetTeamAggregatedStats it reads game_results,
+// so only finished games with an official score count; rest days are measured between those games.
+func (r *teamRepository) GetTeamSplits(ctx context.Context, teamID int64, season, phase *string, dimensions []string) ([]model.TeamSplit, error) {
+	if err := ensurePool(r.pool); err != nil {
+		return nil, err
+	}
+
+	query := `
+		WITH results AS (
+			SELECT date,
+				home_team_id = $1 AS is_home,
+				CASE WHEN home_team_id = $1 THEN away_team_id ELSE home_team_id END AS opponent_id,
+				CASE WHEN winner_id = $1 THEN 'W' ELSE 'L' END AS result,
+				CASE WHEN home_team_id = $1 THEN home_points ELSE away_points END AS points_for,
+				CASE WHEN home_team_id = $1 THEN away_points ELSE home_points END AS points_against,
+				GREATEST(date::DATE - LAG(date::DATE) OVER (ORDER BY date, game_id) - 1, 0) AS rest_days
+			FROM game_results
+			WHERE (home_team_id = $1 OR away_team_id = $1)
+				AND ($2::TEXT IS NULL OR season = $2)
+				AND ($3::TEXT IS NULL OR phase = $3)
+				AND league_id = $5
+		)
+		SELECT
+			COUNT(*) FILTER (WHERE result = 'W') AS wins,
+			COUNT(*) FILTER (WHERE result = 'L') AS losses,
+			SUM(points_for) AS total_points_scored,
+			SUM(points_against) AS total_points_allowed,
+			ROUND(AVG(points_for), 2) AS avg_points_scored,
+			ROUND(AVG(points_against), 2) AS avg_points_allowed,
+			d.dimension, d.key
+		FROM results` + splitBuckets
+
+	exec := getQ(ctx, r.pool)
+	rows, err := exec.Query(ctx, query, teamID, season, phase, dimensions, repository.LeagueID(ctx))
+	if err != nil {
+		return nil, repository.MapPgError(err)
+	}
+	defer rows.Close()
+	res := make([]model.TeamSplit, 0, 16)
+	for rows.Next() {
+		var it model.TeamSplit
+		if err := rows.Scan(
+			&it.Wins, &it.Losses, &it.TotalPointsScored, &it.TotalPointsAllowed, &it.AvgPointsScored, &it.AvgPointsAllowed,
+			&it.Dimension, &it.Key,
+		); err != nil {
+			return nil, repository.MapPgError(err)
+		}
+		res = append(res, it)
+	}
+	return res, nil
+}
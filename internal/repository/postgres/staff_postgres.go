@@ -0,0 +1,278 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/maxviazov/basketball-stats-service/internal/model"
+	"github.com/maxviazov/basketball-stats-service/internal/repository"
+)
+
+type staffRepository struct{ pool *pgxpool.Pool }
+
+func NewStaffRepository(pool *pgxpool.Pool) repository.StaffRepository {
+	return &staffRepository{pool: pool}
+}
+
+const staffColumns = `id, first_name, last_name, created_at, updated_at`
+
+func scanStaff(row pgx.Row, s *model.Staff, extra ...any) error {
+	dest := []any{&s.ID, &s.FirstName, &s.LastName, &s.CreatedAt, &s.UpdatedAt}
+	return row.Scan(append(dest, extra...)...)
+}
+
+// tenureColumns reads a tenure joined with its staff member as s.
+const tenureColumns = `t.id, t.staff_id, t.team_id, t.role, t.start_date, t.end_date, s.first_name, s.last_name, t.created_at`
+
+func scanTenure(row pgx.Row, t *model.StaffTenure) error {
+	return row.Scan(&t.ID, &t.StaffID, &t.TeamID, &t.Role, &t.StartDate, &t.EndDate, &t.FirstName, &t.LastName, &t.CreatedAt)
+}
+
+func (r *staffRepository) Create(ctx context.Context, s model.Staff) (model.Staff, error) {
+	if err := ensurePool(r.pool); err != nil {
+		return model.Staff{}, err
+	}
+	exec := getQ(ctx, r.pool)
+	row := exec.QueryRow(ctx,
+		`INSERT INTO staff (league_id, first_name, last_name) VALUES ($1, $2, $3)
+		 RETURNING `+staffColumns,
+		repository.LeagueID(ctx), s.FirstName, s.LastName,
+	)
+	var out model.Staff
+	if err := scanStaff(row, &out); err != nil {
+		return model.Staff{}, repository.MapPgError(err)
+	}
+	return out, nil
+}
+
+func (r *staffRepository) GetByID(ctx context.Context, id int64) (model.Staff, error) {
+	if err := ensurePool(r.pool); err != nil {
+		return model.Staff{}, err
+	}
+	exec := getQ(ctx, r.pool)
+	row := exec.QueryRow(ctx,
+		`SELECT `+staffColumns+` FROM staff WHERE id = $1 AND league_id = $2`, id, repository.LeagueID(ctx),
+	)
+	var out model.Staff
+	if err := scanStaff(row, &out); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Staff{}, repository.ErrNotFound
+		}
+		return model.Staff{}, repository.MapPgError(err)
+	}
+	return out, nil
+}
+
+func (r *staffRepository) List(ctx context.Context, p repository.Page) (repository.PageResult[model.Staff], error) {
+	if err := ensurePool(r.pool); err != nil {
+		return repository.PageResult[model.Staff]{}, err
+	}
+	limit, offset := sanitizeLimitOffset(p.Limit, p.Offset)
+	exec := getQ(ctx, r.pool)
+	rows, err := exec.Query(ctx,
+		`SELECT `+staffColumns+`, COUNT(*) OVER() AS total
+		 FROM staff
+		 WHERE league_id = $1
+		 ORDER BY last_name, first_name, id
+		 LIMIT $2 OFFSET $3`,
+		repository.LeagueID(ctx), limit, offset,
+	)
+	if err != nil {
+		return repository.PageResult[model.Staff]{}, repository.MapPgError(err)
+	}
+	defer rows.Close()
+	res := repository.PageResult[model.Staff]{Items: make([]model.Staff, 0, limit)}
+	for rows.Next() {
+		var it model.Staff
+		var total int
+		if err := scanStaff(rows, &it, &total); err != nil {
+			return repository.PageResult[model.Staff]{}, repository.MapPgError(err)
+		}
+		res.Items = append(res.Items, it)
+		res.Total = total
+	}
+	return res, nil
+}
+
+// Lock takes the team's row lock first, in the same order for every writer. NO KEY UPDATE is enough to
+// serialize tenure writes without blocking inserts that reference the team.
+func (r *staffRepository) Lock(ctx context.Context, staffID, teamID int64) error {
+	if err := ensurePool(r.pool); err != nil {
+		return err
+	}
+	exec := getQ(ctx, r.pool)
+	if _, err := exec.Exec(ctx,
+		`SELECT id FROM teams WHERE id = $1 AND league_id = $2 FOR NO KEY UPDATE`, teamID, repository.LeagueID(ctx),
+	); err != nil {
+		return repository.MapPgError(err)
+	}
+	var id int64
+	if err := exec.QueryRow(ctx,
+		`SELECT id FROM staff WHERE id = $1 AND league_id = $2 FOR NO KEY UPDATE`, staffID, repository.LeagueID(ctx),
+	).Scan(&id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return repository.ErrNotFound
+		}
+		return repository.MapPgError(err)
+	}
+	return nil
+}
+
+// CreateTenure only inserts when both the staff member and the team belong to the league in context.
+func (r *staffRepository) CreateTenure(ctx context.Context, t model.StaffTenure) (model.StaffTenure, error) {
+	if err := ensurePool(r.pool); err != nil {
+		return model.StaffTenure{}, err
+	}
+	exec := getQ(ctx, r.pool)
+	row := exec.QueryRow(ctx,
+		`WITH created AS (
+			INSERT INTO staff_tenures (staff_id, team_id, role, start_date, end_date)
+			SELECT s.id, tm.id, $3, $4, $5
+			FROM staff s
+			INNER JOIN teams tm ON tm.id = $2 AND tm.league_id = s.league_id AND tm.deleted_at IS NULL
+			WHERE s.id = $1 AND s.league_id = $6
+			RETURNING *
+		 )
+		 SELECT `+tenureColumns+`
+		 FROM created t INNER JOIN staff s ON s.id = t.staff_id`,
+		t.StaffID, t.TeamID, t.Role, t.StartDate, t.EndDate, repository.LeagueID(ctx),
+	)
+	var out model.StaffTenure
+	if err := scanTenure(row, &out); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			// Same outcome as a dangling reference: the staff member or the team is not in this league.
+			return model.StaffTenure{}, repository.ErrConflict
+		}
+		return model.StaffTenure{}, repository.MapPgError(err)
+	}
+	return out, nil
+}
+
+func (r *staffRepository) EndTenure(ctx context.Context, staffID, tenureID int64, end time.Time) (model.StaffTenure, error) {
+	if err := ensurePool(r.pool); err != nil {
+		return model.StaffTenure{}, err
+	}
+	exec := getQ(ctx, r.pool)
+	row := exec.QueryRow(ctx,
+		`WITH ended AS (
+			UPDATE staff_tenures SET end_date = $3
+			WHERE id = $2 AND staff_id = $1 AND end_date IS NULL
+			  AND staff_id IN (SELECT id FROM staff WHERE league_id = $4)
+			RETURNING *
+		 )
+		 SELECT `+tenureColumns+`
+		 FROM ended t INNER JOIN staff s ON s.id = t.staff_id`,
+		staffID, tenureID, end, repository.LeagueID(ctx),
+	)
+	var out model.StaffTenure
+	if err := scanTenure(row, &out); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.StaffTenure{}, repository.ErrNotFound
+		}
+		return model.StaffTenure{}, repository.MapPgError(err)
+	}
+	return out, nil
+}
+
+func (r *staffRepository) ListTenures(ctx context.Context, staffID int64) ([]model.StaffTenure, error) {
+	return r.listTenures(ctx,
+		`SELECT `+tenureColumns+`
+		 FROM staff_tenures t
+		 INNER JOIN staff s ON s.id = t.staff_id AND s.league_id = $2
+		 WHERE t.staff_id = $1
+		 ORDER BY t.start_date, t.id`,
+		staffID, repository.LeagueID(ctx),
+	)
+}
+
+func (r *staffRepository) ListTeamTenures(ctx context.Context, teamID int64) ([]model.StaffTenure, error) {
+	return r.listTenures(ctx,
+		`SELECT `+tenureColumns+`
+		 FROM staff_tenures t
+		 INNER JOIN staff s ON s.id = t.staff_id AND s.league_id = $2
+		 WHERE t.team_id = $1
+		 ORDER BY t.start_date, t.id`,
+		teamID, repository.LeagueID(ctx),
+	)
+}
+
+// ListByTeam uses the same half-open window as the historical roster, so a coach hired on a game day
+// is on the staff for that game.
+func (r *staffRepository) ListByTeam(ctx context.Context, teamID int64, asOf *time.Time) ([]model.StaffTenure, error) {
+	return r.listTenures(ctx,
+		`SELECT `+tenureColumns+`
+		 FROM staff_tenures t
+		 INNER JOIN staff s ON s.id = t.staff_id AND s.league_id = $3
+		 WHERE t.team_id = $1
+		   AND CASE WHEN $2::DATE IS NULL THEN t.end_date IS NULL
+		            ELSE t.start_date <= $2::DATE AND (t.end_date IS NULL OR $2::DATE < t.end_date)
+		       END
+		 ORDER BY CASE t.role WHEN 'head_coach' THEN 0 WHEN 'assistant_coach' THEN 1 ELSE 2 END, s.last_name, s.id`,
+		teamID, asOf, repository.LeagueID(ctx),
+	)
+}
+
+func (r *staffRepository) listTenures(ctx context.Context, query string, args ...any) ([]model.StaffTenure, error) {
+	if err := ensurePool(r.pool); err != nil {
+		return nil, err
+	}
+	exec := getQ(ctx, r.pool)
+	rows, err := exec.Query(ctx, query, args...)
+	if err != nil {
+		return nil, repository.MapPgError(err)
+	}
+	defer rows.Close()
+	res := make([]model.StaffTenure, 0, 4)
+	for rows.Next() {
+		var it model.StaffTenure
+		if err := scanTenure(rows, &it); err != nil {
+			return nil, repository.MapPgError(err)
+		}
+		res = append(res, it)
+	}
+	return res, rows.Err()
+}
+
+// ListCoachSeasons reads results from the game_results view, the one place that decides winners and losers,
+// so a coach's record always agrees with standings and team aggregates. A game belongs to the tenure that
+// covers its calendar day in UTC; head coach tenures of a team never overlap, so no game counts twice.
+func (r *staffRepository) ListCoachSeasons(ctx context.Context, staffID int64, phase *string) ([]model.CoachSeasonRecord, error) {
+	if err := ensurePool(r.pool); err != nil {
+		return nil, err
+	}
+	exec := getQ(ctx, r.pool)
+	rows, err := exec.Query(ctx,
+		`SELECT gr.season,
+			COUNT(*) FILTER (WHERE gr.winner_id = t.team_id) AS wins,
+			COUNT(*) FILTER (WHERE gr.loser_id = t.team_id) AS losses
+		 FROM staff_tenures t
+		 INNER JOIN staff s ON s.id = t.staff_id AND s.league_id = $3
+		 INNER JOIN game_results gr ON gr.league_id = s.league_id
+			AND t.team_id IN (gr.home_team_id, gr.away_team_id)
+			AND t.start_date <= (gr.date AT TIME ZONE 'UTC')::DATE
+			AND (t.end_date IS NULL OR (gr.date AT TIME ZONE 'UTC')::DATE < t.end_date)
+		 WHERE t.staff_id = $1 AND t.role = 'head_coach'
+		   AND ($2::TEXT IS NULL OR gr.phase = $2)
+		 GROUP BY gr.season
+		 ORDER BY gr.season`,
+		staffID, phase, repository.LeagueID(ctx),
+	)
+	if err != nil {
+		return nil, repository.MapPgError(err)
+	}
+	defer rows.Close()
+	var res []model.CoachSeasonRecord
+	for rows.Next() {
+		var it model.CoachSeasonRecord
+		if err := rows.Scan(&it.Season, &it.Wins, &it.Losses); err != nil {
+			return nil, repository.MapPgError(err)
+		}
+		res = append(res, it)
+	}
+	return res, rows.Err()
+}
+
+var _ repository.StaffRepository = (*staffRepository)(nil)
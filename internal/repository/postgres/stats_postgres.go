@@ -2,7 +2,10 @@ package postgres
 
 import (
 	"context"
+	"errors"
+	"time"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/maxviazov/basketball-stats-service/internal/model"
 	"github.com/maxviazov/basketball-stats-service/internal/repository"
@@ -14,6 +17,25 @@ func NewStatsRepository(pool *pgxpool.Pool) repository.StatsRepository {
 	return &statsRepository{pool: pool}
 }
 
+// statLineColumns is the canonical projection for model.PlayerStatLine; keep it in sync with scanStatLine.
+const statLineColumns = `id, player_id, game_id, points, rebounds, offensive_rebounds, defensive_rebounds,
+	assists, steals, blocks, fouls, turnovers,
+	field_goals_made, field_goals_attempted, three_pointers_made, three_pointers_attempted,
+	free_throws_made, free_throws_attempted, minutes_played, created_at, updated_at`
+
+// scanStatLine reads a row produced with statLineColumns; extra destinations are appended after the line fields.
+func scanStatLine(row pgx.Row, s *model.PlayerStatLine, extra ...any) error {
+	dest := []any{
+		&s.ID, &s.PlayerID, &s.GameID, &s.Points, &s.Rebounds, &s.OffensiveRebounds, &s.DefensiveRebounds,
+		&s.Assists, &s.Steals, &s.Blocks, &s.Fouls, &s.Turnovers,
+		&s.FieldGoalsMade, &s.FieldGoalsAttempted, &s.ThreePointersMade, &s.ThreePointersAttempted,
+		&s.FreeThrowsMade, &s.FreeThrowsAttempted, &s.MinutesPlayed, &s.CreatedAt, &s.UpdatedAt,
+	}
+	return row.Scan(append(dest, extra...)...)
+}
+
+// UpsertStatLine only writes a line whose player and game are both in the league; anything else is reported
+// as ErrConflict, the same outcome as a dangling reference.
 func (r *statsRepository) UpsertStatLine(ctx context.Context, s model.PlayerStatLine) (model.PlayerStatLine, error) {
 	if err := ensurePool(r.pool); err != nil {
 		return model.PlayerStatLine{}, err
@@ -21,24 +43,46 @@ func (r *statsRepository) UpsertStatLine(ctx context.Context, s model.PlayerStat
 	exec := getQ(ctx, r.pool)
 	row := exec.QueryRow(ctx,
 		`INSERT INTO player_stats (
-			player_id, game_id, points, rebounds, assists, steals, blocks, fouls, turnovers, minutes_played
-		) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10)
+			player_id, game_id, points, rebounds, offensive_rebounds, defensive_rebounds,
+			assists, steals, blocks, fouls, turnovers,
+			field_goals_made, field_goals_attempted, three_pointers_made, three_pointers_attempted,
+			free_throws_made, free_throws_attempted, minutes_played
+		)
+		SELECT $1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16,$17,$18
+		WHERE EXISTS (
+			SELECT 1 FROM games g JOIN players p ON p.league_id = g.league_id
+			WHERE g.id = $2 AND p.id = $1 AND g.league_id = $19
+		)
 		ON CONFLICT (player_id, game_id)
 		DO UPDATE SET
 			points = EXCLUDED.points,
 			rebounds = EXCLUDED.rebounds,
+			offensive_rebounds = EXCLUDED.offensive_rebounds,
+			defensive_rebounds = EXCLUDED.defensive_rebounds,
 			assists = EXCLUDED.assists,
 			steals = EXCLUDED.steals,
 			blocks = EXCLUDED.blocks,
 			fouls = EXCLUDED.fouls,
 			turnovers = EXCLUDED.turnovers,
+			field_goals_made = EXCLUDED.field_goals_made,
+			field_goals_attempted = EXCLUDED.field_goals_attempted,
+			three_pointers_made = EXCLUDED.three_pointers_made,
+			three_pointers_attempted = EXCLUDED.three_pointers_attempted,
+			free_throws_made = EXCLUDED.free_throws_made,
+			free_throws_attempted = EXCLUDED.free_throws_attempted,
 			minutes_played = EXCLUDED.minutes_played,
 			updated_at = NOW()
-		RETURNING id, player_id, game_id, points, rebounds, assists, steals, blocks, fouls, turnovers, minutes_played, created_at, updated_at`,
-		s.PlayerID, s.GameID, s.Points, s.Rebounds, s.Assists, s.Steals, s.Blocks, s.Fouls, s.Turnovers, s.MinutesPlayed,
+		RETURNING `+statLineColumns,
+		s.PlayerID, s.GameID, s.Points, s.Rebounds, s.OffensiveRebounds, s.DefensiveRebounds,
+		s.Assists, s.Steals, s.Blocks, s.Fouls, s.Turnovers,
+		s.FieldGoalsMade, s.FieldGoalsAttempted, s.ThreePointersMade, s.ThreePointersAttempted,
+		s.FreeThrowsMade, s.FreeThrowsAttempted, s.MinutesPlayed, repository.LeagueID(ctx),
 	)
 	var out model.PlayerStatLine
-	if err := row.Scan(&out.ID, &out.PlayerID, &out.GameID, &out.Points, &out.Rebounds, &out.Assists, &out.Steals, &out.Blocks, &out.Fouls, &out.Turnovers, &out.MinutesPlayed, &out.CreatedAt, &out.UpdatedAt); err != nil {
+	if err := scanStatLine(row, &out); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.PlayerStatLine{}, repository.ErrConflict
+		}
 		return model.PlayerStatLine{}, repository.MapPgError(err)
 	}
 	return out, nil
@@ -50,8 +94,10 @@ func (r *statsRepository) ListByGame(ctx context.Context, gameID int64) ([]model
 	}
 	exec := getQ(ctx, r.pool)
 	rows, err := exec.Query(ctx,
-		`SELECT id, player_id, game_id, points, rebounds, assists, steals, blocks, fouls, turnovers, minutes_played, created_at, updated_at
-		 FROM player_stats WHERE game_id = $1 ORDER BY id`, gameID,
+		`SELECT `+statLineColumns+`
+		 FROM player_stats
+		 WHERE game_id = $1 AND player_id IN (SELECT id FROM players WHERE league_id = $2 AND deleted_at IS NULL)
+		 ORDER BY id`, gameID, repository.LeagueID(ctx),
 	)
 	if err != nil {
 		return nil, repository.MapPgError(err)
@@ -60,7 +106,7 @@ func (r *statsRepository) ListByGame(ctx context.Context, gameID int64) ([]model
 	res := make([]model.PlayerStatLine, 0, 8)
 	for rows.Next() {
 		var it model.PlayerStatLine
-		if err := rows.Scan(&it.ID, &it.PlayerID, &it.GameID, &it.Points, &it.Rebounds, &it.Assists, &it.Steals, &it.Blocks, &it.Fouls, &it.Turnovers, &it.MinutesPlayed, &it.CreatedAt, &it.UpdatedAt); err != nil {
+		if err := scanStatLine(rows, &it); err != nil {
 			return nil, repository.MapPgError(err)
 		}
 		res = append(res, it)
@@ -68,4 +114,94 @@ func (r *statsRepository) ListByGame(ctx context.Context, gameID int64) ([]model
 	return res, nil
 }
 
+// ListByPlayer builds a player's game log. The player's side of each game comes from the membership valid on the
+// game date, falling back to the current team for lines without one. Filters apply before the games are numbered,
+// so Last counts the most recent games that match them, e.g. the last five against one opponent.
+// The player's age is taken on each game's UTC calendar date.
+func (r *statsRepository) ListByPlayer(ctx context.Context, playerID int64, f model.GameLogFilter, p repository.Page) (repository.PageResult[model.GameLogEntry], error) {
+	if err := ensurePool(r.pool); err != nil {
+		return repository.PageResult[model.GameLogEntry]{}, err
+	}
+	limit, offset := sanitizeLimitOffset(p.Limit, p.Offset)
+	var toExclusive *time.Time
+	if f.To != nil {
+		t := f.To.AddDate(0, 0, 1)
+		toExclusive = &t
+	}
+	var last *int
+	if f.Last > 0 {
+		last = &f.Last
+	}
+
+	query := `
+		WITH log AS (
+			SELECT
+				l.*,
+				g.date AS game_date,
+				g.season,
+				p.birth_date,
+				t.team_id,
+				t.team_id = g.home_team_id AS is_home,
+				CASE WHEN t.team_id = g.home_team_id THEN g.away_team_id ELSE g.home_team_id END AS opponent_id,
+				CASE WHEN gr.game_id IS NULL THEN '' WHEN gr.winner_id = t.team_id THEN 'W' ELSE 'L' END AS result,
+				CASE WHEN t.team_id = g.home_team_id THEN g.home_score ELSE g.away_score END AS team_score,
+				CASE WHEN t.team_id = g.home_team_id THEN g.away_score ELSE g.home_score END AS opponent_score,
+				ROW_NUMBER() OVER (ORDER BY g.date DESC, g.id DESC) AS recent
+			FROM (SELECT ` + statLineColumns + ` FROM player_stats WHERE player_id = $1) l
+			INNER JOIN games g ON g.id = l.game_id AND g.league_id = $9 AND g.deleted_at IS NULL
+			INNER JOIN players p ON p.id = l.player_id
+			LEFT JOIN player_game_teams pgt ON pgt.player_id = l.player_id AND pgt.game_id = l.game_id
+			CROSS JOIN LATERAL (SELECT COALESCE(pgt.team_id, p.team_id) AS team_id) t
+			LEFT JOIN game_results gr ON gr.game_id = g.id
+			WHERE ($2::TEXT IS NULL OR g.season = $2)
+				AND ($3::TIMESTAMPTZ IS NULL OR g.date >= $3)
+				AND ($4::TIMESTAMPTZ IS NULL OR g.date < $4)
+				AND ($5::BIGINT IS NULL OR ($5 IN (g.home_team_id, g.away_team_id) AND $5 <> t.team_id))
+		)
+		SELECT ` + statLineColumns + `, game_date, season, team_id, opponent_id, is_home, result, team_score, opponent_score,
+			birth_date, COUNT(*) OVER() AS total
+		FROM log
+		WHERE ($6::INT IS NULL OR recent <= $6)
+		ORDER BY recent
+		LIMIT $7 OFFSET $8
+	`
+
+	exec := getQ(ctx, r.pool)
+	rows, err := exec.Query(ctx, query, playerID, f.Season, f.From, toExclusive, f.OpponentID, last, limit, offset, repository.LeagueID(ctx))
+	if err != nil {
+		return repository.PageResult[model.GameLogEntry]{}, repository.MapPgError(err)
+	}
+	defer rows.Close()
+	res := repository.PageResult[model.GameLogEntry]{Items: make([]model.GameLogEntry, 0, limit)}
+	for rows.Next() {
+		var it model.GameLogEntry
+		var birthDate *time.Time
+		var total int
+		if err := scanStatLine(rows, &it.PlayerStatLine,
+			&it.GameDate, &it.Season, &it.TeamID, &it.OpponentID, &it.Home, &it.Result, &it.TeamScore, &it.OpponentScore, &birthDate, &total,
+		); err != nil {
+			return repository.PageResult[model.GameLogEntry]{}, repository.MapPgError(err)
+		}
+		it.Age = model.AgeOn(birthDate, it.GameDate.UTC())
+		res.Items = append(res.Items, it)
+		res.Total = total
+	}
+	return res, nil
+}
+
+func (r *statsRepository) DeleteStatLine(ctx context.Context, playerID, gameID int64) error {
+	if err := ensurePool(r.pool); err != nil {
+		return err
+	}
+	exec := getQ(ctx, r.pool)
+	if _, err := exec.Exec(ctx,
+		`DELETE FROM player_stats
+		 WHERE player_id = $1 AND game_id = $2 AND game_id IN (SELECT id FROM games WHERE league_id = $3)`,
+		playerID, gameID, repository.LeagueID(ctx),
+	); err != nil {
+		return repository.MapPgError(err)
+	}
+	return nil
+}
+
 var _ repository.StatsRepository = (*statsRepository)(nil)
@@ -16,18 +16,26 @@ func NewTeamRepository(pool *pgxpool.Pool) repository.TeamRepository {
 	return &teamRepository{pool: pool}
 }
 
+// teamColumns is the canonical projection for model.Team; keep it in sync with scanTeam.
+const teamColumns = `id, name, home_venue_id, created_at, updated_at`
+
+func scanTeam(row pgx.Row, t *model.Team, extra ...any) error {
+	dest := []any{&t.ID, &t.Name, &t.HomeVenueID, &t.CreatedAt, &t.UpdatedAt}
+	return row.Scan(append(dest, extra...)...)
+}
+
 func (r *teamRepository) Create(ctx context.Context, t model.Team) (model.Team, error) {
 	if err := ensurePool(r.pool); err != nil {
 		return model.Team{}, err
 	}
 	exec := getQ(ctx, r.pool)
 	row := exec.QueryRow(ctx,
-		`INSERT INTO teams (name) VALUES ($1)
-		 RETURNING id, name, created_at, updated_at`,
-		t.Name,
+		`INSERT INTO teams (league_id, name, home_venue_id) VALUES ($1, $2, $3)
+		 RETURNING `+teamColumns,
+		repository.LeagueID(ctx), t.Name, t.HomeVenueID,
 	)
 	var out model.Team
-	if err := row.Scan(&out.ID, &out.Name, &out.CreatedAt, &out.UpdatedAt); err != nil {
+	if err := scanTeam(row, &out); err != nil {
 		return model.Team{}, repository.MapPgError(err)
 	}
 	return out, nil
@@ -39,10 +47,11 @@ func (r *teamRepository) GetByID(ctx context.Context, id int64) (model.Team, err
 	}
 	exec := getQ(ctx, r.pool)
 	row := exec.QueryRow(ctx,
-		`SELECT id, name, created_at, updated_at FROM teams WHERE id = $1`, id,
+		`SELECT `+teamColumns+` FROM teams WHERE id = $1 AND league_id = $2 AND deleted_at IS NULL`,
+		id, repository.LeagueID(ctx),
 	)
 	var out model.Team
-	if err := row.Scan(&out.ID, &out.Name, &out.CreatedAt, &out.UpdatedAt); err != nil {
+	if err := scanTeam(row, &out); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return model.Team{}, repository.ErrNotFound
 		}
@@ -58,11 +67,12 @@ func (r *teamRepository) List(ctx context.Context, p repository.Page) (repositor
 	limit, offset := sanitizeLimitOffset(p.Limit, p.Offset)
 	exec := getQ(ctx, r.pool)
 	rows, err := exec.Query(ctx,
-		`SELECT id, name, created_at, updated_at, COUNT(*) OVER() AS total
+		`SELECT `+teamColumns+`, COUNT(*) OVER() AS total
 		 FROM teams
+		 WHERE league_id = $1 AND deleted_at IS NULL
 		 ORDER BY id
-		 LIMIT $1 OFFSET $2`,
-		limit, offset,
+		 LIMIT $2 OFFSET $3`,
+		repository.LeagueID(ctx), limit, offset,
 	)
 	if err != nil {
 		return repository.PageResult[model.Team]{}, repository.MapPgError(err)
@@ -72,7 +82,7 @@ func (r *teamRepository) List(ctx context.Context, p repository.Page) (repositor
 	for rows.Next() {
 		var t model.Team
 		var total int
-		if err := rows.Scan(&t.ID, &t.Name, &t.CreatedAt, &t.UpdatedAt, &total); err != nil {
+		if err := scanTeam(rows, &t, &total); err != nil {
 			return repository.PageResult[model.Team]{}, repository.MapPgError(err)
 		}
 		res.Items = append(res.Items, t)
@@ -81,6 +91,69 @@ func (r *teamRepository) List(ctx context.Context, p repository.Page) (repositor
 	return res, nil
 }
 
+// Update stores the name and home venue of a team. Deleted teams are left alone and reported as ErrNotFound.
+func (r *teamRepository) Update(ctx context.Context, t model.Team) (model.Team, error) {
+	if err := ensurePool(r.pool); err != nil {
+		return model.Team{}, err
+	}
+	exec := getQ(ctx, r.pool)
+	row := exec.QueryRow(ctx,
+		`UPDATE teams SET name = $2, home_venue_id = $4, updated_at = NOW()
+		 WHERE id = $1 AND league_id = $3 AND deleted_at IS NULL
+		 RETURNING `+teamColumns,
+		t.ID, t.Name, repository.LeagueID(ctx), t.HomeVenueID,
+	)
+	var out model.Team
+	if err := scanTeam(row, &out); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Team{}, repository.ErrNotFound
+		}
+		return model.Team{}, repository.MapPgError(err)
+	}
+	return out, nil
+}
+
+// Delete marks a team as deleted. Its players, games and stats are kept so a restore brings them back.
+func (r *teamRepository) Delete(ctx context.Context, id int64) error {
+	if err := ensurePool(r.pool); err != nil {
+		return err
+	}
+	exec := getQ(ctx, r.pool)
+	tag, err := exec.Exec(ctx,
+		`UPDATE teams SET deleted_at = NOW(), updated_at = NOW() WHERE id = $1 AND league_id = $2 AND deleted_at IS NULL`,
+		id, repository.LeagueID(ctx),
+	)
+	if err != nil {
+		return repository.MapPgError(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return repository.ErrNotFound
+	}
+	return nil
+}
+
+// Restore clears the deletion mark. The partial unique index on active names reports a name taken meanwhile
+// as ErrAlreadyExists.
+func (r *teamRepository) Restore(ctx context.Context, id int64) (model.Team, error) {
+	if err := ensurePool(r.pool); err != nil {
+		return model.Team{}, err
+	}
+	exec := getQ(ctx, r.pool)
+	row := exec.QueryRow(ctx,
+		`UPDATE teams SET deleted_at = NULL, updated_at = NOW()
+		 WHERE id = $1 AND league_id = $2 AND deleted_at IS NOT NULL
+		 RETURNING `+teamColumns, id, repository.LeagueID(ctx),
+	)
+	var out model.Team
+	if err := scanTeam(row, &out); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Team{}, repository.ErrNotFound
+		}
+		return model.Team{}, repository.MapPgError(err)
+	}
+	return out, nil
+}
+
 // Exists performs a lightweight check to see if a team with the given ID exists.
 func (r *teamRepository) Exists(ctx context.Context, id int64) (bool, error) {
 	if err := ensurePool(r.pool); err != nil {
@@ -88,7 +161,10 @@ func (r *teamRepository) Exists(ctx context.Context, id int64) (bool, error) {
 	}
 	var exists bool
 	exec := getQ(ctx, r.pool)
-	err := exec.QueryRow(ctx, `SELECT EXISTS(SELECT 1 FROM teams WHERE id = $1)`, id).Scan(&exists)
+	err := exec.QueryRow(ctx,
+		`SELECT EXISTS(SELECT 1 FROM teams WHERE id = $1 AND league_id = $2 AND deleted_at IS NULL)`,
+		id, repository.LeagueID(ctx),
+	).Scan(&exists)
 	if err != nil {
 		return false, repository.MapPgError(err)
 	}
@@ -96,51 +172,15 @@ func (r *teamRepository) Exists(ctx context.Context, id int64) (bool, error) {
 }
 
 // GetTeamAggregatedStats calculates and returns a team's aggregated statistics.
-// It can filter by season; a nil season returns career stats.
-// This query is complex:
-// 1. It first calculates the total points for each team in each finished game (game_team_scores CTE).
-// 2. It then determines the winner and loser for each game by comparing home and away scores (game_results CTE).
-// 3. Finally, it aggregates wins, losses, and points for the specified team, filtering by season if provided.
-func (r *teamRepository) GetTeamAggregatedStats(ctx context.Context, teamID int64, season *string) (model.TeamAggregatedStats, error) {
+// It can filter by season and phase; a nil season returns career stats, a nil phase includes every phase.
+// Results come from the game_results view, which only counts finished games with an official score,
+// so a game with missing player stat lines still has the right winner.
+func (r *teamRepository) GetTeamAggregatedStats(ctx context.Context, teamID int64, season, phase *string) (model.TeamAggregatedStats, error) {
 	if err := ensurePool(r.pool); err != nil {
 		return model.TeamAggregatedStats{}, err
 	}
 
 	query := `
-		WITH game_team_scores AS (
-			-- Calculate total points for each team in each game from player stats.
-			SELECT
-				g.id AS game_id,
-				p.team_id,
-				SUM(ps.points) AS points
-			FROM player_stats ps
-			JOIN games g ON ps.game_id = g.id
-			JOIN players p ON ps.player_id = p.id
-			WHERE g.status = 'finished'
-			GROUP BY g.id, p.team_id
-		),
-		game_results AS (
-			-- Determine winner, loser, and points for each game.
-			SELECT
-				g.id AS game_id,
-				g.home_team_id,
-				g.away_team_id,
-				COALESCE(hts.points, 0) AS home_points,
-				COALESCE(ats.points, 0) AS away_points,
-				CASE
-					WHEN COALESCE(hts.points, 0) > COALESCE(ats.points, 0) THEN g.home_team_id
-					ELSE g.away_team_id
-				END AS winner_id,
-				CASE
-					WHEN COALESCE(hts.points, 0) < COALESCE(ats.points, 0) THEN g.home_team_id
-					ELSE g.away_team_id
-				END AS loser_id
-			FROM games g
-			LEFT JOIN game_team_scores hts ON g.id = hts.game_id AND g.home_team_id = hts.team_id
-			LEFT JOIN game_team_scores ats ON g.id = ats.game_id AND g.away_team_id = ats.team_id
-			WHERE g.status = 'finished' AND ($2::TEXT IS NULL OR g.season = $2)
-		)
-		-- Aggregate stats for the specified team.
 		SELECT
 			COALESCE(SUM(CASE WHEN winner_id = $1 THEN 1 ELSE 0 END), 0) AS wins,
 			COALESCE(SUM(CASE WHEN loser_id = $1 THEN 1 ELSE 0 END), 0) AS losses,
@@ -149,11 +189,14 @@ func (r *teamRepository) GetTeamAggregatedStats(ctx context.Context, teamID int6
 			COALESCE(ROUND(AVG(CASE WHEN home_team_id = $1 THEN home_points ELSE away_points END), 2), 0) AS avg_points_scored,
 			COALESCE(ROUND(AVG(CASE WHEN home_team_id = $1 THEN away_points ELSE home_points END), 2), 0) AS avg_points_allowed
 		FROM game_results
-		WHERE home_team_id = $1 OR away_team_id = $1
+		WHERE (home_team_id = $1 OR away_team_id = $1)
+			AND ($2::TEXT IS NULL OR season = $2)
+			AND ($3::TEXT IS NULL OR phase = $3)
+			AND league_id = $4
 	`
 
 	exec := getQ(ctx, r.pool)
-	row := exec.QueryRow(ctx, query, teamID, season)
+	row := exec.QueryRow(ctx, query, teamID, season, phase, repository.LeagueID(ctx))
 
 	var stats model.TeamAggregatedStats
 	err := row.Scan(
@@ -173,4 +216,150 @@ func (r *teamRepository) GetTeamAggregatedStats(ctx context.Context, teamID int6
 	return stats, nil
 }
 
+// ListStandings builds every team's record for a season in one pass over game_results.
+// Each result is unfolded into one row per team, so home and away games are handled by the same aggregates;
+// the most recent games are numbered per team to derive the last-10 record and the current streak.
+// Teams without a result in the season are included with an empty record.
+func (r *teamRepository) ListStandings(ctx context.Context, season string, phase *string) ([]model.TeamStanding, error) {
+	if err := ensurePool(r.pool); err != nil {
+		return nil, err
+	}
+
+	query := `
+		WITH results AS (
+			SELECT game_id, date, home_team_id AS team_id, away_team_id AS opponent_id, TRUE AS is_home,
+				home_points AS points_for, away_points AS points_against, winner_id = home_team_id AS won
+			FROM game_results
+			WHERE league_id = $3 AND season = $1 AND ($2::TEXT IS NULL OR phase = $2)
+			UNION ALL
+			SELECT game_id, date, away_team_id, home_team_id, FALSE,
+				away_points, home_points, winner_id = away_team_id
+			FROM game_results
+			WHERE league_id = $3 AND season = $1 AND ($2::TEXT IS NULL OR phase = $2)
+		),
+		ordered AS (
+			SELECT r.*, ROW_NUMBER() OVER (PARTITION BY team_id ORDER BY date DESC, game_id DESC) AS recent
+			FROM results r
+		),
+		totals AS (
+			SELECT
+				team_id,
+				COUNT(*) FILTER (WHERE won) AS wins,
+				COUNT(*) FILTER (WHERE NOT won) AS losses,
+				COUNT(*) FILTER (WHERE won AND is_home) AS home_wins,
+				COUNT(*) FILTER (WHERE NOT won AND is_home) AS home_losses,
+				COUNT(*) FILTER (WHERE won AND NOT is_home) AS away_wins,
+				COUNT(*) FILTER (WHERE NOT won AND NOT is_home) AS away_losses,
+				COUNT(*) FILTER (WHERE won AND recent <= 10) AS last10_wins,
+				COUNT(*) FILTER (WHERE NOT won AND recent <= 10) AS last10_losses,
+				SUM(points_for) AS points_for,
+				SUM(points_against) AS points_against
+			FROM ordered
+			GROUP BY team_id
+		),
+		-- The streak runs from the latest game back to the first game with a different result.
+		streaks AS (
+			SELECT
+				o.team_id,
+				latest.won,
+				COALESCE(MIN(o.recent) FILTER (WHERE o.won <> latest.won) - 1, MAX(o.recent)) AS length
+			FROM ordered o
+			JOIN ordered latest ON latest.team_id = o.team_id AND latest.recent = 1
+			GROUP BY o.team_id, latest.won
+		),
+		head_to_head AS (
+			SELECT
+				team_id,
+				ARRAY_AGG(opponent_id::BIGINT ORDER BY opponent_id) AS opponents,
+				ARRAY_AGG(wins ORDER BY opponent_id) AS wins,
+				ARRAY_AGG(losses ORDER BY opponent_id) AS losses
+			FROM (
+				SELECT team_id, opponent_id, COUNT(*) FILTER (WHERE won) AS wins, COUNT(*) FILTER (WHERE NOT won) AS losses
+				FROM results
+				GROUP BY team_id, opponent_id
+			) pairs
+			GROUP BY team_id
+		)
+		SELECT
+			t.id, t.name,
+			COALESCE(tot.wins, 0), COALESCE(tot.losses, 0),
+			COALESCE(tot.home_wins, 0), COALESCE(tot.home_losses, 0),
+			COALESCE(tot.away_wins, 0), COALESCE(tot.away_losses, 0),
+			COALESCE(tot.last10_wins, 0), COALESCE(tot.last10_losses, 0),
+			COALESCE(CASE WHEN s.won THEN 'W' ELSE 'L' END || s.length, ''),
+			COALESCE(tot.points_for, 0), COALESCE(tot.points_against, 0),
+			COALESCE(h.opponents, '{}'), COALESCE(h.wins, '{}'), COALESCE(h.losses, '{}')
+		FROM teams t
+		LEFT JOIN totals tot ON tot.team_id = t.id
+		LEFT JOIN streaks s ON s.team_id = t.id
+		LEFT JOIN head_to_head h ON h.team_id = t.id
+		WHERE t.league_id = $3 AND t.deleted_at IS NULL
+		ORDER BY t.id
+	`
+
+	exec := getQ(ctx, r.pool)
+	rows, err := exec.Query(ctx, query, season, phase, repository.LeagueID(ctx))
+	if err != nil {
+		return nil, repository.MapPgError(err)
+	}
+	defer rows.Close()
+	res := make([]model.TeamStanding, 0)
+	for rows.Next() {
+		var it model.TeamStanding
+		var opponents, h2hWins, h2hLosses []int64
+		if err := rows.Scan(
+			&it.TeamID, &it.TeamName,
+			&it.Wins, &it.Losses,
+			&it.Home.Wins, &it.Home.Losses,
+			&it.Away.Wins, &it.Away.Losses,
+			&it.Last10.Wins, &it.Last10.Losses,
+			&it.Streak,
+			&it.PointsFor, &it.PointsAgainst,
+			&opponents, &h2hWins, &h2hLosses,
+		); err != nil {
+			return nil, repository.MapPgError(err)
+		}
+		it.PointDiff = it.PointsFor - it.PointsAgainst
+		it.HeadToHead = make(map[int64]model.WinLoss, len(opponents))
+		for i, opp := range opponents {
+			it.HeadToHead[opp] = model.WinLoss{Wins: int(h2hWins[i]), Losses: int(h2hLosses[i])}
+		}
+		res = append(res, it)
+	}
+	return res, nil
+}
+
+// ListAttendance averages the recorded attendance of each team's home games per season.
+// Deleted games and games without an attendance figure are left out, so a team with none has no row.
+func (r *teamRepository) ListAttendance(ctx context.Context, season *string, teamID *int64) ([]model.TeamAttendance, error) {
+	if err := ensurePool(r.pool); err != nil {
+		return nil, err
+	}
+	exec := getQ(ctx, r.pool)
+	rows, err := exec.Query(ctx,
+		`SELECT t.id, t.name, g.season, COUNT(*), SUM(g.attendance), ROUND(AVG(g.attendance), 1)::FLOAT8
+		 FROM games g
+		 INNER JOIN teams t ON t.id = g.home_team_id AND t.deleted_at IS NULL
+		 WHERE g.league_id = $1 AND g.deleted_at IS NULL AND g.attendance IS NOT NULL
+		   AND ($2::TEXT IS NULL OR g.season = $2)
+		   AND ($3::BIGINT IS NULL OR g.home_team_id = $3)
+		 GROUP BY t.id, t.name, g.season
+		 ORDER BY g.season DESC, t.id`,
+		repository.LeagueID(ctx), season, teamID,
+	)
+	if err != nil {
+		return nil, repository.MapPgError(err)
+	}
+	defer rows.Close()
+	res := make([]model.TeamAttendance, 0)
+	for rows.Next() {
+		var it model.TeamAttendance
+		if err := rows.Scan(&it.TeamID, &it.TeamName, &it.Season, &it.Games, &it.TotalAttendance, &it.AverageAttendance); err != nil {
+			return nil, repository.MapPgError(err)
+		}
+		res = append(res, it)
+	}
+	return res, rows.Err()
+}
+
 var _ repository.TeamRepository = (*teamRepository)(nil)
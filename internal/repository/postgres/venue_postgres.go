@@ -0,0 +1,129 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/maxviazov/basketball-stats-service/internal/model"
+	"github.com/maxviazov/basketball-stats-service/internal/repository"
+)
+
+type venueRepository struct{ pool *pgxpool.Pool }
+
+func NewVenueRepository(pool *pgxpool.Pool) repository.VenueRepository {
+	return &venueRepository{pool: pool}
+}
+
+const venueColumns = `id, name, city, capacity, timezone, latitude, longitude, created_at, updated_at`
+
+func scanVenue(row pgx.Row, v *model.Venue, extra ...any) error {
+	dest := []any{&v.ID, &v.Name, &v.City, &v.Capacity, &v.Timezone, &v.Latitude, &v.Longitude, &v.CreatedAt, &v.UpdatedAt}
+	return row.Scan(append(dest, extra...)...)
+}
+
+func (r *venueRepository) Create(ctx context.Context, v model.Venue) (model.Venue, error) {
+	if err := ensurePool(r.pool); err != nil {
+		return model.Venue{}, err
+	}
+	exec := getQ(ctx, r.pool)
+	row := exec.QueryRow(ctx,
+		`INSERT INTO venues (league_id, name, city, capacity, timezone, latitude, longitude)
+		 VALUES ($1, $2, $3, $4, $5, $6, $7)
+		 RETURNING `+venueColumns,
+		repository.LeagueID(ctx), v.Name, v.City, v.Capacity, v.Timezone, v.Latitude, v.Longitude,
+	)
+	var out model.Venue
+	if err := scanVenue(row, &out); err != nil {
+		return model.Venue{}, repository.MapPgError(err)
+	}
+	return out, nil
+}
+
+func (r *venueRepository) GetByID(ctx context.Context, id int64) (model.Venue, error) {
+	if err := ensurePool(r.pool); err != nil {
+		return model.Venue{}, err
+	}
+	exec := getQ(ctx, r.pool)
+	var out model.Venue
+	row := exec.QueryRow(ctx,
+		`SELECT `+venueColumns+` FROM venues WHERE id = $1 AND league_id = $2`, id, repository.LeagueID(ctx),
+	)
+	if err := scanVenue(row, &out); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Venue{}, repository.ErrNotFound
+		}
+		return model.Venue{}, repository.MapPgError(err)
+	}
+	return out, nil
+}
+
+func (r *venueRepository) List(ctx context.Context, p repository.Page) (repository.PageResult[model.Venue], error) {
+	if err := ensurePool(r.pool); err != nil {
+		return repository.PageResult[model.Venue]{}, err
+	}
+	limit, offset := sanitizeLimitOffset(p.Limit, p.Offset)
+	exec := getQ(ctx, r.pool)
+	rows, err := exec.Query(ctx,
+		`SELECT `+venueColumns+`, COUNT(*) OVER() AS total
+		 FROM venues
+		 WHERE league_id = $1
+		 ORDER BY id
+		 LIMIT $2 OFFSET $3`, repository.LeagueID(ctx), limit, offset,
+	)
+	if err != nil {
+		return repository.PageResult[model.Venue]{}, repository.MapPgError(err)
+	}
+	defer rows.Close()
+	res := repository.PageResult[model.Venue]{Items: make([]model.Venue, 0, limit)}
+	for rows.Next() {
+		var it model.Venue
+		var total int
+		if err := scanVenue(rows, &it, &total); err != nil {
+			return repository.PageResult[model.Venue]{}, repository.MapPgError(err)
+		}
+		res.Items = append(res.Items, it)
+		res.Total = total
+	}
+	return res, nil
+}
+
+func (r *venueRepository) Update(ctx context.Context, v model.Venue) (model.Venue, error) {
+	if err := ensurePool(r.pool); err != nil {
+		return model.Venue{}, err
+	}
+	exec := getQ(ctx, r.pool)
+	row := exec.QueryRow(ctx,
+		`UPDATE venues SET name = $2, city = $3, capacity = $4, timezone = $5, latitude = $6, longitude = $7, updated_at = NOW()
+		 WHERE id = $1 AND league_id = $8
+		 RETURNING `+venueColumns,
+		v.ID, v.Name, v.City, v.Capacity, v.Timezone, v.Latitude, v.Longitude, repository.LeagueID(ctx),
+	)
+	var out model.Venue
+	if err := scanVenue(row, &out); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Venue{}, repository.ErrNotFound
+		}
+		return model.Venue{}, repository.MapPgError(err)
+	}
+	return out, nil
+}
+
+// Delete is a hard delete. The RESTRICT foreign keys from teams and games report a venue in use as ErrConflict.
+func (r *venueRepository) Delete(ctx context.Context, id int64) error {
+	if err := ensurePool(r.pool); err != nil {
+		return err
+	}
+	exec := getQ(ctx, r.pool)
+	tag, err := exec.Exec(ctx, `DELETE FROM venues WHERE id = $1 AND league_id = $2`, id, repository.LeagueID(ctx))
+	if err != nil {
+		return repository.MapPgError(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return repository.ErrNotFound
+	}
+	return nil
+}
+
+var _ repository.VenueRepository = (*venueRepository)(nil)
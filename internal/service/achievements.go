@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/maxviazov/basketball-stats-service/internal/model"
+	"github.com/maxviazov/basketball-stats-service/internal/repository"
+	"github.com/rs/zerolog"
+)
+
+// Achievement kinds. Single-game feats are detected from one line; career kinds are milestones.
+const (
+	achievementDoubleDouble   = "double_double"
+	achievementTripleDouble   = "triple_double"
+	achievementFortyPoints    = "forty_points"
+	achievementTwentyRebounds = "twenty_rebounds"
+)
+
+var achievementKinds = []string{
+	achievementDoubleDouble, achievementTripleDouble, achievementFortyPoints, achievementTwentyRebounds,
+	"career_points", "career_rebounds", "career_assists",
+}
+
+// careerMilestones are the career totals recorded as milestones.
+var careerMilestones = []model.CareerMilestone{
+	{Stat: "points", Threshold: 1000}, {Stat: "points", Threshold: 5000}, {Stat: "points", Threshold: 10000},
+	{Stat: "points", Threshold: 20000}, {Stat: "points", Threshold: 30000},
+	{Stat: "rebounds", Threshold: 1000}, {Stat: "rebounds", Threshold: 5000}, {Stat: "rebounds", Threshold: 10000},
+	{Stat: "assists", Threshold: 1000}, {Stat: "assists", Threshold: 5000}, {Stat: "assists", Threshold: 10000},
+}
+
+// detectGameFeats returns the single-game feats of a line. Double-doubles and triple-doubles count double-digit
+// points, rebounds, assists, steals and blocks, and their value is the number of such categories;
+// a triple-double is not recorded as a double-double too.
+func detectGameFeats(line model.PlayerStatLine) []model.Achievement {
+	var feats []model.Achievement
+	doubles := 0
+	for _, v := range []int{line.Points, line.Rebounds, line.Assists, line.Steals, line.Blocks} {
+		if v >= 10 {
+			doubles++
+		}
+	}
+	switch {
+	case doubles >= 3:
+		feats = append(feats, model.Achievement{Kind: achievementTripleDouble, Value: doubles})
+	case doubles == 2:
+		feats = append(feats, model.Achievement{Kind: achievementDoubleDouble, Value: doubles})
+	}
+	if line.Points >= 40 {
+		feats = append(feats, model.Achievement{Kind: achievementFortyPoints, Value: line.Points})
+	}
+	if line.Rebounds >= 20 {
+		feats = append(feats, model.Achievement{Kind: achievementTwentyRebounds, Value: line.Rebounds})
+	}
+	return feats
+}
+
+// refreshAchievements re-evaluates a player's feats after their line for a game was written, or removed when line is nil.
+// It must run in the transaction that changed the line so feats never disagree with the box score.
+func refreshAchievements(ctx context.Context, achievements repository.AchievementRepository, playerID, gameID int64, line *model.PlayerStatLine) error {
+	var feats []model.Achievement
+	if line != nil {
+		feats = detectGameFeats(*line)
+	}
+	if err := achievements.ReplaceGameFeats(ctx, playerID, gameID, feats); err != nil {
+		return err
+	}
+	return achievements.SyncMilestones(ctx, playerID, careerMilestones)
+}
+
+type achievementService struct {
+	achievements repository.AchievementRepository
+	players      repository.PlayerRepository
+	log          zerolog.Logger
+}
+
+func NewAchievementService(achievements repository.AchievementRepository, players repository.PlayerRepository, logger zerolog.Logger) AchievementService {
+	l := logger.With().Str("module", "service").Str("component", "achievements").Logger()
+	return &achievementService{achievements: achievements, players: players, log: l}
+}
+
+func (s *achievementService) ListPlayerAchievements(ctx context.Context, playerID int64, page repository.Page) (repository.PageResult[model.Achievement], error) {
+	if playerID <= 0 {
+		return repository.PageResult[model.Achievement]{}, NewInvalidInputError([]FieldError{{Field: "id", Message: "must be > 0"}})
+	}
+	exists, err := s.players.Exists(ctx, playerID)
+	if err != nil {
+		s.log.Error().Err(err).Int64("player_id", playerID).Msg("failed to check player existence")
+		return repository.PageResult[model.Achievement]{}, err
+	}
+	if !exists {
+		return repository.PageResult[model.Achievement]{}, repository.ErrNotFound
+	}
+	p := normalizePage(page)
+	res, err := s.achievements.ListByPlayer(ctx, playerID, p)
+	if err != nil {
+		s.log.Error().Err(err).Int64("player_id", playerID).Msg("list player achievements failed")
+		return repository.PageResult[model.Achievement]{}, err
+	}
+	return res, nil
+}
+
+func (s *achievementService) ListAchievements(ctx context.Context, f model.AchievementFilter, page repository.Page) (repository.PageResult[model.Achievement], error) {
+	var ferrs []FieldError
+	if f.Season != nil && !IsValidSeason(*f.Season) {
+		ferrs = append(ferrs, FieldError{Field: "season", Message: "must be in YYYY-YY format"})
+	}
+	if f.Kind != nil && !slices.Contains(achievementKinds, *f.Kind) {
+		ferrs = append(ferrs, FieldError{Field: "kind", Message: "must be one of " + strings.Join(achievementKinds, "|")})
+	}
+	if err := NewInvalidInputError(ferrs); err != nil {
+		return repository.PageResult[model.Achievement]{}, err
+	}
+	p := normalizePage(page)
+	res, err := s.achievements.List(ctx, f, p)
+	if err != nil {
+		s.log.Error().Err(err).Msg("list achievements failed")
+		return repository.PageResult[model.Achievement]{}, err
+	}
+	return res, nil
+}
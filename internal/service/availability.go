@@ -0,0 +1,251 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/maxviazov/basketball-stats-service/internal/model"
+	"github.com/maxviazov/basketball-stats-service/internal/repository"
+	"github.com/rs/zerolog"
+)
+
+// Availability statuses. Active and dnp_coach players were available; the other statuses explain an absence.
+const (
+	availabilityActive    = "active"
+	availabilityDNPCoach  = "dnp_coach"
+	availabilityInactive  = "inactive"
+	availabilityInjured   = "injured"
+	availabilitySuspended = "suspended"
+)
+
+const (
+	maxReasonLength      = 200
+	maxInjuryDescription = 200
+)
+
+type availabilityService struct {
+	availability repository.AvailabilityRepository
+	players      repository.PlayerRepository
+	games        repository.GameRepository
+	stats        repository.StatsRepository
+	tx           repository.TxManager
+	log          zerolog.Logger
+}
+
+func NewAvailabilityService(availability repository.AvailabilityRepository, players repository.PlayerRepository, games repository.GameRepository, stats repository.StatsRepository, tx repository.TxManager, logger zerolog.Logger) AvailabilityService {
+	l := logger.With().Str("module", "service").Str("component", "availability").Logger()
+	return &availabilityService{availability: availability, players: players, games: games, stats: stats, tx: tx, log: l}
+}
+
+// SetAvailability records whether a player on either roster could play in a game. Any status other than active
+// says the player did not get on the floor, so it is rejected while they have a line showing they did.
+func (s *availabilityService) SetAvailability(ctx context.Context, gameID, playerID int64, status string, reason *string) (model.PlayerAvailability, error) {
+	status = normalizeKeyword(status) // "DNP-Coach" is dnp_coach
+	reason = trimOptional(reason)
+	var ferrs []FieldError
+	if gameID <= 0 {
+		ferrs = append(ferrs, FieldError{Field: "game_id", Message: "must be > 0"})
+	}
+	if playerID <= 0 {
+		ferrs = append(ferrs, FieldError{Field: "player_id", Message: "must be > 0"})
+	}
+	if !isValidAvailabilityStatus(status) {
+		ferrs = append(ferrs, FieldError{Field: "status", Message: "must be one of active|dnp_coach|inactive|injured|suspended"})
+	}
+	if reason != nil && len([]rune(*reason)) > maxReasonLength {
+		ferrs = append(ferrs, FieldError{Field: "reason", Message: "length must be at most 200"})
+	}
+	if err := NewInvalidInputError(ferrs); err != nil {
+		return model.PlayerAvailability{}, err
+	}
+
+	var out model.PlayerAvailability
+	err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		g, err := s.games.GetByID(ctx, gameID)
+		if err != nil {
+			return err
+		}
+		if _, err := s.players.GetByID(ctx, playerID); err != nil {
+			if errors.Is(err, repository.ErrNotFound) {
+				return NewInvalidInputError([]FieldError{{Field: "player_id", Message: "player does not exist"}})
+			}
+			return err
+		}
+		if _, err := checkRoster(ctx, s.players, playerID, g); err != nil {
+			return err
+		}
+		if status != availabilityActive {
+			lines, err := s.stats.ListByGame(ctx, gameID)
+			if err != nil {
+				return err
+			}
+			for _, l := range lines {
+				if l.PlayerID == playerID && l.Played() {
+					return NewInvalidInputError([]FieldError{{Field: "status", Message: "player has a stat line showing they played in this game"}})
+				}
+			}
+		}
+		out, err = s.availability.SetAvailability(ctx, model.PlayerAvailability{GameID: gameID, PlayerID: playerID, Status: status, Reason: reason})
+		return err
+	})
+	if err != nil {
+		if !errors.Is(err, ErrInvalidInput) && !errors.Is(err, repository.ErrNotFound) {
+			s.log.Error().Err(err).Int64("game_id", gameID).Int64("player_id", playerID).Msg("set availability failed")
+		}
+		return model.PlayerAvailability{}, err
+	}
+	s.log.Info().Int64("game_id", gameID).Int64("player_id", playerID).Str("status", status).Msg("availability recorded")
+	return out, nil
+}
+
+func (s *availabilityService) ListGameAvailability(ctx context.Context, gameID int64) ([]model.PlayerAvailability, error) {
+	if gameID <= 0 {
+		return nil, NewInvalidInputError([]FieldError{{Field: "game_id", Message: "must be > 0"}})
+	}
+	if _, err := s.games.GetByID(ctx, gameID); err != nil {
+		return nil, err
+	}
+	return s.availability.ListAvailability(ctx, gameID)
+}
+
+func (s *availabilityService) ClearAvailability(ctx context.Context, gameID, playerID int64) error {
+	var ferrs []FieldError
+	if gameID <= 0 {
+		ferrs = append(ferrs, FieldError{Field: "game_id", Message: "must be > 0"})
+	}
+	if playerID <= 0 {
+		ferrs = append(ferrs, FieldError{Field: "player_id", Message: "must be > 0"})
+	}
+	if err := NewInvalidInputError(ferrs); err != nil {
+		return err
+	}
+	if err := s.availability.DeleteAvailability(ctx, gameID, playerID); err != nil {
+		return err
+	}
+	s.log.Info().Int64("game_id", gameID).Int64("player_id", playerID).Msg("availability cleared")
+	return nil
+}
+
+// RecordInjury adds an entry to a player's injury timeline.
+func (s *availabilityService) RecordInjury(ctx context.Context, inj model.Injury) (model.Injury, error) {
+	inj.Description = strings.TrimSpace(inj.Description)
+	inj.StartDate = truncateToDate(inj.StartDate)
+	inj.ExpectedReturnDate = truncateOptionalDate(inj.ExpectedReturnDate)
+	inj.ReturnedOn = truncateOptionalDate(inj.ReturnedOn)
+	var ferrs []FieldError
+	if inj.PlayerID <= 0 {
+		ferrs = append(ferrs, FieldError{Field: "id", Message: "must be > 0"})
+	}
+	ferrs = append(ferrs, validateInjury(inj)...)
+	if err := NewInvalidInputError(ferrs); err != nil {
+		return model.Injury{}, err
+	}
+	if _, err := s.players.GetByID(ctx, inj.PlayerID); err != nil {
+		return model.Injury{}, err
+	}
+	out, err := s.availability.CreateInjury(ctx, inj)
+	if err != nil {
+		s.log.Error().Err(err).Int64("player_id", inj.PlayerID).Msg("record injury failed")
+		return model.Injury{}, err
+	}
+	s.log.Info().Int64("player_id", out.PlayerID).Int64("injury_id", out.ID).Msg("injury recorded")
+	return out, nil
+}
+
+// UpdateInjury merges the patch into the stored injury and validates the result as on creation.
+func (s *availabilityService) UpdateInjury(ctx context.Context, playerID, injuryID int64, patch model.InjuryPatch) (model.Injury, error) {
+	var ferrs []FieldError
+	if playerID <= 0 {
+		ferrs = append(ferrs, FieldError{Field: "id", Message: "must be > 0"})
+	}
+	if injuryID <= 0 {
+		ferrs = append(ferrs, FieldError{Field: "injury_id", Message: "must be > 0"})
+	}
+	if err := NewInvalidInputError(ferrs); err != nil {
+		return model.Injury{}, err
+	}
+	inj, err := s.availability.GetInjury(ctx, playerID, injuryID)
+	if err != nil {
+		return model.Injury{}, err
+	}
+	if patch.Description != nil {
+		inj.Description = strings.TrimSpace(*patch.Description)
+	}
+	if patch.ExpectedReturnDate != nil {
+		inj.ExpectedReturnDate = truncateOptionalDate(patch.ExpectedReturnDate)
+	}
+	if patch.ReturnedOn != nil {
+		inj.ReturnedOn = truncateOptionalDate(patch.ReturnedOn)
+	}
+	if err := NewInvalidInputError(validateInjury(inj)); err != nil {
+		return model.Injury{}, err
+	}
+	out, err := s.availability.UpdateInjury(ctx, inj)
+	if err != nil {
+		if !errors.Is(err, repository.ErrNotFound) {
+			s.log.Error().Err(err).Int64("player_id", playerID).Int64("injury_id", injuryID).Msg("update injury failed")
+		}
+		return model.Injury{}, err
+	}
+	s.log.Info().Int64("player_id", playerID).Int64("injury_id", injuryID).Msg("injury updated")
+	return out, nil
+}
+
+func (s *availabilityService) ListInjuries(ctx context.Context, playerID int64) ([]model.Injury, error) {
+	if playerID <= 0 {
+		return nil, NewInvalidInputError([]FieldError{{Field: "id", Message: "must be > 0"}})
+	}
+	if _, err := s.players.GetByID(ctx, playerID); err != nil {
+		return nil, err
+	}
+	return s.availability.ListInjuries(ctx, playerID)
+}
+
+// validateInjury checks an already trimmed and truncated injury.
+func validateInjury(inj model.Injury) []FieldError {
+	var ferrs []FieldError
+	if ln := len([]rune(inj.Description)); ln < 1 || ln > maxInjuryDescription {
+		ferrs = append(ferrs, FieldError{Field: "description", Message: "length must be between 1 and 200"})
+	}
+	if inj.StartDate.IsZero() {
+		ferrs = append(ferrs, FieldError{Field: "start_date", Message: "is required"})
+		return ferrs
+	}
+	if inj.ExpectedReturnDate != nil && inj.ExpectedReturnDate.Before(inj.StartDate) {
+		ferrs = append(ferrs, FieldError{Field: "expected_return_date", Message: "must not be before start_date"})
+	}
+	if inj.ReturnedOn != nil && inj.ReturnedOn.Before(inj.StartDate) {
+		ferrs = append(ferrs, FieldError{Field: "returned_on", Message: "must not be before start_date"})
+	}
+	return ferrs
+}
+
+func isValidAvailabilityStatus(status string) bool {
+	switch status {
+	case availabilityActive, availabilityDNPCoach, availabilityInactive, availabilityInjured, availabilitySuspended:
+		return true
+	}
+	return false
+}
+
+// trimOptional trims a free-text field and drops it when nothing is left.
+func trimOptional(v *string) *string {
+	if v == nil {
+		return nil
+	}
+	t := strings.TrimSpace(*v)
+	if t == "" {
+		return nil
+	}
+	return &t
+}
+
+func truncateOptionalDate(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	d := truncateToDate(*t)
+	return &d
+}
@@ -0,0 +1,370 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math"
+
+	"github.com/maxviazov/basketball-stats-service/internal/model"
+	"github.com/maxviazov/basketball-stats-service/internal/repository"
+	"github.com/rs/zerolog"
+)
+
+// Play-by-play event types. Shots are split by value so a single event fully describes its box score effect.
+const (
+	eventTwoPointMade     = "two_pt_made"
+	eventTwoPointMissed   = "two_pt_missed"
+	eventThreePointMade   = "three_pt_made"
+	eventThreePointMissed = "three_pt_missed"
+	eventFreeThrowMade    = "free_throw_made"
+	eventFreeThrowMissed  = "free_throw_missed"
+	eventOffRebound       = "offensive_rebound"
+	eventDefRebound       = "defensive_rebound"
+	eventAssist           = "assist"
+	eventSteal            = "steal"
+	eventBlock            = "block"
+	eventTurnover         = "turnover"
+	eventFoul             = "foul"
+	eventSubIn            = "substitution_in"
+	eventSubOut           = "substitution_out"
+)
+
+func isValidEventType(t string) bool {
+	switch t {
+	case eventTwoPointMade, eventTwoPointMissed, eventThreePointMade, eventThreePointMissed,
+		eventFreeThrowMade, eventFreeThrowMissed, eventOffRebound, eventDefRebound,
+		eventAssist, eventSteal, eventBlock, eventTurnover, eventFoul, eventSubIn, eventSubOut:
+		return true
+	default:
+		return false
+	}
+}
+
+type eventService struct {
+	events       repository.EventRepository
+	stats        repository.StatsRepository
+	achievements repository.AchievementRepository
+	players      repository.PlayerRepository
+	games        repository.GameRepository
+	rules        repository.RuleProfileRepository
+	availability repository.AvailabilityRepository
+	shots        repository.ShotRepository
+	tx           repository.TxManager
+	log          zerolog.Logger
+}
+
+func NewEventService(events repository.EventRepository, stats repository.StatsRepository, achievements repository.AchievementRepository, players repository.PlayerRepository, games repository.GameRepository, rules repository.RuleProfileRepository, availability repository.AvailabilityRepository, shots repository.ShotRepository, tx repository.TxManager, logger zerolog.Logger) EventService {
+	l := logger.With().Str("module", "service").Str("component", "events").Logger()
+	return &eventService{events: events, stats: stats, achievements: achievements, players: players, games: games, rules: rules, availability: availability, shots: shots, tx: tx, log: l}
+}
+
+// RecordEvent appends an event to a game's stream and re-derives the player's box score line in the same transaction.
+func (s *eventService) RecordEvent(ctx context.Context, e model.GameEvent) (model.GameEvent, error) {
+	if err := NewInvalidInputError(validateEvent(e)); err != nil {
+		return model.GameEvent{}, err
+	}
+	var out model.GameEvent
+	err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.events.LockGame(ctx, e.GameID); err != nil {
+			return err
+		}
+		if err := s.checkParticipants(ctx, e); err != nil {
+			return err
+		}
+		created, err := s.events.Create(ctx, e)
+		if err != nil {
+			return err
+		}
+		out = created
+		return s.rebuildLines(ctx, e.GameID, e.PlayerID)
+	})
+	if err != nil {
+		s.logFailure(err, e.GameID, "record event failed")
+		return model.GameEvent{}, err
+	}
+	return out, nil
+}
+
+// CorrectEvent rewrites an existing event. Both the previous and the new player get their lines re-derived,
+// so moving an event from one player to another never leaves a stale line behind.
+func (s *eventService) CorrectEvent(ctx context.Context, e model.GameEvent) (model.GameEvent, error) {
+	ferrs := validateEvent(e)
+	if e.ID <= 0 {
+		ferrs = append(ferrs, FieldError{Field: "event_id", Message: "must be > 0"})
+	}
+	if err := NewInvalidInputError(ferrs); err != nil {
+		return model.GameEvent{}, err
+	}
+	var out model.GameEvent
+	err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.events.LockGame(ctx, e.GameID); err != nil {
+			return err
+		}
+		prev, err := s.events.GetByID(ctx, e.ID)
+		if err != nil {
+			return err
+		}
+		if prev.GameID != e.GameID {
+			return repository.ErrNotFound
+		}
+		if err := s.checkParticipants(ctx, e); err != nil {
+			return err
+		}
+		updated, err := s.events.Update(ctx, e)
+		if err != nil {
+			return err
+		}
+		out = updated
+		return s.rebuildLines(ctx, e.GameID, prev.PlayerID, e.PlayerID)
+	})
+	if err != nil {
+		s.logFailure(err, e.GameID, "correct event failed")
+		return model.GameEvent{}, err
+	}
+	return out, nil
+}
+
+// DeleteEvent removes an event and re-derives the affected player's line; a player left without events loses the line.
+func (s *eventService) DeleteEvent(ctx context.Context, gameID, eventID int64) error {
+	var ferrs []FieldError
+	if gameID <= 0 {
+		ferrs = append(ferrs, FieldError{Field: "game_id", Message: "must be > 0"})
+	}
+	if eventID <= 0 {
+		ferrs = append(ferrs, FieldError{Field: "event_id", Message: "must be > 0"})
+	}
+	if err := NewInvalidInputError(ferrs); err != nil {
+		return err
+	}
+	err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.events.LockGame(ctx, gameID); err != nil {
+			return err
+		}
+		prev, err := s.events.GetByID(ctx, eventID)
+		if err != nil {
+			return err
+		}
+		if prev.GameID != gameID {
+			return repository.ErrNotFound
+		}
+		if err := s.events.Delete(ctx, eventID); err != nil {
+			return err
+		}
+		return s.rebuildLines(ctx, gameID, prev.PlayerID)
+	})
+	if err != nil {
+		s.logFailure(err, gameID, "delete event failed")
+		return err
+	}
+	return nil
+}
+
+func (s *eventService) ListEvents(ctx context.Context, gameID int64) ([]model.GameEvent, error) {
+	if gameID <= 0 {
+		return nil, NewInvalidInputError([]FieldError{{Field: "game_id", Message: "must be > 0"}})
+	}
+	if _, err := s.games.GetByID(ctx, gameID); err != nil {
+		return nil, err
+	}
+	return s.events.ListByGame(ctx, gameID)
+}
+
+// checkParticipants verifies the game is under way, the event's team plays in it and the player was on that team's
+// roster on the game date.
+// The clock is checked against the period length of the game's rule profile, and the team's roster size
+// against the players who already have a line.
+func (s *eventService) checkParticipants(ctx context.Context, e model.GameEvent) error {
+	g, err := s.games.GetByID(ctx, e.GameID)
+	if err != nil {
+		return err
+	}
+	profile, err := s.rules.GetByID(ctx, g.RuleProfileID)
+	if err != nil {
+		return err
+	}
+	var ferrs []FieldError
+	if e.ClockSeconds > profile.PeriodSeconds(e.Period) {
+		ferrs = append(ferrs, FieldError{Field: "clock_seconds", Message: "must be within the period length"})
+	}
+	if !acceptsStats(g.Status) {
+		ferrs = append(ferrs, FieldError{Field: "game_id", Message: "game is " + g.Status + "; events are only accepted in progress or finished"})
+	}
+	if e.TeamID != g.HomeTeamID && e.TeamID != g.AwayTeamID {
+		ferrs = append(ferrs, FieldError{Field: "team_id", Message: "team does not play in this game"})
+	}
+	teamID, err := checkRoster(ctx, s.players, e.PlayerID, g)
+	switch {
+	case errors.Is(err, ErrInvalidInput):
+		ferrs = append(ferrs, FieldErrors(err)...)
+	case err != nil:
+		return err
+	case teamID != e.TeamID:
+		ferrs = append(ferrs, FieldError{Field: "player_id", Message: "player was not on team_id's roster on the game date"})
+	}
+	if err := NewInvalidInputError(ferrs); err != nil {
+		return err
+	}
+	return checkRosterSize(ctx, s.games, g, e.TeamID, e.PlayerID, profile)
+}
+
+// rebuildLines re-derives the stat line of each player from their events, and their achievements with it.
+// A derived line goes through the same validation as a submitted one, so an event that would
+// produce an impossible box score (e.g. a seventh foul under NBA rules) is rejected together with the write.
+// The event being written is already stored, so an overtime counts from its first event.
+// A derived line that puts the player on the floor is rejected while they are recorded as not playing, and
+// neither a derived line nor a removed one may leave the player's charted shots uncovered.
+func (s *eventService) rebuildLines(ctx context.Context, gameID int64, playerIDs ...int64) error {
+	g, err := s.games.GetByID(ctx, gameID)
+	if err != nil {
+		return err
+	}
+	profile, overtimes, err := loadGameRules(ctx, s.rules, s.games, g)
+	if err != nil {
+		return err
+	}
+	seen := make(map[int64]bool, len(playerIDs))
+	for _, pid := range playerIDs {
+		if seen[pid] {
+			continue
+		}
+		seen[pid] = true
+		evs, err := s.events.ListByPlayerGame(ctx, pid, gameID)
+		if err != nil {
+			return err
+		}
+		if len(evs) == 0 {
+			if err := checkShotsCovered(ctx, s.shots, model.PlayerStatLine{PlayerID: pid, GameID: gameID}); err != nil {
+				return err
+			}
+			if err := s.stats.DeleteStatLine(ctx, pid, gameID); err != nil {
+				return err
+			}
+			if err := refreshAchievements(ctx, s.achievements, pid, gameID, nil); err != nil {
+				return err
+			}
+			continue
+		}
+		line := deriveStatLine(pid, gameID, evs)
+		ferrs := validateStatLine(line)
+		if len(ferrs) == 0 {
+			ferrs = validateStatLineRules(line, profile, overtimes)
+		}
+		if err := NewInvalidInputError(ferrs); err != nil {
+			return err
+		}
+		if err := checkAvailable(ctx, s.availability, line); err != nil {
+			return err
+		}
+		if err := checkShotsCovered(ctx, s.shots, line); err != nil {
+			return err
+		}
+		saved, err := s.stats.UpsertStatLine(ctx, line)
+		if err != nil {
+			return err
+		}
+		if err := refreshAchievements(ctx, s.achievements, pid, gameID, &saved); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *eventService) logFailure(err error, gameID int64, msg string) {
+	if errors.Is(err, ErrInvalidInput) || errors.Is(err, repository.ErrNotFound) {
+		s.log.Debug().Err(err).Int64("game_id", gameID).Interface("field_errors", FieldErrors(err)).Msg(msg)
+		return
+	}
+	s.log.Error().Err(err).Int64("game_id", gameID).Msg(msg)
+}
+
+func validateEvent(e model.GameEvent) []FieldError {
+	var ferrs []FieldError
+	if e.GameID <= 0 {
+		ferrs = append(ferrs, FieldError{Field: "game_id", Message: "must be > 0"})
+	}
+	if e.TeamID <= 0 {
+		ferrs = append(ferrs, FieldError{Field: "team_id", Message: "must be > 0"})
+	}
+	if e.PlayerID <= 0 {
+		ferrs = append(ferrs, FieldError{Field: "player_id", Message: "must be > 0"})
+	}
+	if e.Period < 1 {
+		ferrs = append(ferrs, FieldError{Field: "period", Message: "must be >= 1"})
+	} else if e.ClockSeconds < 0 {
+		ferrs = append(ferrs, FieldError{Field: "clock_seconds", Message: "must be >= 0"})
+	}
+	if !isValidEventType(e.EventType) {
+		ferrs = append(ferrs, FieldError{Field: "event_type", Message: "unknown event type"})
+	}
+	return ferrs
+}
+
+// deriveStatLine folds a single player's events, in replay order, into a box score line.
+// Minutes come from substitution pairs: players on court when a period starts are expected to have a
+// substitution_in at the full period clock, and anyone still on court when a period ends is credited until 0:00.
+func deriveStatLine(playerID, gameID int64, events []model.GameEvent) model.PlayerStatLine {
+	line := model.PlayerStatLine{PlayerID: playerID, GameID: gameID}
+	seconds := 0
+	period, inSince := 0, -1
+	for _, e := range events {
+		if e.Period != period {
+			if inSince >= 0 {
+				seconds += inSince
+			}
+			period, inSince = e.Period, -1
+		}
+		switch e.EventType {
+		case eventTwoPointMade:
+			line.FieldGoalsMade++
+			line.FieldGoalsAttempted++
+			line.Points += 2
+		case eventTwoPointMissed:
+			line.FieldGoalsAttempted++
+		case eventThreePointMade:
+			line.FieldGoalsMade++
+			line.FieldGoalsAttempted++
+			line.ThreePointersMade++
+			line.ThreePointersAttempted++
+			line.Points += 3
+		case eventThreePointMissed:
+			line.FieldGoalsAttempted++
+			line.ThreePointersAttempted++
+		case eventFreeThrowMade:
+			line.FreeThrowsMade++
+			line.FreeThrowsAttempted++
+			line.Points++
+		case eventFreeThrowMissed:
+			line.FreeThrowsAttempted++
+		case eventOffRebound:
+			line.OffensiveRebounds++
+			line.Rebounds++
+		case eventDefRebound:
+			line.DefensiveRebounds++
+			line.Rebounds++
+		case eventAssist:
+			line.Assists++
+		case eventSteal:
+			line.Steals++
+		case eventBlock:
+			line.Blocks++
+		case eventTurnover:
+			line.Turnovers++
+		case eventFoul:
+			line.Fouls++
+		case eventSubIn:
+			if inSince < 0 {
+				inSince = e.ClockSeconds
+			}
+		case eventSubOut:
+			if inSince >= 0 {
+				seconds += inSince - e.ClockSeconds
+				inSince = -1
+			}
+		}
+	}
+	if inSince >= 0 {
+		seconds += inSince
+	}
+	line.MinutesPlayed = float32(math.Round(float64(seconds)/6) / 10)
+	return line
+}
@@ -3,8 +3,8 @@ package service
 import (
 	"context"
 	"errors"
+	"strconv"
 	"strings"
-	"time"
 
 	"github.com/maxviazov/basketball-stats-service/internal/model"
 	"github.com/maxviazov/basketball-stats-service/internal/repository"
@@ -12,21 +12,31 @@ import (
 )
 
 type gameService struct {
-	games repository.GameRepository
-	teams repository.TeamRepository
-	tx    repository.TxManager
-	log   zerolog.Logger
+	games        repository.GameRepository
+	teams        repository.TeamRepository
+	seasons      repository.SeasonRepository
+	rules        repository.RuleProfileRepository
+	venues       repository.VenueRepository
+	stats        repository.StatsRepository
+	achievements repository.AchievementRepository
+	tx           repository.TxManager
+	log          zerolog.Logger
 }
 
-func NewGameService(games repository.GameRepository, teams repository.TeamRepository, tx repository.TxManager, logger zerolog.Logger) GameService {
+func NewGameService(games repository.GameRepository, teams repository.TeamRepository, seasons repository.SeasonRepository, rules repository.RuleProfileRepository, venues repository.VenueRepository, stats repository.StatsRepository, achievements repository.AchievementRepository, tx repository.TxManager, logger zerolog.Logger) GameService {
 	l := logger.With().Str("module", "service").Str("component", "game").Logger()
-	return &gameService{games: games, teams: teams, tx: tx, log: l}
+	return &gameService{games: games, teams: teams, seasons: seasons, rules: rules, venues: venues, stats: stats, achievements: achievements, tx: tx, log: l}
 }
 
-func (s *gameService) CreateGame(ctx context.Context, season string, date time.Time, homeID, awayID int64, status string) (model.Game, error) {
+func (s *gameService) CreateGame(ctx context.Context, g model.Game) (model.Game, error) {
 	// Normalize input strings
-	seasonTrimmed := strings.TrimSpace(season)
-	statusNorm := normalizeStatus(status)
+	g.Season = strings.TrimSpace(g.Season)
+	g.Status = normalizeStatus(g.Status)
+	g.Phase = normalizePhase(g.Phase)
+	if g.Phase == "" {
+		g.Phase = phaseRegular
+	}
+	homeID, awayID := g.HomeTeamID, g.AwayTeamID
 
 	var ferrs []FieldError
 	if homeID <= 0 {
@@ -38,15 +48,23 @@ func (s *gameService) CreateGame(ctx context.Context, season string, date time.T
 	if homeID > 0 && awayID > 0 && homeID == awayID {
 		ferrs = append(ferrs, FieldError{Field: "teams", Message: "home and away must differ"})
 	}
-	if date.IsZero() {
+	if g.Date.IsZero() {
 		ferrs = append(ferrs, FieldError{Field: "date", Message: "must be set"})
 	}
-	if seasonTrimmed == "" || !IsValidSeason(seasonTrimmed) {
+	if g.Season == "" || !IsValidSeason(g.Season) {
 		ferrs = append(ferrs, FieldError{Field: "season", Message: "invalid format, expected YYYY-YY"})
 	}
-	if !isValidGameStatus(statusNorm) {
+	if !isValidPhase(g.Phase) {
+		ferrs = append(ferrs, FieldError{Field: "phase", Message: "must be one of preseason|regular|playoffs"})
+	}
+	if !isValidGameStatus(g.Status) {
 		ferrs = append(ferrs, FieldError{Field: "status", Message: "must be one of scheduled|in_progress|finished"})
 	}
+	if g.RuleProfileID < 0 {
+		ferrs = append(ferrs, FieldError{Field: "rule_profile_id", Message: "must be > 0"})
+	}
+	ferrs = append(ferrs, validateVenueRef(g.VenueID, "venue_id")...)
+	ferrs = append(ferrs, validateAttendance(g.Attendance)...)
 
 	// Early exit if basic structure is invalid – do not touch the database.
 	if err := NewInvalidInputError(ferrs); err != nil {
@@ -56,7 +74,8 @@ func (s *gameService) CreateGame(ctx context.Context, season string, date time.T
 
 	// Existence checks before attempting persistence.
 	var existenceErrs []FieldError
-	if _, err := s.teams.GetByID(ctx, homeID); err != nil {
+	home, err := s.teams.GetByID(ctx, homeID)
+	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			existenceErrs = append(existenceErrs, FieldError{Field: "home_team_id", Message: "team does not exist"})
 		} else {
@@ -70,19 +89,56 @@ func (s *gameService) CreateGame(ctx context.Context, season string, date time.T
 			return model.Game{}, err
 		}
 	}
+	season, err := s.seasons.GetByName(ctx, g.Season)
+	switch {
+	case errors.Is(err, repository.ErrNotFound):
+		existenceErrs = append(existenceErrs, FieldError{Field: "season", Message: "season does not exist"})
+	case err != nil:
+		return model.Game{}, err
+	default:
+		existenceErrs = append(existenceErrs, validateGameInSeason(g, season)...)
+	}
+	if g.RuleProfileID > 0 {
+		if _, err := s.rules.GetByID(ctx, g.RuleProfileID); err != nil {
+			if !errors.Is(err, repository.ErrNotFound) {
+				return model.Game{}, err
+			}
+			existenceErrs = append(existenceErrs, FieldError{Field: "rule_profile_id", Message: "rule profile does not exist"})
+		}
+	}
+	// A game is played at the home team's venue unless it names another one.
+	if g.VenueID == nil {
+		g.VenueID = home.HomeVenueID
+	}
+	venueErrs, err := s.checkVenue(ctx, g.VenueID, g.Attendance)
+	if err != nil {
+		return model.Game{}, err
+	}
+	existenceErrs = append(existenceErrs, venueErrs...)
 	if err := NewInvalidInputError(existenceErrs); err != nil {
 		s.log.Debug().Interface("field_errors", existenceErrs).Msg("game validation failed (existence)")
 		return model.Game{}, err
 	}
 
-	// One INSERT – transaction is redundant, but we leave the generalization: maybe accompanying records will appear.
+	// Every game is inserted as scheduled. A game imported as in progress or finished is then walked along
+	// gameTransitions in the same transaction, so its history shows each move like any other game's.
 	var out model.Game
-	err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
-		created, err := s.games.Create(ctx, model.Game{Season: seasonTrimmed, Date: date, HomeTeamID: homeID, AwayTeamID: awayID, Status: statusNorm})
+	err = s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		created, err := s.games.Create(ctx, model.Game{Season: g.Season, Phase: g.Phase, Date: g.Date, HomeTeamID: homeID, AwayTeamID: awayID, Status: statusScheduled, RuleProfileID: g.RuleProfileID, VenueID: g.VenueID, Attendance: g.Attendance})
 		if err != nil {
 			return err
 		}
 		out = created
+		for _, to := range []string{statusInProgress, statusFinished} {
+			if out.Status == g.Status {
+				break
+			}
+			tr, err := s.games.Transition(ctx, out.ID, out.Status, to)
+			if err != nil {
+				return err
+			}
+			out.Status = tr.ToStatus
+		}
 		return nil
 	})
 	if err != nil {
@@ -92,11 +148,69 @@ func (s *gameService) CreateGame(ctx context.Context, season string, date time.T
 	return out, nil
 }
 
+// validateGameInSeason checks the game date against the season and the phase it is played in.
+// Dates are compared by calendar day, in UTC, since season boundaries are whole days.
+func validateGameInSeason(g model.Game, season model.Season) []FieldError {
+	day := truncateToDate(g.Date.UTC())
+	if day.Before(season.StartDate) || day.After(season.EndDate) {
+		return []FieldError{{Field: "date", Message: "must fall inside season " + season.Name}}
+	}
+	for _, p := range season.Phases {
+		if p.Phase != g.Phase {
+			continue
+		}
+		if day.Before(p.StartDate) || day.After(p.EndDate) {
+			return []FieldError{{Field: "date", Message: "must fall inside the " + g.Phase + " phase of season " + season.Name}}
+		}
+		return nil
+	}
+	return []FieldError{{Field: "phase", Message: "season " + season.Name + " has no " + g.Phase + " phase"}}
+}
+
+func validateAttendance(attendance *int) []FieldError {
+	if attendance != nil && *attendance < 0 {
+		return []FieldError{{Field: "attendance", Message: "must be >= 0"}}
+	}
+	return nil
+}
+
+// checkVenue looks up the venue of a game and checks the attendance against its capacity.
+// An attendance needs a venue, since there is nothing to check it against otherwise.
+func (s *gameService) checkVenue(ctx context.Context, venueID *int64, attendance *int) ([]FieldError, error) {
+	if venueID == nil {
+		if attendance != nil {
+			return []FieldError{{Field: "attendance", Message: "requires a venue"}}, nil
+		}
+		return nil, nil
+	}
+	venue, err := s.venues.GetByID(ctx, *venueID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return []FieldError{{Field: "venue_id", Message: "venue does not exist"}}, nil
+		}
+		return nil, err
+	}
+	if attendance != nil && *attendance > venue.Capacity {
+		return []FieldError{{Field: "attendance", Message: "must be <= the capacity of " + venue.Name + " (" + strconv.Itoa(venue.Capacity) + ")"}}, nil
+	}
+	return nil, nil
+}
+
 func (s *gameService) GetGame(ctx context.Context, id int64) (model.Game, error) {
 	if id <= 0 {
 		return model.Game{}, NewInvalidInputError([]FieldError{{Field: "id", Message: "must be > 0"}})
 	}
-	return s.games.GetByID(ctx, id)
+	g, err := s.games.GetByID(ctx, id)
+	if err != nil {
+		return model.Game{}, err
+	}
+	periods, err := s.games.ListPeriodScores(ctx, id)
+	if err != nil {
+		s.log.Error().Err(err).Int64("game_id", id).Msg("list period scores failed")
+		return model.Game{}, err
+	}
+	g.LineScore = periods
+	return g, nil
 }
 
 func (s *gameService) ListGames(ctx context.Context, page repository.Page) (repository.PageResult[model.Game], error) {
@@ -108,3 +222,350 @@ func (s *gameService) ListGames(ctx context.Context, page repository.Page) (repo
 	}
 	return res, nil
 }
+
+// UpdateGame reschedules a game, changes its phase, venue or attendance. The patch is merged into the stored
+// game and the result must still fall inside its season phase and fit its venue, as on creation.
+func (s *gameService) UpdateGame(ctx context.Context, id int64, patch model.GamePatch) (model.Game, error) {
+	var ferrs []FieldError
+	if id <= 0 {
+		ferrs = append(ferrs, FieldError{Field: "id", Message: "must be > 0"})
+	}
+	if patch.Date != nil && patch.Date.IsZero() {
+		ferrs = append(ferrs, FieldError{Field: "date", Message: "must be set"})
+	}
+	if patch.Phase != nil {
+		phase := normalizePhase(*patch.Phase)
+		patch.Phase = &phase
+		if !isValidPhase(phase) {
+			ferrs = append(ferrs, FieldError{Field: "phase", Message: "must be one of preseason|regular|playoffs"})
+		}
+	}
+	ferrs = append(ferrs, validateVenueRef(patch.VenueID, "venue_id")...)
+	ferrs = append(ferrs, validateAttendance(patch.Attendance)...)
+	if err := NewInvalidInputError(ferrs); err != nil {
+		return model.Game{}, err
+	}
+
+	var out model.Game
+	err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		g, err := s.games.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if patch.Date == nil && patch.Phase == nil && patch.VenueID == nil && patch.Attendance == nil {
+			out = g
+			return nil
+		}
+		if patch.Date != nil {
+			g.Date = *patch.Date
+		}
+		if patch.Phase != nil {
+			g.Phase = *patch.Phase
+		}
+		if patch.VenueID != nil {
+			g.VenueID = patch.VenueID
+		}
+		if patch.Attendance != nil {
+			g.Attendance = patch.Attendance
+		}
+		season, err := s.seasons.GetByName(ctx, g.Season)
+		if err != nil {
+			return err
+		}
+		if err := NewInvalidInputError(validateGameInSeason(g, season)); err != nil {
+			return err
+		}
+		if patch.VenueID != nil || patch.Attendance != nil {
+			venueErrs, err := s.checkVenue(ctx, g.VenueID, g.Attendance)
+			if err != nil {
+				return err
+			}
+			if err := NewInvalidInputError(venueErrs); err != nil {
+				return err
+			}
+		}
+		out, err = s.games.Update(ctx, g)
+		return err
+	})
+	if err != nil {
+		if !errors.Is(err, ErrInvalidInput) && !errors.Is(err, repository.ErrNotFound) {
+			s.log.Error().Err(err).Int64("game_id", id).Msg("update game failed")
+		}
+		return model.Game{}, err
+	}
+	s.log.Info().Int64("game_id", id).Msg("game updated")
+	return out, nil
+}
+
+// TransitionGame moves a game to a new status along gameTransitions and records when it happened.
+// A move the lifecycle does not allow is rejected as invalid input on the status field.
+func (s *gameService) TransitionGame(ctx context.Context, id int64, status string) (model.GameTransition, error) {
+	status = normalizeStatus(status)
+	var ferrs []FieldError
+	if id <= 0 {
+		ferrs = append(ferrs, FieldError{Field: "id", Message: "must be > 0"})
+	}
+	if !isKnownGameStatus(status) {
+		ferrs = append(ferrs, FieldError{Field: "status", Message: "must be one of scheduled|in_progress|finished|postponed|cancelled"})
+	}
+	if err := NewInvalidInputError(ferrs); err != nil {
+		return model.GameTransition{}, err
+	}
+
+	var out model.GameTransition
+	err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		g, err := s.games.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if !canTransition(g.Status, status) {
+			return NewInvalidInputError([]FieldError{{Field: "status", Message: "cannot move a " + g.Status + " game to " + status}})
+		}
+		if status == statusFinished && g.HomeScore != nil && g.AwayScore != nil && *g.HomeScore == *g.AwayScore {
+			return NewInvalidInputError([]FieldError{{Field: "status", Message: "a finished game cannot end in a tie"}})
+		}
+		out, err = s.games.Transition(ctx, id, g.Status, status)
+		return err
+	})
+	if err != nil {
+		if !errors.Is(err, ErrInvalidInput) && !errors.Is(err, repository.ErrNotFound) {
+			s.log.Error().Err(err).Int64("game_id", id).Str("status", status).Msg("game transition failed")
+		}
+		return model.GameTransition{}, err
+	}
+	s.log.Info().Int64("game_id", id).Str("from", out.FromStatus).Str("to", out.ToStatus).Msg("game transitioned")
+	return out, nil
+}
+
+func (s *gameService) ListGameTransitions(ctx context.Context, id int64) ([]model.GameTransition, error) {
+	if id <= 0 {
+		return nil, NewInvalidInputError([]FieldError{{Field: "id", Message: "must be > 0"}})
+	}
+	if _, err := s.games.GetByID(ctx, id); err != nil {
+		return nil, err
+	}
+	return s.games.ListTransitions(ctx, id)
+}
+
+// DeleteGame soft-deletes a game; it drops out of results, standings and aggregates until restored.
+// Career milestones of the players in it are recomputed in the same transaction, so a milestone reached
+// in the deleted game moves to the game where the total is now crossed.
+func (s *gameService) DeleteGame(ctx context.Context, id int64) error {
+	if id <= 0 {
+		return NewInvalidInputError([]FieldError{{Field: "id", Message: "must be > 0"}})
+	}
+	err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.games.Delete(ctx, id); err != nil {
+			return err
+		}
+		return s.syncGameMilestones(ctx, id)
+	})
+	if err != nil {
+		if !errors.Is(err, repository.ErrNotFound) {
+			s.log.Error().Err(err).Int64("game_id", id).Msg("delete game failed")
+		}
+		return err
+	}
+	s.log.Info().Int64("game_id", id).Msg("game deleted")
+	return nil
+}
+
+// RestoreGame undeletes a game and recomputes the career milestones of the players in it, like DeleteGame.
+func (s *gameService) RestoreGame(ctx context.Context, id int64) (model.Game, error) {
+	if id <= 0 {
+		return model.Game{}, NewInvalidInputError([]FieldError{{Field: "id", Message: "must be > 0"}})
+	}
+	var out model.Game
+	err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		restored, err := s.games.Restore(ctx, id)
+		if err != nil {
+			return err
+		}
+		out = restored
+		return s.syncGameMilestones(ctx, id)
+	})
+	if err != nil {
+		if !errors.Is(err, repository.ErrNotFound) {
+			s.log.Error().Err(err).Int64("game_id", id).Msg("restore game failed")
+		}
+		return model.Game{}, err
+	}
+	s.log.Info().Int64("game_id", id).Msg("game restored")
+	return out, nil
+}
+
+// syncGameMilestones recomputes the career milestones of every player with a line in a game.
+// Single-game feats stay with their game and are hidden or shown along with it.
+func (s *gameService) syncGameMilestones(ctx context.Context, gameID int64) error {
+	lines, err := s.stats.ListByGame(ctx, gameID)
+	if err != nil {
+		return err
+	}
+	for _, l := range lines {
+		if err := s.achievements.SyncMilestones(ctx, l.PlayerID, careerMilestones); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UpdateScore validates and stores the official score of a game.
+// The line score is optional, but when present it must be contiguous from period 1 and add up to the final score.
+func (s *gameService) UpdateScore(ctx context.Context, gameID int64, score model.GameScore) (model.Game, error) {
+	var ferrs []FieldError
+	if gameID <= 0 {
+		ferrs = append(ferrs, FieldError{Field: "id", Message: "must be > 0"})
+	}
+	if score.HomeScore < 0 {
+		ferrs = append(ferrs, FieldError{Field: "home_score", Message: "must be >= 0"})
+	}
+	if score.AwayScore < 0 {
+		ferrs = append(ferrs, FieldError{Field: "away_score", Message: "must be >= 0"})
+	}
+	ferrs = append(ferrs, validateLineScore(score)...)
+	if err := NewInvalidInputError(ferrs); err != nil {
+		s.log.Debug().Interface("field_errors", ferrs).Int64("game_id", gameID).Msg("score validation failed (structure)")
+		return model.Game{}, err
+	}
+
+	var out model.Game
+	err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		g, err := s.games.GetByID(ctx, gameID)
+		if err != nil {
+			return err
+		}
+		// Rules that depend on the game's state.
+		var stateErrs []FieldError
+		switch g.Status {
+		case statusScheduled:
+			stateErrs = append(stateErrs, FieldError{Field: "status", Message: "game has not started"})
+		case statusPostponed, statusCancelled:
+			stateErrs = append(stateErrs, FieldError{Field: "status", Message: "game was " + g.Status})
+		case statusFinished:
+			if score.HomeScore == score.AwayScore {
+				stateErrs = append(stateErrs, FieldError{Field: "away_score", Message: "a finished game cannot end in a tie"})
+			}
+			profile, err := s.rules.GetByID(ctx, g.RuleProfileID)
+			if err != nil {
+				return err
+			}
+			if n := len(score.Periods); n > 0 && n < profile.Periods {
+				stateErrs = append(stateErrs, FieldError{Field: "periods", Message: "a finished game needs at least " + strconv.Itoa(profile.Periods) + " periods"})
+			}
+		}
+		if err := NewInvalidInputError(stateErrs); err != nil {
+			return err
+		}
+		updated, err := s.games.UpdateScore(ctx, gameID, score)
+		if err != nil {
+			return err
+		}
+		out = updated
+		return nil
+	})
+	if err != nil {
+		if !errors.Is(err, ErrInvalidInput) && !errors.Is(err, repository.ErrNotFound) {
+			s.log.Error().Err(err).Int64("game_id", gameID).Msg("update score failed")
+		}
+		return model.Game{}, err
+	}
+	s.log.Info().Int64("game_id", gameID).Int("home", score.HomeScore).Int("away", score.AwayScore).Msg("game score updated")
+	return out, nil
+}
+
+// ReconcileScore reports whether the official score matches the points in the player stat lines.
+// A game without an official score is reported as inconsistent.
+func (s *gameService) ReconcileScore(ctx context.Context, gameID int64) (model.ScoreReconciliation, error) {
+	if gameID <= 0 {
+		return model.ScoreReconciliation{}, NewInvalidInputError([]FieldError{{Field: "id", Message: "must be > 0"}})
+	}
+	g, err := s.games.GetByID(ctx, gameID)
+	if err != nil {
+		return model.ScoreReconciliation{}, err
+	}
+	home, away, err := s.games.GetPlayerPointTotals(ctx, gameID)
+	if err != nil {
+		s.log.Error().Err(err).Int64("game_id", gameID).Msg("player point totals failed")
+		return model.ScoreReconciliation{}, err
+	}
+	out := model.ScoreReconciliation{
+		GameID:           gameID,
+		HomeScore:        g.HomeScore,
+		AwayScore:        g.AwayScore,
+		HomePlayerPoints: home,
+		AwayPlayerPoints: away,
+	}
+	out.Consistent = g.HomeScore != nil && g.AwayScore != nil && *g.HomeScore == home && *g.AwayScore == away
+	return out, nil
+}
+
+// GetBoxScore assembles the box score of a game. Team totals are summed from the player lines,
+// so they can differ from the official score until the game is reconciled.
+func (s *gameService) GetBoxScore(ctx context.Context, gameID int64) (model.BoxScore, error) {
+	if gameID <= 0 {
+		return model.BoxScore{}, NewInvalidInputError([]FieldError{{Field: "id", Message: "must be > 0"}})
+	}
+	box, err := s.games.GetBoxScore(ctx, gameID)
+	if err != nil {
+		if !errors.Is(err, repository.ErrNotFound) {
+			s.log.Error().Err(err).Int64("game_id", gameID).Msg("box score failed")
+		}
+		return model.BoxScore{}, err
+	}
+	periods, err := s.games.ListPeriodScores(ctx, gameID)
+	if err != nil {
+		s.log.Error().Err(err).Int64("game_id", gameID).Msg("list period scores failed")
+		return model.BoxScore{}, err
+	}
+	box.Game.LineScore = periods
+	sumBoxScoreTeam(&box.Home)
+	sumBoxScoreTeam(&box.Away)
+	return box, nil
+}
+
+// sumBoxScoreTeam fills the totals and shooting percentages of one side from its player lines.
+// The totals count a single game, however many players took part.
+func sumBoxScoreTeam(t *model.BoxScoreTeam) {
+	var totals model.StatTotals
+	for _, l := range t.Players {
+		totals.Add(l.Totals())
+	}
+	if totals.Games > 0 {
+		totals.Games = 1
+	}
+	t.Totals = totals
+	t.FieldGoalPct = round(div(float64(totals.FieldGoalsMade), float64(totals.FieldGoalsAttempted)), 3)
+	t.ThreePointPct = round(div(float64(totals.ThreePointersMade), float64(totals.ThreePointersAttempted)), 3)
+	t.FreeThrowPct = round(div(float64(totals.FreeThrowsMade), float64(totals.FreeThrowsAttempted)), 3)
+}
+
+// validateLineScore checks the structure of an optional line score against the final score.
+func validateLineScore(score model.GameScore) []FieldError {
+	if len(score.Periods) == 0 {
+		return nil
+	}
+	var ferrs []FieldError
+	homeSum, awaySum := 0, 0
+	for i, p := range score.Periods {
+		if p.Period != i+1 {
+			ferrs = append(ferrs, FieldError{Field: "periods", Message: "periods must be numbered 1..N without gaps"})
+			break
+		}
+		if p.HomePoints < 0 || p.AwayPoints < 0 {
+			ferrs = append(ferrs, FieldError{Field: "periods", Message: "period points must be >= 0"})
+			break
+		}
+		homeSum += p.HomePoints
+		awaySum += p.AwayPoints
+	}
+	if len(ferrs) > 0 {
+		return ferrs
+	}
+	if homeSum != score.HomeScore {
+		ferrs = append(ferrs, FieldError{Field: "home_score", Message: "must equal the sum of home period points"})
+	}
+	if awaySum != score.AwayScore {
+		ferrs = append(ferrs, FieldError{Field: "away_score", Message: "must equal the sum of away period points"})
+	}
+	return ferrs
+}
@@ -0,0 +1,75 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/maxviazov/basketball-stats-service/internal/model"
+	"github.com/maxviazov/basketball-stats-service/internal/repository"
+	"github.com/rs/zerolog"
+)
+
+const (
+	perGame  = "game"
+	perTotal = "total"
+
+	defaultLeadersLimit = 10
+	maxLeadersLimit     = 100
+)
+
+type leadersService struct {
+	leaders  repository.LeadersRepository
+	minGames int
+	log      zerolog.Logger
+}
+
+// NewLeadersService builds the leaderboard service; minGames is the qualification threshold from config.
+func NewLeadersService(leaders repository.LeadersRepository, minGames int, logger zerolog.Logger) LeadersService {
+	l := logger.With().Str("module", "service").Str("component", "leaders").Logger()
+	if minGames < 0 {
+		minGames = 0
+	}
+	return &leadersService{leaders: leaders, minGames: minGames, log: l}
+}
+
+// GetLeaders ranks players by one stat. per is "game" (the default) or "total"; season and phase are optional.
+// Only players with at least the configured number of games in the selected range qualify.
+func (s *leadersService) GetLeaders(ctx context.Context, stat, per string, season, phase *string, limit int) (model.Leaderboard, error) {
+	stat = strings.ToLower(strings.TrimSpace(stat))
+	per = strings.ToLower(strings.TrimSpace(per))
+	if per == "" {
+		per = perGame
+	}
+
+	var ferrs []FieldError
+	if stat == "" {
+		ferrs = append(ferrs, FieldError{Field: "stat", Message: "is required"})
+	} else if !repository.IsLeaderStat(stat) {
+		ferrs = append(ferrs, FieldError{Field: "stat", Message: "is not a player_stats column"})
+	}
+	if per != perGame && per != perTotal {
+		ferrs = append(ferrs, FieldError{Field: "per", Message: "must be one of game|total"})
+	}
+	if season != nil && !IsValidSeason(*season) {
+		ferrs = append(ferrs, FieldError{Field: "season", Message: "must be in YYYY-YY format"})
+	}
+	phase, phaseErrs := validatePhaseFilter(phase)
+	ferrs = append(ferrs, phaseErrs...)
+	if limit < 0 || limit > maxLeadersLimit {
+		ferrs = append(ferrs, FieldError{Field: "limit", Message: "must be between 1 and 100"})
+	}
+	if err := NewInvalidInputError(ferrs); err != nil {
+		return model.Leaderboard{}, err
+	}
+	if limit == 0 {
+		limit = defaultLeadersLimit
+	}
+
+	q := model.LeaderQuery{Stat: stat, PerGame: per == perGame, Season: season, Phase: phase, MinGames: s.minGames, Limit: limit}
+	entries, err := s.leaders.ListLeaders(ctx, q)
+	if err != nil {
+		s.log.Error().Err(err).Str("stat", stat).Str("per", per).Msg("failed to list leaders")
+		return model.Leaderboard{}, err
+	}
+	return model.Leaderboard{Stat: stat, Per: per, Season: season, Phase: phase, MinGames: s.minGames, Leaders: entries}, nil
+}
@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/maxviazov/basketball-stats-service/internal/model"
+	"github.com/maxviazov/basketball-stats-service/internal/repository"
+	"github.com/rs/zerolog"
+)
+
+type leagueService struct {
+	leagues repository.LeagueRepository
+	log     zerolog.Logger
+}
+
+func NewLeagueService(leagues repository.LeagueRepository, logger zerolog.Logger) LeagueService {
+	l := logger.With().Str("module", "service").Str("component", "league").Logger()
+	return &leagueService{leagues: leagues, log: l}
+}
+
+func (s *leagueService) CreateLeague(ctx context.Context, name string) (model.League, error) {
+	name = strings.TrimSpace(name)
+	if ln := len([]rune(name)); ln < 2 || ln > 50 {
+		return model.League{}, NewInvalidInputError([]FieldError{{Field: "name", Message: "length must be between 2 and 50"}})
+	}
+	out, err := s.leagues.Create(ctx, model.League{Name: name})
+	if err != nil {
+		if !errors.Is(err, repository.ErrAlreadyExists) {
+			s.log.Error().Err(err).Str("name", name).Msg("create league failed")
+		}
+		return model.League{}, err
+	}
+	s.log.Info().Int64("league_id", out.ID).Str("name", out.Name).Msg("league created")
+	return out, nil
+}
+
+func (s *leagueService) GetLeague(ctx context.Context, id int64) (model.League, error) {
+	if id <= 0 {
+		return model.League{}, NewInvalidInputError([]FieldError{{Field: "league_id", Message: "must be > 0"}})
+	}
+	return s.leagues.GetByID(ctx, id)
+}
+
+func (s *leagueService) ListLeagues(ctx context.Context, page repository.Page) (repository.PageResult[model.League], error) {
+	p := normalizePage(page)
+	res, err := s.leagues.List(ctx, p)
+	if err != nil {
+		s.log.Error().Err(err).Int("limit", p.Limit).Int("offset", p.Offset).Msg("list leagues failed")
+		return repository.PageResult[model.League]{}, err
+	}
+	return res, nil
+}
@@ -0,0 +1,263 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/maxviazov/basketball-stats-service/internal/model"
+	"github.com/maxviazov/basketball-stats-service/internal/repository"
+	"github.com/rs/zerolog"
+)
+
+// unitSize is the number of players a team has on court, and so the size of a starting five and of a lineup.
+const unitSize = 5
+
+type lineupService struct {
+	lineups repository.LineupRepository
+	teams   repository.TeamRepository
+	players repository.PlayerRepository
+	games   repository.GameRepository
+	rules   repository.RuleProfileRepository
+	tx      repository.TxManager
+	log     zerolog.Logger
+}
+
+func NewLineupService(lineups repository.LineupRepository, teams repository.TeamRepository, players repository.PlayerRepository, games repository.GameRepository, rules repository.RuleProfileRepository, tx repository.TxManager, logger zerolog.Logger) LineupService {
+	l := logger.With().Str("module", "service").Str("component", "lineups").Logger()
+	return &lineupService{lineups: lineups, teams: teams, players: players, games: games, rules: rules, tx: tx, log: l}
+}
+
+// SetStarters replaces a team's starting five for a game. Starters can be named before tip-off, so the game
+// may still be scheduled; each player must be on the team's roster on the game date.
+func (s *lineupService) SetStarters(ctx context.Context, gameID, teamID int64, playerIDs []int64) ([]model.GameStarter, error) {
+	var ferrs []FieldError
+	if gameID <= 0 {
+		ferrs = append(ferrs, FieldError{Field: "game_id", Message: "must be > 0"})
+	}
+	if teamID <= 0 {
+		ferrs = append(ferrs, FieldError{Field: "team_id", Message: "must be > 0"})
+	}
+	ferrs = append(ferrs, validateUnit(playerIDs)...)
+	if err := NewInvalidInputError(ferrs); err != nil {
+		return nil, err
+	}
+	ids := slices.Sorted(slices.Values(playerIDs))
+
+	var out []model.GameStarter
+	err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		g, err := s.games.GetByID(ctx, gameID)
+		if err != nil {
+			return err
+		}
+		if err := checkUnit(ctx, s.players, g, teamID, ids); err != nil {
+			return err
+		}
+		out, err = s.lineups.ReplaceStarters(ctx, gameID, teamID, ids)
+		return err
+	})
+	if err != nil {
+		s.logFailure(err, gameID, "set starters failed")
+		return nil, err
+	}
+	s.log.Info().Int64("game_id", gameID).Int64("team_id", teamID).Msg("starters set")
+	return out, nil
+}
+
+func (s *lineupService) ListStarters(ctx context.Context, gameID int64) ([]model.GameStarter, error) {
+	if gameID <= 0 {
+		return nil, NewInvalidInputError([]FieldError{{Field: "game_id", Message: "must be > 0"}})
+	}
+	if _, err := s.games.GetByID(ctx, gameID); err != nil {
+		return nil, err
+	}
+	return s.lineups.ListStarters(ctx, gameID)
+}
+
+// RecordStint stores a stretch a five-man unit spent on court. Like events, stints are only accepted once the
+// game is under way, and the clock is checked against the period length of the game's rule profile.
+// A team has one unit on court at a time, so a stint may not overlap another of the same team and period.
+func (s *lineupService) RecordStint(ctx context.Context, st model.LineupStint) (model.LineupStint, error) {
+	if err := NewInvalidInputError(validateStint(st)); err != nil {
+		return model.LineupStint{}, err
+	}
+	st.PlayerIDs = slices.Sorted(slices.Values(st.PlayerIDs))
+
+	var out model.LineupStint
+	err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.lineups.LockGame(ctx, st.GameID); err != nil {
+			return err
+		}
+		g, err := s.games.GetByID(ctx, st.GameID)
+		if err != nil {
+			return err
+		}
+		profile, err := s.rules.GetByID(ctx, g.RuleProfileID)
+		if err != nil {
+			return err
+		}
+		var ferrs []FieldError
+		if !acceptsStats(g.Status) {
+			ferrs = append(ferrs, FieldError{Field: "game_id", Message: "game is " + g.Status + "; stints are only accepted in progress or finished"})
+		}
+		if st.StartClock > profile.PeriodSeconds(st.Period) {
+			ferrs = append(ferrs, FieldError{Field: "start_clock", Message: "must be within the period length"})
+		}
+		if err := NewInvalidInputError(ferrs); err != nil {
+			return err
+		}
+		if err := checkUnit(ctx, s.players, g, st.TeamID, st.PlayerIDs); err != nil {
+			return err
+		}
+		existing, err := s.lineups.ListStints(ctx, st.GameID)
+		if err != nil {
+			return err
+		}
+		for _, e := range existing {
+			if e.TeamID == st.TeamID && e.Period == st.Period && st.StartClock > e.EndClock && st.EndClock < e.StartClock {
+				return NewInvalidInputError([]FieldError{{Field: "start_clock", Message: "overlaps stint " + strconv.FormatInt(e.ID, 10) + " of the same team"}})
+			}
+		}
+		out, err = s.lineups.CreateStint(ctx, st)
+		return err
+	})
+	if err != nil {
+		s.logFailure(err, st.GameID, "record stint failed")
+		return model.LineupStint{}, err
+	}
+	s.log.Info().Int64("game_id", out.GameID).Int64("stint_id", out.ID).Msg("stint recorded")
+	return out, nil
+}
+
+func (s *lineupService) ListStints(ctx context.Context, gameID int64) ([]model.LineupStint, error) {
+	if gameID <= 0 {
+		return nil, NewInvalidInputError([]FieldError{{Field: "game_id", Message: "must be > 0"}})
+	}
+	if _, err := s.games.GetByID(ctx, gameID); err != nil {
+		return nil, err
+	}
+	return s.lineups.ListStints(ctx, gameID)
+}
+
+func (s *lineupService) DeleteStint(ctx context.Context, gameID, stintID int64) error {
+	var ferrs []FieldError
+	if gameID <= 0 {
+		ferrs = append(ferrs, FieldError{Field: "game_id", Message: "must be > 0"})
+	}
+	if stintID <= 0 {
+		ferrs = append(ferrs, FieldError{Field: "stint_id", Message: "must be > 0"})
+	}
+	if err := NewInvalidInputError(ferrs); err != nil {
+		return err
+	}
+	if err := s.lineups.DeleteStint(ctx, gameID, stintID); err != nil {
+		return err
+	}
+	s.log.Info().Int64("game_id", gameID).Int64("stint_id", stintID).Msg("stint deleted")
+	return nil
+}
+
+// ListTeamLineups rates every five-man unit a team used in a season. Offensive and defensive ratings are
+// points scored and allowed per 100 possessions of each side; net rating is their difference.
+func (s *lineupService) ListTeamLineups(ctx context.Context, teamID int64, season string) ([]model.LineupStats, error) {
+	season = strings.TrimSpace(season)
+	var ferrs []FieldError
+	if teamID <= 0 {
+		ferrs = append(ferrs, FieldError{Field: "team_id", Message: "must be > 0"})
+	}
+	if season == "" {
+		ferrs = append(ferrs, FieldError{Field: "season", Message: "is required"})
+	} else if !IsValidSeason(season) {
+		ferrs = append(ferrs, FieldError{Field: "season", Message: "must be in YYYY-YY format"})
+	}
+	if err := NewInvalidInputError(ferrs); err != nil {
+		return nil, err
+	}
+	exists, err := s.teams.Exists(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, repository.ErrNotFound
+	}
+	out, err := s.lineups.ListTeamLineups(ctx, teamID, season)
+	if err != nil {
+		s.log.Error().Err(err).Int64("team_id", teamID).Str("season", season).Msg("list lineups failed")
+		return nil, err
+	}
+	for i := range out {
+		l := &out[i]
+		l.Minutes = round(l.Minutes, 1)
+		l.OffensiveRating = round(100*div(float64(l.PointsFor), l.OffensivePossessions), 1)
+		l.DefensiveRating = round(100*div(float64(l.PointsAgainst), l.DefensivePossessions), 1)
+		l.NetRating = round(l.OffensiveRating-l.DefensiveRating, 1)
+		l.OffensivePossessions = round(l.OffensivePossessions, 1)
+		l.DefensivePossessions = round(l.DefensivePossessions, 1)
+	}
+	return out, nil
+}
+
+func (s *lineupService) logFailure(err error, gameID int64, msg string) {
+	if errors.Is(err, ErrInvalidInput) || errors.Is(err, repository.ErrNotFound) {
+		s.log.Debug().Err(err).Int64("game_id", gameID).Interface("field_errors", FieldErrors(err)).Msg(msg)
+		return
+	}
+	s.log.Error().Err(err).Int64("game_id", gameID).Msg(msg)
+}
+
+// validateUnit checks that player_ids names exactly five different players.
+func validateUnit(playerIDs []int64) []FieldError {
+	if len(playerIDs) != unitSize {
+		return []FieldError{{Field: "player_ids", Message: "must list exactly 5 players"}}
+	}
+	seen := make(map[int64]bool, unitSize)
+	for _, id := range playerIDs {
+		if id <= 0 {
+			return []FieldError{{Field: "player_ids", Message: "must all be > 0"}}
+		}
+		if seen[id] {
+			return []FieldError{{Field: "player_ids", Message: "must not repeat a player"}}
+		}
+		seen[id] = true
+	}
+	return nil
+}
+
+func validateStint(st model.LineupStint) []FieldError {
+	var ferrs []FieldError
+	if st.GameID <= 0 {
+		ferrs = append(ferrs, FieldError{Field: "game_id", Message: "must be > 0"})
+	}
+	if st.TeamID <= 0 {
+		ferrs = append(ferrs, FieldError{Field: "team_id", Message: "must be > 0"})
+	}
+	if st.Period < 1 {
+		ferrs = append(ferrs, FieldError{Field: "period", Message: "must be >= 1"})
+	}
+	if st.EndClock < 0 {
+		ferrs = append(ferrs, FieldError{Field: "end_clock", Message: "must be >= 0"})
+	} else if st.StartClock <= st.EndClock {
+		ferrs = append(ferrs, FieldError{Field: "start_clock", Message: "must be greater than end_clock"})
+	}
+	return append(ferrs, validateUnit(st.PlayerIDs)...)
+}
+
+// checkUnit verifies the team plays in the game and every player was on its roster on the game date.
+func checkUnit(ctx context.Context, players repository.PlayerRepository, g model.Game, teamID int64, playerIDs []int64) error {
+	if teamID != g.HomeTeamID && teamID != g.AwayTeamID {
+		return NewInvalidInputError([]FieldError{{Field: "team_id", Message: "team does not play in this game"}})
+	}
+	day := truncateToDate(g.Date.UTC())
+	for _, id := range playerIDs {
+		onTeam, err := players.TeamOn(ctx, id, day)
+		if err != nil && !errors.Is(err, repository.ErrNotFound) {
+			return err
+		}
+		if err != nil || onTeam != teamID {
+			return NewInvalidInputError([]FieldError{{Field: "player_ids", Message: "player " + strconv.FormatInt(id, 10) + " was not on the team's roster on the game date"}})
+		}
+	}
+	return nil
+}
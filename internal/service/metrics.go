@@ -0,0 +1,240 @@
+package service
+
+import (
+	"context"
+	"math"
+	"strings"
+
+	"github.com/maxviazov/basketball-stats-service/internal/model"
+	"github.com/maxviazov/basketball-stats-service/internal/repository"
+	"github.com/rs/zerolog"
+)
+
+// leagueAveragePER is the value PER is scaled to, by definition, for an average player.
+const leagueAveragePER = 15.0
+
+type metricsService struct {
+	metrics repository.MetricsRepository
+	players repository.PlayerRepository
+	log     zerolog.Logger
+}
+
+func NewMetricsService(metrics repository.MetricsRepository, players repository.PlayerRepository, logger zerolog.Logger) MetricsService {
+	l := logger.With().Str("module", "service").Str("component", "metrics").Logger()
+	return &metricsService{metrics: metrics, players: players, log: l}
+}
+
+// GetPlayerAdvancedStats computes a player's advanced metrics for one season.
+// PER is relative to the league, so the whole season is loaded to derive pace, value of possession and the
+// average PER it is scaled against. A player without lines in the season gets zeroed metrics, not an error.
+func (s *metricsService) GetPlayerAdvancedStats(ctx context.Context, playerID int64, season string) (model.PlayerAdvancedStats, error) {
+	season = strings.TrimSpace(season)
+	var ferrs []FieldError
+	if playerID <= 0 {
+		ferrs = append(ferrs, FieldError{Field: "id", Message: "must be > 0"})
+	}
+	if season == "" {
+		ferrs = append(ferrs, FieldError{Field: "season", Message: "is required"})
+	} else if !IsValidSeason(season) {
+		ferrs = append(ferrs, FieldError{Field: "season", Message: "must be in YYYY-YY format"})
+	}
+	if err := NewInvalidInputError(ferrs); err != nil {
+		return model.PlayerAdvancedStats{}, err
+	}
+
+	exists, err := s.players.Exists(ctx, playerID)
+	if err != nil {
+		s.log.Error().Err(err).Int64("player_id", playerID).Msg("failed to check player existence")
+		return model.PlayerAdvancedStats{}, err
+	}
+	if !exists {
+		return model.PlayerAdvancedStats{}, repository.ErrNotFound
+	}
+
+	totals, err := s.metrics.ListSeasonTotals(ctx, season)
+	if err != nil {
+		s.log.Error().Err(err).Str("season", season).Msg("failed to load season totals")
+		return model.PlayerAdvancedStats{}, err
+	}
+	lines, err := s.metrics.ListPlayerSeasonLines(ctx, playerID, season)
+	if err != nil {
+		s.log.Error().Err(err).Int64("player_id", playerID).Str("season", season).Msg("failed to load player lines")
+		return model.PlayerAdvancedStats{}, err
+	}
+
+	lg := newLeagueModel(totals)
+	out := model.PlayerAdvancedStats{
+		PlayerID:   playerID,
+		Season:     season,
+		GameScores: make([]model.GameScoreEntry, 0, len(lines)),
+		League: model.LeagueContext{
+			Pace:              round(lg.pace, 2),
+			ValueOfPossession: round(lg.vop, 3),
+			DefReboundPct:     round(lg.drbPct, 3),
+			AvgUnscaledPER:    round(lg.avgAPER, 3),
+		},
+	}
+	var gsSum float64
+	for _, l := range lines {
+		if !l.Played() {
+			continue // a DNP line has no game score to average
+		}
+		gs := gameScore(l.PlayerStatLine)
+		gsSum += gs
+		out.GameScores = append(out.GameScores, model.GameScoreEntry{GameID: l.GameID, GameDate: l.GameDate, GameScore: round(gs, 1)})
+	}
+	out.AvgGameScore = round(div(gsSum, float64(len(out.GameScores))), 2)
+
+	// A player traded mid-season has one row per team; team-relative metrics are minutes-weighted across them.
+	var p model.StatTotals
+	var perSum, usageSum float64
+	for _, row := range totals {
+		if row.PlayerID != playerID {
+			continue
+		}
+		p.Add(row.Player)
+		perSum += lg.per(row) * row.Player.Minutes
+		usageSum += usageRate(row.Player, row.Team) * row.Player.Minutes
+	}
+	out.GamesPlayed = p.Games
+	out.MinutesPlayed = round(p.Minutes, 1)
+	out.PER = round(div(perSum, p.Minutes), 2)
+	out.UsageRate = round(div(usageSum, p.Minutes), 2)
+	out.AssistToTurnover = round(div(float64(p.Assists), float64(p.Turnovers)), 2)
+	out.Per36 = per36(p)
+	return out, nil
+}
+
+// leagueModel holds the season-wide constants of Hollinger's PER, all derived from the season's stat lines.
+type leagueModel struct {
+	totals  model.StatTotals
+	gameMin float64 // regulation game minutes summed over the games in totals
+	pace    float64
+	vop     float64 // value of possession
+	drbPct  float64 // share of rebounds that are defensive
+	factor  float64
+	avgAPER float64 // minutes-weighted average of pace-adjusted PER
+}
+
+func newLeagueModel(rows []model.PlayerSeasonTotals) leagueModel {
+	var lg leagueModel
+	// Team totals repeat on every row of the team; count each team once.
+	seen := make(map[int64]bool)
+	for _, r := range rows {
+		if seen[r.TeamID] {
+			continue
+		}
+		seen[r.TeamID] = true
+		lg.totals.Add(r.Team)
+		lg.gameMin += r.TeamGameMinutes
+	}
+	t := lg.totals
+	lg.pace = pace(t, lg.gameMin)
+	lg.vop = div(float64(t.Points), float64(t.FieldGoalsAttempted-t.OffensiveRebounds+t.Turnovers)+0.44*float64(t.FreeThrowsAttempted))
+	lg.drbPct = div(float64(t.Rebounds-t.OffensiveRebounds), float64(t.Rebounds))
+	lg.factor = 2.0/3 - div(0.5*div(float64(t.Assists), float64(t.FieldGoalsMade)), 2*div(float64(t.FieldGoalsMade), float64(t.FreeThrowsMade)))
+
+	var weighted, minutes float64
+	for _, r := range rows {
+		weighted += lg.adjustedPER(r) * r.Player.Minutes
+		minutes += r.Player.Minutes
+	}
+	lg.avgAPER = div(weighted, minutes)
+	return lg
+}
+
+// unadjustedPER is Hollinger's uPER: a per-minute sum of weighted box score contributions.
+func (lg leagueModel) unadjustedPER(p, tm model.StatTotals) float64 {
+	if p.Minutes <= 0 {
+		return 0
+	}
+	lt := lg.totals
+	tmAstRatio := div(float64(tm.Assists), float64(tm.FieldGoalsMade))
+	foulCost := div(float64(lt.FreeThrowsMade), float64(lt.Fouls)) - 0.44*div(float64(lt.FreeThrowsAttempted), float64(lt.Fouls))*lg.vop
+
+	v := float64(p.ThreePointersMade) +
+		2.0/3*float64(p.Assists) +
+		(2-lg.factor*tmAstRatio)*float64(p.FieldGoalsMade) +
+		float64(p.FreeThrowsMade)*0.5*(1+(1-tmAstRatio)+2.0/3*tmAstRatio) -
+		lg.vop*float64(p.Turnovers) -
+		lg.vop*lg.drbPct*float64(p.FieldGoalsAttempted-p.FieldGoalsMade) -
+		lg.vop*0.44*(0.44+0.56*lg.drbPct)*float64(p.FreeThrowsAttempted-p.FreeThrowsMade) +
+		lg.vop*(1-lg.drbPct)*float64(p.DefensiveRebounds) +
+		lg.vop*lg.drbPct*float64(p.OffensiveRebounds) +
+		lg.vop*float64(p.Steals) +
+		lg.vop*lg.drbPct*float64(p.Blocks) -
+		float64(p.Fouls)*foulCost
+	return v / p.Minutes
+}
+
+// adjustedPER corrects uPER for the pace of the player's team, so fast teams do not inflate their players.
+func (lg leagueModel) adjustedPER(r model.PlayerSeasonTotals) float64 {
+	tmPace := pace(r.Team, r.TeamGameMinutes)
+	if tmPace <= 0 {
+		return 0
+	}
+	return lg.unadjustedPER(r.Player, r.Team) * lg.pace / tmPace
+}
+
+// per scales adjusted PER so the league average is 15.
+func (lg leagueModel) per(r model.PlayerSeasonTotals) float64 {
+	return div(lg.adjustedPER(r)*leagueAveragePER, lg.avgAPER)
+}
+
+// possessions is the usual box score estimate of possessions used.
+func possessions(t model.StatTotals) float64 {
+	return float64(t.FieldGoalsAttempted) + 0.44*float64(t.FreeThrowsAttempted) - float64(t.OffensiveRebounds) + float64(t.Turnovers)
+}
+
+// pace is possessions per regulation game, whose length comes from the rule profiles of the games in t
+// (gameMinutes sums it over t.Games); a team plays five player-minutes per game minute.
+func pace(t model.StatTotals, gameMinutes float64) float64 {
+	return div(div(gameMinutes, float64(t.Games))*possessions(t), t.Minutes/5)
+}
+
+// usageRate is the percentage of team plays a player finished while on the court.
+func usageRate(p, tm model.StatTotals) float64 {
+	plays := float64(p.FieldGoalsAttempted) + 0.44*float64(p.FreeThrowsAttempted) + float64(p.Turnovers)
+	tmPlays := float64(tm.FieldGoalsAttempted) + 0.44*float64(tm.FreeThrowsAttempted) + float64(tm.Turnovers)
+	return 100 * div(plays*(tm.Minutes/5), p.Minutes*tmPlays)
+}
+
+// gameScore is John Hollinger's Game Score for a single line.
+func gameScore(l model.PlayerStatLine) float64 {
+	return float64(l.Points) +
+		0.4*float64(l.FieldGoalsMade) -
+		0.7*float64(l.FieldGoalsAttempted) -
+		0.4*float64(l.FreeThrowsAttempted-l.FreeThrowsMade) +
+		0.7*float64(l.OffensiveRebounds) +
+		0.3*float64(l.DefensiveRebounds) +
+		float64(l.Steals) +
+		0.7*float64(l.Assists) +
+		0.7*float64(l.Blocks) -
+		0.4*float64(l.Fouls) -
+		float64(l.Turnovers)
+}
+
+func per36(t model.StatTotals) model.Per36Stats {
+	rate := func(v int) float64 { return round(div(float64(v)*36, t.Minutes), 1) }
+	return model.Per36Stats{
+		Points:    rate(t.Points),
+		Rebounds:  rate(t.Rebounds),
+		Assists:   rate(t.Assists),
+		Steals:    rate(t.Steals),
+		Blocks:    rate(t.Blocks),
+		Turnovers: rate(t.Turnovers),
+	}
+}
+
+// div returns 0 instead of Inf/NaN when there is nothing to divide by, e.g. a season without any minutes.
+func div(a, b float64) float64 {
+	if b == 0 {
+		return 0
+	}
+	return a / b
+}
+
+func round(v float64, places int) float64 {
+	p := math.Pow(10, float64(places))
+	return math.Round(v*p) / p
+}
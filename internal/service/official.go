@@ -0,0 +1,216 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/maxviazov/basketball-stats-service/internal/model"
+	"github.com/maxviazov/basketball-stats-service/internal/repository"
+	"github.com/rs/zerolog"
+)
+
+// Crew roles. A game has at most one crew chief; the number of referees and umpires depends on the competition.
+const (
+	roleCrewChief = "crew_chief"
+	roleReferee   = "referee"
+	roleUmpire    = "umpire"
+)
+
+type officialService struct {
+	officials repository.OfficialRepository
+	games     repository.GameRepository
+	tx        repository.TxManager
+	log       zerolog.Logger
+}
+
+func NewOfficialService(officials repository.OfficialRepository, games repository.GameRepository, tx repository.TxManager, logger zerolog.Logger) OfficialService {
+	l := logger.With().Str("module", "service").Str("component", "official").Logger()
+	return &officialService{officials: officials, games: games, tx: tx, log: l}
+}
+
+func (s *officialService) CreateOfficial(ctx context.Context, firstName, lastName string) (model.Official, error) {
+	firstName = strings.TrimSpace(firstName)
+	lastName = strings.TrimSpace(lastName)
+	var ferrs []FieldError
+	if ln := len([]rune(firstName)); ln < 1 || ln > 50 {
+		ferrs = append(ferrs, FieldError{Field: "first_name", Message: "length must be between 1 and 50"})
+	}
+	if ln := len([]rune(lastName)); ln < 1 || ln > 50 {
+		ferrs = append(ferrs, FieldError{Field: "last_name", Message: "length must be between 1 and 50"})
+	}
+	if err := NewInvalidInputError(ferrs); err != nil {
+		return model.Official{}, err
+	}
+	out, err := s.officials.Create(ctx, model.Official{FirstName: firstName, LastName: lastName})
+	if err != nil {
+		s.log.Error().Err(err).Str("first_name", firstName).Str("last_name", lastName).Msg("create official failed")
+		return model.Official{}, err
+	}
+	s.log.Info().Int64("official_id", out.ID).Msg("official created")
+	return out, nil
+}
+
+func (s *officialService) GetOfficial(ctx context.Context, id int64) (model.Official, error) {
+	if id <= 0 {
+		return model.Official{}, NewInvalidInputError([]FieldError{{Field: "id", Message: "must be > 0"}})
+	}
+	return s.officials.GetByID(ctx, id)
+}
+
+func (s *officialService) ListOfficials(ctx context.Context, page repository.Page) (repository.PageResult[model.Official], error) {
+	p := normalizePage(page)
+	res, err := s.officials.List(ctx, p)
+	if err != nil {
+		s.log.Error().Err(err).Int("limit", p.Limit).Int("offset", p.Offset).Msg("list officials failed")
+		return repository.PageResult[model.Official]{}, err
+	}
+	return res, nil
+}
+
+// AssignOfficial puts an official on a game's crew, or changes their role if they already are on it.
+// An official works one game a day (in UTC, like season boundaries) and a crew has one crew chief.
+// Both rules are checked here for a field-level error and enforced again by the database.
+func (s *officialService) AssignOfficial(ctx context.Context, gameID, officialID int64, role string) (model.GameOfficial, error) {
+	role = normalizeKeyword(role)
+	var ferrs []FieldError
+	if gameID <= 0 {
+		ferrs = append(ferrs, FieldError{Field: "game_id", Message: "must be > 0"})
+	}
+	if officialID <= 0 {
+		ferrs = append(ferrs, FieldError{Field: "official_id", Message: "must be > 0"})
+	}
+	if !isValidRole(role) {
+		ferrs = append(ferrs, FieldError{Field: "role", Message: "must be one of crew_chief|referee|umpire"})
+	}
+	if err := NewInvalidInputError(ferrs); err != nil {
+		return model.GameOfficial{}, err
+	}
+
+	var out model.GameOfficial
+	err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		g, err := s.games.GetByID(ctx, gameID)
+		if err != nil {
+			return err
+		}
+		if _, err := s.officials.GetByID(ctx, officialID); err != nil {
+			if errors.Is(err, repository.ErrNotFound) {
+				return NewInvalidInputError([]FieldError{{Field: "official_id", Message: "official does not exist"}})
+			}
+			return err
+		}
+		day := truncateToDate(g.Date.UTC())
+		booked, err := s.officials.GamesOn(ctx, officialID, day)
+		if err != nil {
+			return err
+		}
+		for _, id := range booked {
+			if id != gameID {
+				return NewInvalidInputError([]FieldError{{Field: "official_id", Message: "already assigned to game " + strconv.FormatInt(id, 10) + " on " + day.Format("2006-01-02")}})
+			}
+		}
+		if role == roleCrewChief {
+			crew, err := s.officials.ListByGame(ctx, gameID)
+			if err != nil {
+				return err
+			}
+			for _, c := range crew {
+				if c.Role == roleCrewChief && c.OfficialID != officialID {
+					return NewInvalidInputError([]FieldError{{Field: "role", Message: "game already has a crew chief"}})
+				}
+			}
+		}
+		out, err = s.officials.Assign(ctx, gameID, officialID, role)
+		return err
+	})
+	if err != nil {
+		if !errors.Is(err, ErrInvalidInput) && !errors.Is(err, repository.ErrNotFound) && !errors.Is(err, repository.ErrAlreadyExists) {
+			s.log.Error().Err(err).Int64("game_id", gameID).Int64("official_id", officialID).Msg("assign official failed")
+		}
+		return model.GameOfficial{}, err
+	}
+	s.log.Info().Int64("game_id", gameID).Int64("official_id", officialID).Str("role", role).Msg("official assigned")
+	return out, nil
+}
+
+func (s *officialService) UnassignOfficial(ctx context.Context, gameID, officialID int64) error {
+	var ferrs []FieldError
+	if gameID <= 0 {
+		ferrs = append(ferrs, FieldError{Field: "game_id", Message: "must be > 0"})
+	}
+	if officialID <= 0 {
+		ferrs = append(ferrs, FieldError{Field: "official_id", Message: "must be > 0"})
+	}
+	if err := NewInvalidInputError(ferrs); err != nil {
+		return err
+	}
+	if err := s.officials.Unassign(ctx, gameID, officialID); err != nil {
+		return err
+	}
+	s.log.Info().Int64("game_id", gameID).Int64("official_id", officialID).Msg("official unassigned")
+	return nil
+}
+
+func (s *officialService) ListGameOfficials(ctx context.Context, gameID int64) ([]model.GameOfficial, error) {
+	if gameID <= 0 {
+		return nil, NewInvalidInputError([]FieldError{{Field: "game_id", Message: "must be > 0"}})
+	}
+	if _, err := s.games.GetByID(ctx, gameID); err != nil {
+		return nil, err
+	}
+	return s.officials.ListByGame(ctx, gameID)
+}
+
+func (s *officialService) ListOfficialGames(ctx context.Context, officialID int64, page repository.Page) (repository.PageResult[model.OfficialGame], error) {
+	if officialID <= 0 {
+		return repository.PageResult[model.OfficialGame]{}, NewInvalidInputError([]FieldError{{Field: "id", Message: "must be > 0"}})
+	}
+	if _, err := s.officials.GetByID(ctx, officialID); err != nil {
+		return repository.PageResult[model.OfficialGame]{}, err
+	}
+	p := normalizePage(page)
+	res, err := s.officials.ListGames(ctx, officialID, p)
+	if err != nil {
+		s.log.Error().Err(err).Int64("official_id", officialID).Msg("list official games failed")
+		return repository.PageResult[model.OfficialGame]{}, err
+	}
+	return res, nil
+}
+
+func (s *officialService) GetOfficialSummary(ctx context.Context, officialID int64, season *string) (model.OfficialSummary, error) {
+	var ferrs []FieldError
+	if officialID <= 0 {
+		ferrs = append(ferrs, FieldError{Field: "id", Message: "must be > 0"})
+	}
+	if season != nil && !IsValidSeason(*season) {
+		ferrs = append(ferrs, FieldError{Field: "season", Message: "must be in YYYY-YY format"})
+	}
+	if err := NewInvalidInputError(ferrs); err != nil {
+		return model.OfficialSummary{}, err
+	}
+	if _, err := s.officials.GetByID(ctx, officialID); err != nil {
+		return model.OfficialSummary{}, err
+	}
+	out, err := s.officials.GetSummary(ctx, officialID, season)
+	if err != nil {
+		s.log.Error().Err(err).Int64("official_id", officialID).Msg("official summary failed")
+		return model.OfficialSummary{}, err
+	}
+	return out, nil
+}
+
+// normalizeKeyword lowercases an enum-like value and turns spaces and hyphens into underscores,
+// so "Crew Chief" and "crew-chief" both match crew_chief.
+func normalizeKeyword(v string) string {
+	v = strings.ToLower(strings.TrimSpace(v))
+	return strings.NewReplacer(" ", "_", "-", "_").Replace(v)
+}
+
+func isValidRole(role string) bool {
+	switch role {
+	case roleCrewChief, roleReferee, roleUmpire:
+		return true
+	}
+	return false
+}
@@ -2,6 +2,9 @@ package service
 
 import (
 	"context"
+	"errors"
+	"regexp"
+	"slices"
 	"strings"
 	"time"
 
@@ -13,15 +16,18 @@ import (
 type playerService struct {
 	players repository.PlayerRepository
 	teams   repository.TeamRepository
+	tx      repository.TxManager
 	log     zerolog.Logger
 }
 
-func NewPlayerService(players repository.PlayerRepository, teams repository.TeamRepository, logger zerolog.Logger) PlayerService {
+func NewPlayerService(players repository.PlayerRepository, teams repository.TeamRepository, tx repository.TxManager, logger zerolog.Logger) PlayerService {
 	l := logger.With().Str("module", "service").Str("component", "player").Logger()
-	return &playerService{players: players, teams: teams, log: l}
+	return &playerService{players: players, teams: teams, tx: tx, log: l}
 }
 
-func (s *playerService) CreatePlayer(ctx context.Context, teamID int64, firstName, lastName, position string) (model.Player, error) {
+// CreatePlayer registers a player with their optional bio. A jersey number worn by an active teammate is reported
+// as repository.ErrAlreadyExists naming jersey_number.
+func (s *playerService) CreatePlayer(ctx context.Context, teamID int64, firstName, lastName, position string, bio model.PlayerBio) (model.Player, error) {
 	start := time.Now()
 	rawFirst, rawLast, rawPos := firstName, lastName, position
 
@@ -34,6 +40,38 @@ func (s *playerService) CreatePlayer(ctx context.Context, teamID int64, firstNam
 	if teamID <= 0 {
 		ferrs = append(ferrs, FieldError{Field: "team_id", Message: "must be > 0"})
 	}
+	ferrs = append(ferrs, validatePlayerFields(firstName, lastName, position)...)
+	ferrs = append(ferrs, normalizePlayerBio(&bio, position)...)
+
+	if err := NewInvalidInputError(ferrs); err != nil {
+		s.log.Debug().Interface("field_errors", ferrs).Str("fn_raw", rawFirst).Str("ln_raw", rawLast).Str("pos_raw", rawPos).Msg("player validation failed")
+		return model.Player{}, err
+	}
+
+	// Existence check improves client UX vs deferring to FK violation.
+	if _, err := s.teams.GetByID(ctx, teamID); err != nil {
+		if err == repository.ErrNotFound { // cheap direct compare; could use errors.Is if wrapped
+			ferrs = append(ferrs, FieldError{Field: "team_id", Message: "team does not exist"})
+			return model.Player{}, NewInvalidInputError(ferrs)
+		}
+		return model.Player{}, err
+	}
+
+	out, err := s.players.Create(ctx, model.Player{TeamID: teamID, FirstName: firstName, LastName: lastName, Position: position, PlayerBio: bio})
+	if err != nil {
+		if errors.Is(err, repository.ErrAlreadyExists) {
+			return model.Player{}, err
+		}
+		s.log.Error().Err(err).Int64("team_id", teamID).Str("fn", firstName).Str("ln", lastName).Msg("create player failed")
+		return model.Player{}, err
+	}
+	s.log.Info().Dur("took", time.Since(start)).Int64("player_id", out.ID).Msg("player created")
+	return out, nil
+}
+
+// validatePlayerFields checks normalized names and position, as stored.
+func validatePlayerFields(firstName, lastName, position string) []FieldError {
+	var ferrs []FieldError
 	if firstName == "" {
 		ferrs = append(ferrs, FieldError{Field: "first_name", Message: "must not be empty"})
 	} else if ln := len([]rune(firstName)); ln > 50 {
@@ -47,27 +85,180 @@ func (s *playerService) CreatePlayer(ctx context.Context, teamID int64, firstNam
 	if !isValidPosition(position) { // after normalizePosition
 		ferrs = append(ferrs, FieldError{Field: "position", Message: "must be one of PG, SG, SF, PF, C"})
 	}
+	return ferrs
+}
+
+// Bio limits; the migration checks the same ranges.
+const (
+	minHeightCm = 120
+	maxHeightCm = 250
+	minWeightKg = 40
+	maxWeightKg = 200
+)
+
+var (
+	jerseyRe      = regexp.MustCompile(`^[0-9]{1,2}$`)
+	nationalityRe = regexp.MustCompile(`^[A-Z]{2}$`)
+)
+
+// normalizePlayerBio canonicalizes the bio in place and validates it against the primary position.
+// Zero values are stored as missing, so a patch clears a field by sending one.
+func normalizePlayerBio(b *model.PlayerBio, position string) []FieldError {
+	var ferrs []FieldError
+	if b.JerseyNumber != nil {
+		v := strings.TrimSpace(*b.JerseyNumber)
+		b.JerseyNumber = &v
+		switch {
+		case v == "":
+			b.JerseyNumber = nil
+		case !jerseyRe.MatchString(v):
+			ferrs = append(ferrs, FieldError{Field: "jersey_number", Message: "must be one or two digits, e.g. 0, 00 or 23"})
+		}
+	}
+	if b.HeightCm != nil {
+		if *b.HeightCm == 0 {
+			b.HeightCm = nil
+		} else if *b.HeightCm < minHeightCm || *b.HeightCm > maxHeightCm {
+			ferrs = append(ferrs, FieldError{Field: "height_cm", Message: "must be between 120 and 250"})
+		}
+	}
+	if b.WeightKg != nil {
+		if *b.WeightKg == 0 {
+			b.WeightKg = nil
+		} else if *b.WeightKg < minWeightKg || *b.WeightKg > maxWeightKg {
+			ferrs = append(ferrs, FieldError{Field: "weight_kg", Message: "must be between 40 and 200"})
+		}
+	}
+	if b.BirthDate != nil {
+		if b.BirthDate.IsZero() {
+			b.BirthDate = nil
+		} else {
+			d := truncateToDate(b.BirthDate.UTC())
+			b.BirthDate = &d
+			if !d.Before(truncateToDate(time.Now().UTC())) {
+				ferrs = append(ferrs, FieldError{Field: "birth_date", Message: "must be in the past"})
+			}
+		}
+	}
+	if b.Nationality != nil {
+		v := strings.ToUpper(strings.TrimSpace(*b.Nationality))
+		b.Nationality = &v
+		switch {
+		case v == "":
+			b.Nationality = nil
+		case !nationalityRe.MatchString(v):
+			ferrs = append(ferrs, FieldError{Field: "nationality", Message: "must be an ISO 3166-1 alpha-2 country code"})
+		}
+	}
+	if b.Handedness != nil {
+		v := normalizeKeyword(*b.Handedness)
+		b.Handedness = &v
+		switch {
+		case v == "":
+			b.Handedness = nil
+		case v != "left" && v != "right":
+			ferrs = append(ferrs, FieldError{Field: "handedness", Message: "must be one of left|right"})
+		}
+	}
+	secondary := make([]string, 0, len(b.SecondaryPositions))
+	for _, pos := range b.SecondaryPositions {
+		pos = normalizePosition(pos)
+		if !isValidPosition(pos) || pos == position || slices.Contains(secondary, pos) {
+			ferrs = append(ferrs, FieldError{Field: "secondary_positions", Message: "must be distinct positions of PG, SG, SF, PF, C other than the primary one"})
+			break
+		}
+		secondary = append(secondary, pos)
+	}
+	b.SecondaryPositions = secondary
+	return ferrs
+}
 
+// applyBioPatch merges the bio fields of a patch; a zero value clears the field and is dropped by normalizePlayerBio.
+func applyBioPatch(b *model.PlayerBio, patch model.PlayerPatch) {
+	if patch.JerseyNumber != nil {
+		b.JerseyNumber = patch.JerseyNumber
+	}
+	if patch.HeightCm != nil {
+		b.HeightCm = patch.HeightCm
+	}
+	if patch.WeightKg != nil {
+		b.WeightKg = patch.WeightKg
+	}
+	if patch.BirthDate != nil {
+		b.BirthDate = patch.BirthDate
+	}
+	if patch.Nationality != nil {
+		b.Nationality = patch.Nationality
+	}
+	if patch.Handedness != nil {
+		b.Handedness = patch.Handedness
+	}
+	if patch.SecondaryPositions != nil {
+		b.SecondaryPositions = *patch.SecondaryPositions
+	}
+}
+
+// UpdatePlayer applies a partial update of the name, position and bio. The patch is merged into the stored
+// player first, so the result is validated as a whole.
+func (s *playerService) UpdatePlayer(ctx context.Context, id int64, patch model.PlayerPatch) (model.Player, error) {
+	if id <= 0 {
+		return model.Player{}, NewInvalidInputError([]FieldError{{Field: "id", Message: "must be > 0"}})
+	}
+	player, err := s.players.GetByID(ctx, id)
+	if err != nil {
+		return model.Player{}, err
+	}
+	if patch == (model.PlayerPatch{}) {
+		return player, nil
+	}
+	if patch.FirstName != nil {
+		player.FirstName = strings.TrimSpace(*patch.FirstName)
+	}
+	if patch.LastName != nil {
+		player.LastName = strings.TrimSpace(*patch.LastName)
+	}
+	if patch.Position != nil {
+		player.Position = normalizePosition(*patch.Position)
+	}
+	applyBioPatch(&player.PlayerBio, patch)
+	ferrs := validatePlayerFields(player.FirstName, player.LastName, player.Position)
+	ferrs = append(ferrs, normalizePlayerBio(&player.PlayerBio, player.Position)...)
 	if err := NewInvalidInputError(ferrs); err != nil {
-		s.log.Debug().Interface("field_errors", ferrs).Str("fn_raw", rawFirst).Str("ln_raw", rawLast).Str("pos_raw", rawPos).Msg("player validation failed")
 		return model.Player{}, err
 	}
 
-	// Existence check improves client UX vs deferring to FK violation.
-	if _, err := s.teams.GetByID(ctx, teamID); err != nil {
-		if err == repository.ErrNotFound { // cheap direct compare; could use errors.Is if wrapped
-			ferrs = append(ferrs, FieldError{Field: "team_id", Message: "team does not exist"})
-			return model.Player{}, NewInvalidInputError(ferrs)
+	out, err := s.players.Update(ctx, player)
+	if err != nil {
+		if !errors.Is(err, repository.ErrNotFound) && !errors.Is(err, repository.ErrAlreadyExists) {
+			s.log.Error().Err(err).Int64("player_id", id).Msg("update player failed")
 		}
 		return model.Player{}, err
 	}
+	s.log.Info().Int64("player_id", id).Msg("player updated")
+	return out, nil
+}
+
+// DeletePlayer soft-deletes a player; their stat lines stay stored but no longer count anywhere.
+func (s *playerService) DeletePlayer(ctx context.Context, id int64) error {
+	if id <= 0 {
+		return NewInvalidInputError([]FieldError{{Field: "id", Message: "must be > 0"}})
+	}
+	if err := s.players.Delete(ctx, id); err != nil {
+		return err
+	}
+	s.log.Info().Int64("player_id", id).Msg("player deleted")
+	return nil
+}
 
-	out, err := s.players.Create(ctx, model.Player{TeamID: teamID, FirstName: firstName, LastName: lastName, Position: position})
+func (s *playerService) RestorePlayer(ctx context.Context, id int64) (model.Player, error) {
+	if id <= 0 {
+		return model.Player{}, NewInvalidInputError([]FieldError{{Field: "id", Message: "must be > 0"}})
+	}
+	out, err := s.players.Restore(ctx, id)
 	if err != nil {
-		s.log.Error().Err(err).Int64("team_id", teamID).Str("fn", firstName).Str("ln", lastName).Msg("create player failed")
 		return model.Player{}, err
 	}
-	s.log.Info().Dur("took", time.Since(start)).Int64("player_id", out.ID).Msg("player created")
+	s.log.Info().Int64("player_id", id).Msg("player restored")
 	return out, nil
 }
 
@@ -78,12 +269,12 @@ func (s *playerService) GetPlayer(ctx context.Context, id int64) (model.Player,
 	return s.players.GetByID(ctx, id)
 }
 
-func (s *playerService) ListPlayersByTeam(ctx context.Context, teamID int64, page repository.Page) (repository.PageResult[model.Player], error) {
+func (s *playerService) ListPlayersByTeam(ctx context.Context, teamID int64, asOf *time.Time, page repository.Page) (repository.PageResult[model.Player], error) {
 	if teamID <= 0 {
 		return repository.PageResult[model.Player]{}, NewInvalidInputError([]FieldError{{Field: "team_id", Message: "must be > 0"}})
 	}
 	p := normalizePage(page)
-	res, err := s.players.ListByTeam(ctx, teamID, p)
+	res, err := s.players.ListByTeam(ctx, teamID, asOf, p)
 	if err != nil {
 		s.log.Error().Err(err).Int64("team_id", teamID).Int("limit", p.Limit).Int("offset", p.Offset).Msg("list players failed")
 		return repository.PageResult[model.Player]{}, err
@@ -93,7 +284,7 @@ func (s *playerService) ListPlayersByTeam(ctx context.Context, teamID int64, pag
 
 // GetPlayerAggregatedStats retrieves and validates parameters for fetching player statistics.
 // It ensures the player ID is valid and the season format is correct if provided.
-func (s *playerService) GetPlayerAggregatedStats(ctx context.Context, playerID int64, season *string) (model.PlayerAggregatedStats, error) {
+func (s *playerService) GetPlayerAggregatedStats(ctx context.Context, playerID int64, season, phase *string) (model.PlayerAggregatedStats, error) {
 	var ferrs []FieldError
 	if playerID <= 0 {
 		ferrs = append(ferrs, FieldError{Field: "id", Message: "must be > 0"})
@@ -101,6 +292,8 @@ func (s *playerService) GetPlayerAggregatedStats(ctx context.Context, playerID i
 	if season != nil && !IsValidSeason(*season) {
 		ferrs = append(ferrs, FieldError{Field: "season", Message: "must be in YYYY-YY format"})
 	}
+	phase, phaseErrs := validatePhaseFilter(phase)
+	ferrs = append(ferrs, phaseErrs...)
 	if err := NewInvalidInputError(ferrs); err != nil {
 		return model.PlayerAggregatedStats{}, err
 	}
@@ -115,7 +308,7 @@ func (s *playerService) GetPlayerAggregatedStats(ctx context.Context, playerID i
 		return model.PlayerAggregatedStats{}, repository.ErrNotFound
 	}
 
-	stats, err := s.players.GetPlayerAggregatedStats(ctx, playerID, season)
+	stats, err := s.players.GetPlayerAggregatedStats(ctx, playerID, season, phase)
 	if err != nil {
 		s.log.Error().Err(err).Int64("player_id", playerID).Msg("failed to get player aggregated stats")
 		return model.PlayerAggregatedStats{}, err
@@ -123,3 +316,121 @@ func (s *playerService) GetPlayerAggregatedStats(ctx context.Context, playerID i
 
 	return stats, nil
 }
+
+// GetPlayerSplits returns the player's aggregates per bucket of each requested split dimension.
+func (s *playerService) GetPlayerSplits(ctx context.Context, playerID int64, season, phase *string, dimensions []string) (model.PlayerSplits, error) {
+	var ferrs []FieldError
+	if playerID <= 0 {
+		ferrs = append(ferrs, FieldError{Field: "id", Message: "must be > 0"})
+	}
+	if season != nil && !IsValidSeason(*season) {
+		ferrs = append(ferrs, FieldError{Field: "season", Message: "must be in YYYY-YY format"})
+	}
+	phase, phaseErrs := validatePhaseFilter(phase)
+	ferrs = append(ferrs, phaseErrs...)
+	dimensions, dimErrs := validateSplitDimensions(dimensions)
+	ferrs = append(ferrs, dimErrs...)
+	if err := NewInvalidInputError(ferrs); err != nil {
+		return model.PlayerSplits{}, err
+	}
+
+	exists, err := s.players.Exists(ctx, playerID)
+	if err != nil {
+		s.log.Error().Err(err).Int64("player_id", playerID).Msg("failed to check player existence")
+		return model.PlayerSplits{}, err
+	}
+	if !exists {
+		return model.PlayerSplits{}, repository.ErrNotFound
+	}
+
+	splits, err := s.players.GetPlayerSplits(ctx, playerID, season, phase, dimensions)
+	if err != nil {
+		s.log.Error().Err(err).Int64("player_id", playerID).Msg("failed to get player splits")
+		return model.PlayerSplits{}, err
+	}
+	return model.PlayerSplits{PlayerID: playerID, Season: season, Phase: phase, Splits: splits}, nil
+}
+
+// TransferPlayer moves a player to another team from the given date on.
+// Stat lines of games before that date stay with the previous team; later ones follow the player.
+func (s *playerService) TransferPlayer(ctx context.Context, playerID, teamID int64, on time.Time) (model.Membership, error) {
+	var ferrs []FieldError
+	if playerID <= 0 {
+		ferrs = append(ferrs, FieldError{Field: "id", Message: "must be > 0"})
+	}
+	if teamID <= 0 {
+		ferrs = append(ferrs, FieldError{Field: "team_id", Message: "must be > 0"})
+	}
+	if on.IsZero() {
+		ferrs = append(ferrs, FieldError{Field: "date", Message: "is required"})
+	}
+	if err := NewInvalidInputError(ferrs); err != nil {
+		return model.Membership{}, err
+	}
+	on = truncateToDate(on)
+
+	var out model.Membership
+	err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if _, err := s.players.GetByID(ctx, playerID); err != nil {
+			return err
+		}
+		if _, err := s.teams.GetByID(ctx, teamID); err != nil {
+			if errors.Is(err, repository.ErrNotFound) {
+				return NewInvalidInputError([]FieldError{{Field: "team_id", Message: "team does not exist"}})
+			}
+			return err
+		}
+		history, err := s.players.ListMemberships(ctx, playerID)
+		if err != nil {
+			return err
+		}
+		if err := validateTransfer(history, teamID, on); err != nil {
+			return err
+		}
+		out, err = s.players.Transfer(ctx, playerID, teamID, on)
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, ErrInvalidInput) || errors.Is(err, repository.ErrNotFound) {
+			s.log.Debug().Err(err).Int64("player_id", playerID).Interface("field_errors", FieldErrors(err)).Msg("transfer rejected")
+		} else {
+			s.log.Error().Err(err).Int64("player_id", playerID).Int64("team_id", teamID).Msg("transfer failed")
+		}
+		return model.Membership{}, err
+	}
+	s.log.Info().Int64("player_id", playerID).Int64("team_id", teamID).Time("date", on).Msg("player transferred")
+	return out, nil
+}
+
+func (s *playerService) ListMemberships(ctx context.Context, playerID int64) ([]model.Membership, error) {
+	if playerID <= 0 {
+		return nil, NewInvalidInputError([]FieldError{{Field: "id", Message: "must be > 0"}})
+	}
+	if _, err := s.players.GetByID(ctx, playerID); err != nil {
+		return nil, err
+	}
+	return s.players.ListMemberships(ctx, playerID)
+}
+
+// validateTransfer only allows appending to the history: the date must fall after the start of the
+// current membership so periods never overlap, and the player must actually change teams.
+func validateTransfer(history []model.Membership, teamID int64, on time.Time) error {
+	for _, m := range history {
+		if m.EndDate != nil {
+			continue
+		}
+		if m.TeamID == teamID {
+			return NewInvalidInputError([]FieldError{{Field: "team_id", Message: "player already plays for this team"}})
+		}
+		if m.StartDate != nil && !on.After(*m.StartDate) {
+			return NewInvalidInputError([]FieldError{{Field: "date", Message: "must be after the start of the current membership"}})
+		}
+	}
+	return nil
+}
+
+// truncateToDate drops the time of day; memberships are tracked with day granularity.
+func truncateToDate(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,136 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/maxviazov/basketball-stats-service/internal/model"
+	"github.com/maxviazov/basketball-stats-service/internal/repository"
+	"github.com/rs/zerolog"
+)
+
+// Bounds for a custom rule profile. They only keep obvious typos out; real competitions sit well inside them.
+const (
+	maxPeriodMinutes = 30
+	maxPeriods       = 8
+	minRosterSize    = 5
+	maxRosterSize    = 20
+)
+
+type ruleProfileService struct {
+	rules repository.RuleProfileRepository
+	log   zerolog.Logger
+}
+
+func NewRuleProfileService(rules repository.RuleProfileRepository, logger zerolog.Logger) RuleProfileService {
+	l := logger.With().Str("module", "service").Str("component", "rules").Logger()
+	return &ruleProfileService{rules: rules, log: l}
+}
+
+func (s *ruleProfileService) CreateRuleProfile(ctx context.Context, p model.RuleProfile) (model.RuleProfile, error) {
+	p.Name = strings.ToLower(strings.TrimSpace(p.Name))
+	if err := NewInvalidInputError(validateRuleProfile(p)); err != nil {
+		return model.RuleProfile{}, err
+	}
+	out, err := s.rules.Create(ctx, p)
+	if err != nil {
+		if !errors.Is(err, repository.ErrAlreadyExists) {
+			s.log.Error().Err(err).Str("name", p.Name).Msg("create rule profile failed")
+		}
+		return model.RuleProfile{}, err
+	}
+	s.log.Info().Int64("rule_profile_id", out.ID).Str("name", out.Name).Msg("rule profile created")
+	return out, nil
+}
+
+func (s *ruleProfileService) GetRuleProfile(ctx context.Context, id int64) (model.RuleProfile, error) {
+	if id <= 0 {
+		return model.RuleProfile{}, NewInvalidInputError([]FieldError{{Field: "id", Message: "must be > 0"}})
+	}
+	return s.rules.GetByID(ctx, id)
+}
+
+func (s *ruleProfileService) ListRuleProfiles(ctx context.Context, page repository.Page) (repository.PageResult[model.RuleProfile], error) {
+	p := normalizePage(page)
+	res, err := s.rules.List(ctx, p)
+	if err != nil {
+		s.log.Error().Err(err).Int("limit", p.Limit).Int("offset", p.Offset).Msg("list rule profiles failed")
+		return repository.PageResult[model.RuleProfile]{}, err
+	}
+	return res, nil
+}
+
+func validateRuleProfile(p model.RuleProfile) []FieldError {
+	var ferrs []FieldError
+	if p.Name == "" {
+		ferrs = append(ferrs, FieldError{Field: "name", Message: "must not be empty"})
+	}
+	if p.PeriodMinutes < 1 || p.PeriodMinutes > maxPeriodMinutes {
+		ferrs = append(ferrs, FieldError{Field: "period_minutes", Message: "must be between 1 and 30"})
+	}
+	if p.Periods < 1 || p.Periods > maxPeriods {
+		ferrs = append(ferrs, FieldError{Field: "periods", Message: "must be between 1 and 8"})
+	}
+	if p.OvertimeMinutes < 1 || p.OvertimeMinutes > p.PeriodMinutes {
+		ferrs = append(ferrs, FieldError{Field: "overtime_minutes", Message: "must be between 1 and period_minutes"})
+	}
+	if p.FoulLimit < 1 {
+		ferrs = append(ferrs, FieldError{Field: "foul_limit", Message: "must be > 0"})
+	}
+	if p.RosterSize < minRosterSize || p.RosterSize > maxRosterSize {
+		ferrs = append(ferrs, FieldError{Field: "roster_size", Message: "must be between 5 and 20"})
+	}
+	return ferrs
+}
+
+// loadGameRules returns the rule profile of a game and the number of overtimes played so far, counted from
+// its line score and its events like the player_stats rules trigger does.
+func loadGameRules(ctx context.Context, rules repository.RuleProfileRepository, games repository.GameRepository, g model.Game) (model.RuleProfile, int, error) {
+	profile, err := rules.GetByID(ctx, g.RuleProfileID)
+	if err != nil {
+		return model.RuleProfile{}, 0, err
+	}
+	played, err := games.CountPeriodsPlayed(ctx, g.ID)
+	if err != nil {
+		return model.RuleProfile{}, 0, err
+	}
+	return profile, max(played-profile.Periods, 0), nil
+}
+
+// validateStatLineRules checks the limits of a line that depend on how the game is played.
+func validateStatLineRules(line model.PlayerStatLine, profile model.RuleProfile, overtimes int) []FieldError {
+	var ferrs []FieldError
+	if line.Fouls > profile.FoulLimit {
+		ferrs = append(ferrs, FieldError{Field: "fouls", Message: "must be <= " + strconv.Itoa(profile.FoulLimit) + " under " + profile.Name + " rules"})
+	}
+	if limit := profile.MaxMinutes(overtimes); float64(line.MinutesPlayed) > limit {
+		ferrs = append(ferrs, FieldError{Field: "minutes_played", Message: "must be <= " + strconv.FormatFloat(limit, 'f', 1, 64) + " for this game"})
+	}
+	return ferrs
+}
+
+// checkRosterSize rejects a line that would give a team more players in the game than its roster size allows.
+// Players who already have a line do not count twice, so corrections always pass.
+func checkRosterSize(ctx context.Context, games repository.GameRepository, g model.Game, teamID, playerID int64, profile model.RuleProfile) error {
+	box, err := games.GetBoxScore(ctx, g.ID)
+	if err != nil {
+		return err
+	}
+	side := box.Home
+	if teamID == g.AwayTeamID {
+		side = box.Away
+	}
+	others := 0
+	for _, l := range side.Players {
+		if l.PlayerID == playerID {
+			return nil
+		}
+		others++
+	}
+	if others >= profile.RosterSize {
+		return NewInvalidInputError([]FieldError{{Field: "player_id", Message: "team already has " + strconv.Itoa(profile.RosterSize) + " players in this game"}})
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/maxviazov/basketball-stats-service/internal/model"
+	"github.com/maxviazov/basketball-stats-service/internal/repository"
+	"github.com/rs/zerolog"
+)
+
+// Query length bounds for search. Trigram matching needs a few characters to be meaningful.
+const (
+	minSearchQuery = 2
+	maxSearchQuery = 100
+)
+
+type searchService struct {
+	search repository.SearchRepository
+	log    zerolog.Logger
+}
+
+func NewSearchService(search repository.SearchRepository, logger zerolog.Logger) SearchService {
+	l := logger.With().Str("module", "service").Str("component", "search").Logger()
+	return &searchService{search: search, log: l}
+}
+
+func (s *searchService) Search(ctx context.Context, q string, kind *string, page repository.Page) (repository.PageResult[model.SearchResult], error) {
+	q = strings.Join(strings.Fields(q), " ")
+	var ferrs []FieldError
+	if n := utf8.RuneCountInString(q); n < minSearchQuery || n > maxSearchQuery {
+		ferrs = append(ferrs, FieldError{Field: "q", Message: "must be between 2 and 100 characters"})
+	}
+	if kind != nil {
+		k := strings.ToLower(strings.TrimSpace(*kind))
+		if k != "team" && k != "player" {
+			ferrs = append(ferrs, FieldError{Field: "type", Message: "must be one of team|player"})
+		}
+		kind = &k
+	}
+	if err := NewInvalidInputError(ferrs); err != nil {
+		return repository.PageResult[model.SearchResult]{}, err
+	}
+
+	p := normalizePage(page)
+	res, err := s.search.Search(ctx, q, kind, p)
+	if err != nil {
+		s.log.Error().Err(err).Str("q", q).Msg("search failed")
+		return repository.PageResult[model.SearchResult]{}, err
+	}
+	return res, nil
+}
@@ -0,0 +1,129 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/maxviazov/basketball-stats-service/internal/model"
+	"github.com/maxviazov/basketball-stats-service/internal/repository"
+	"github.com/rs/zerolog"
+)
+
+type seasonService struct {
+	seasons repository.SeasonRepository
+	tx      repository.TxManager
+	log     zerolog.Logger
+}
+
+func NewSeasonService(seasons repository.SeasonRepository, tx repository.TxManager, logger zerolog.Logger) SeasonService {
+	l := logger.With().Str("module", "service").Str("component", "season").Logger()
+	return &seasonService{seasons: seasons, tx: tx, log: l}
+}
+
+// CreateSeason validates and stores a season. Without explicit phases the whole season is a single regular phase.
+func (s *seasonService) CreateSeason(ctx context.Context, in model.Season) (model.Season, error) {
+	in.Name = strings.TrimSpace(in.Name)
+	in.StartDate = truncateToDate(in.StartDate)
+	in.EndDate = truncateToDate(in.EndDate)
+	if len(in.Phases) == 0 {
+		in.Phases = []model.SeasonPhase{{Phase: phaseRegular, StartDate: in.StartDate, EndDate: in.EndDate}}
+	}
+	for i := range in.Phases {
+		in.Phases[i].Phase = normalizePhase(in.Phases[i].Phase)
+		in.Phases[i].StartDate = truncateToDate(in.Phases[i].StartDate)
+		in.Phases[i].EndDate = truncateToDate(in.Phases[i].EndDate)
+	}
+	if err := NewInvalidInputError(validateSeason(in)); err != nil {
+		s.log.Debug().Interface("field_errors", FieldErrors(err)).Str("name", in.Name).Msg("season validation failed")
+		return model.Season{}, err
+	}
+
+	var out model.Season
+	err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		created, err := s.seasons.Create(ctx, in)
+		if err != nil {
+			return err
+		}
+		out = created
+		return nil
+	})
+	if err != nil {
+		if !errors.Is(err, repository.ErrAlreadyExists) {
+			s.log.Error().Err(err).Str("name", in.Name).Msg("create season failed")
+		}
+		return model.Season{}, err
+	}
+	s.log.Info().Int64("season_id", out.ID).Str("name", out.Name).Msg("season created")
+	return out, nil
+}
+
+func (s *seasonService) GetSeason(ctx context.Context, id int64) (model.Season, error) {
+	if id <= 0 {
+		return model.Season{}, NewInvalidInputError([]FieldError{{Field: "id", Message: "must be > 0"}})
+	}
+	return s.seasons.GetByID(ctx, id)
+}
+
+func (s *seasonService) ListSeasons(ctx context.Context, page repository.Page) (repository.PageResult[model.Season], error) {
+	p := normalizePage(page)
+	res, err := s.seasons.List(ctx, p)
+	if err != nil {
+		s.log.Error().Err(err).Int("limit", p.Limit).Int("offset", p.Offset).Msg("list seasons failed")
+		return repository.PageResult[model.Season]{}, err
+	}
+	return res, nil
+}
+
+// validateSeason checks the season bounds and that phases are known, unique, inside the season and do not overlap.
+func validateSeason(in model.Season) []FieldError {
+	var ferrs []FieldError
+	if !IsValidSeason(in.Name) {
+		ferrs = append(ferrs, FieldError{Field: "name", Message: "must be in YYYY-YY format"})
+	}
+	if in.RuleProfileID < 0 {
+		ferrs = append(ferrs, FieldError{Field: "rule_profile_id", Message: "must be > 0"})
+	}
+	if in.StartDate.IsZero() || in.EndDate.IsZero() {
+		ferrs = append(ferrs, FieldError{Field: "dates", Message: "start_date and end_date are required"})
+		return ferrs
+	}
+	if in.EndDate.Before(in.StartDate) {
+		ferrs = append(ferrs, FieldError{Field: "end_date", Message: "must not be before start_date"})
+	}
+	if len(ferrs) == 0 {
+		if startYear, _ := strconv.Atoi(in.Name[:4]); in.StartDate.Year() != startYear {
+			ferrs = append(ferrs, FieldError{Field: "start_date", Message: "must fall in the first year of the season name"})
+		}
+	}
+
+	seen := make(map[string]bool, len(in.Phases))
+	phases := make([]model.SeasonPhase, 0, len(in.Phases))
+	for _, p := range in.Phases {
+		switch {
+		case !isValidPhase(p.Phase):
+			ferrs = append(ferrs, FieldError{Field: "phases", Message: "phase must be one of preseason|regular|playoffs"})
+			continue
+		case seen[p.Phase]:
+			ferrs = append(ferrs, FieldError{Field: "phases", Message: "phase " + p.Phase + " is listed twice"})
+			continue
+		case p.EndDate.Before(p.StartDate):
+			ferrs = append(ferrs, FieldError{Field: "phases", Message: "phase " + p.Phase + " ends before it starts"})
+			continue
+		case p.StartDate.Before(in.StartDate) || p.EndDate.After(in.EndDate):
+			ferrs = append(ferrs, FieldError{Field: "phases", Message: "phase " + p.Phase + " must fall inside the season"})
+			continue
+		}
+		seen[p.Phase] = true
+		phases = append(phases, p)
+	}
+	sort.Slice(phases, func(i, j int) bool { return phases[i].StartDate.Before(phases[j].StartDate) })
+	for i := 1; i < len(phases); i++ {
+		if !phases[i].StartDate.After(phases[i-1].EndDate) {
+			ferrs = append(ferrs, FieldError{Field: "phases", Message: "phases " + phases[i-1].Phase + " and " + phases[i].Phase + " overlap"})
+		}
+	}
+	return ferrs
+}
@@ -52,29 +52,204 @@ func FieldErrors(err error) []FieldError {
 
 // TeamService defines team-oriented use cases.
 type TeamService interface {
-	CreateTeam(ctx context.Context, name string) (model.Team, error)
+	// CreateTeam stores a team with a name and an optional home venue.
+	CreateTeam(ctx context.Context, t model.Team) (model.Team, error)
 	GetTeam(ctx context.Context, id int64) (model.Team, error)
 	ListTeams(ctx context.Context, page repository.Page) (repository.PageResult[model.Team], error)
-	GetTeamAggregatedStats(ctx context.Context, teamID int64, season *string) (model.TeamAggregatedStats, error)
+	UpdateTeam(ctx context.Context, id int64, patch model.TeamPatch) (model.Team, error)
+	// DeleteTeam soft-deletes a team; RestoreTeam brings it back with everything that references it.
+	DeleteTeam(ctx context.Context, id int64) error
+	RestoreTeam(ctx context.Context, id int64) (model.Team, error)
+	GetTeamAggregatedStats(ctx context.Context, teamID int64, season, phase *string) (model.TeamAggregatedStats, error)
+	// GetTeamSplits breaks a team's record down by split dimensions; no dimensions means all of them.
+	GetTeamSplits(ctx context.Context, teamID int64, season, phase *string, dimensions []string) (model.TeamSplits, error)
+	// GetAttendance reports average home attendance per team and season, optionally for one season or team.
+	GetAttendance(ctx context.Context, season *string, teamID *int64) ([]model.TeamAttendance, error)
+}
+
+// StandingsService builds league tables.
+type StandingsService interface {
+	// GetStandings ranks every team in a season phase, the regular season when phase is nil.
+	GetStandings(ctx context.Context, season string, phase *string) (model.Standings, error)
 }
 
 // PlayerService defines player-oriented use cases.
 type PlayerService interface {
-	CreatePlayer(ctx context.Context, teamID int64, firstName, lastName, position string) (model.Player, error)
+	// CreatePlayer registers a player; every bio field is optional.
+	CreatePlayer(ctx context.Context, teamID int64, firstName, lastName, position string, bio model.PlayerBio) (model.Player, error)
 	GetPlayer(ctx context.Context, id int64) (model.Player, error)
-	ListPlayersByTeam(ctx context.Context, teamID int64, page repository.Page) (repository.PageResult[model.Player], error)
-	GetPlayerAggregatedStats(ctx context.Context, playerID int64, season *string) (model.PlayerAggregatedStats, error)
+	// UpdatePlayer changes a player's name, position or bio; teams only change through TransferPlayer.
+	UpdatePlayer(ctx context.Context, id int64, patch model.PlayerPatch) (model.Player, error)
+	// DeletePlayer soft-deletes a player; RestorePlayer brings them back with their stat lines.
+	DeletePlayer(ctx context.Context, id int64) error
+	RestorePlayer(ctx context.Context, id int64) (model.Player, error)
+	// ListPlayersByTeam returns the current roster, or the roster on asOf when it is set.
+	ListPlayersByTeam(ctx context.Context, teamID int64, asOf *time.Time, page repository.Page) (repository.PageResult[model.Player], error)
+	GetPlayerAggregatedStats(ctx context.Context, playerID int64, season, phase *string) (model.PlayerAggregatedStats, error)
+	// GetPlayerSplits breaks a player's aggregates down by split dimensions; no dimensions means all of them.
+	GetPlayerSplits(ctx context.Context, playerID int64, season, phase *string, dimensions []string) (model.PlayerSplits, error)
+	// TransferPlayer moves a player to another team starting on the given date; their jersey number stays behind.
+	TransferPlayer(ctx context.Context, playerID, teamID int64, on time.Time) (model.Membership, error)
+	ListMemberships(ctx context.Context, playerID int64) ([]model.Membership, error)
+}
+
+// SeasonService defines season use cases.
+type SeasonService interface {
+	CreateSeason(ctx context.Context, s model.Season) (model.Season, error)
+	GetSeason(ctx context.Context, id int64) (model.Season, error)
+	ListSeasons(ctx context.Context, page repository.Page) (repository.PageResult[model.Season], error)
+}
+
+// LeagueService defines use cases for leagues. Every other service works inside the league
+// its context is scoped to with repository.WithLeague.
+type LeagueService interface {
+	CreateLeague(ctx context.Context, name string) (model.League, error)
+	GetLeague(ctx context.Context, id int64) (model.League, error)
+	ListLeagues(ctx context.Context, page repository.Page) (repository.PageResult[model.League], error)
+}
+
+// VenueService defines use cases for the venues games are played in.
+type VenueService interface {
+	CreateVenue(ctx context.Context, v model.Venue) (model.Venue, error)
+	GetVenue(ctx context.Context, id int64) (model.Venue, error)
+	ListVenues(ctx context.Context, page repository.Page) (repository.PageResult[model.Venue], error)
+	UpdateVenue(ctx context.Context, id int64, patch model.VenuePatch) (model.Venue, error)
+	// DeleteVenue removes a venue no team or game refers to.
+	DeleteVenue(ctx context.Context, id int64) error
+}
+
+// OfficialService defines use cases for a league's officials and the crews working its games.
+type OfficialService interface {
+	CreateOfficial(ctx context.Context, firstName, lastName string) (model.Official, error)
+	GetOfficial(ctx context.Context, id int64) (model.Official, error)
+	ListOfficials(ctx context.Context, page repository.Page) (repository.PageResult[model.Official], error)
+	// AssignOfficial puts an official on a game's crew, or changes their role there.
+	AssignOfficial(ctx context.Context, gameID, officialID int64, role string) (model.GameOfficial, error)
+	UnassignOfficial(ctx context.Context, gameID, officialID int64) error
+	ListGameOfficials(ctx context.Context, gameID int64) ([]model.GameOfficial, error)
+	ListOfficialGames(ctx context.Context, officialID int64, page repository.Page) (repository.PageResult[model.OfficialGame], error)
+	// GetOfficialSummary averages total fouls per finished game the official worked.
+	GetOfficialSummary(ctx context.Context, officialID int64, season *string) (model.OfficialSummary, error)
+}
+
+// StaffService defines use cases for the coaches and trainers of a league and their tenures with teams.
+type StaffService interface {
+	CreateStaff(ctx context.Context, firstName, lastName string) (model.Staff, error)
+	GetStaff(ctx context.Context, id int64) (model.Staff, error)
+	ListStaff(ctx context.Context, page repository.Page) (repository.PageResult[model.Staff], error)
+	// AddTenure puts a staff member on a team's staff in a role from a start date, optionally up to an end date.
+	AddTenure(ctx context.Context, t model.StaffTenure) (model.StaffTenure, error)
+	// EndTenure closes a current tenure on the given date, the first day the staff member is gone.
+	EndTenure(ctx context.Context, staffID, tenureID int64, end time.Time) (model.StaffTenure, error)
+	ListTenures(ctx context.Context, staffID int64) ([]model.StaffTenure, error)
+	// ListTeamStaff returns a team's current staff, or its staff on asOf when given.
+	ListTeamStaff(ctx context.Context, teamID int64, asOf *time.Time) ([]model.StaffTenure, error)
+	// GetCoachRecord returns a head coach's career and per-season win-loss record, optionally for one phase.
+	GetCoachRecord(ctx context.Context, staffID int64, phase *string) (model.CoachRecord, error)
+}
+
+// RuleProfileService defines use cases for the rule profiles competitions are played under.
+type RuleProfileService interface {
+	CreateRuleProfile(ctx context.Context, p model.RuleProfile) (model.RuleProfile, error)
+	GetRuleProfile(ctx context.Context, id int64) (model.RuleProfile, error)
+	ListRuleProfiles(ctx context.Context, page repository.Page) (repository.PageResult[model.RuleProfile], error)
 }
 
 // GameService defines game-oriented use cases.
 type GameService interface {
-	CreateGame(ctx context.Context, season string, date time.Time, homeID, awayID int64, status string) (model.Game, error)
+	// CreateGame validates and stores a game; its date must fall inside the season phase it is played in.
+	CreateGame(ctx context.Context, g model.Game) (model.Game, error)
 	GetGame(ctx context.Context, id int64) (model.Game, error)
 	ListGames(ctx context.Context, page repository.Page) (repository.PageResult[model.Game], error)
+	// UpdateGame changes the date, phase, venue or attendance of a game; the result must still fit its season
+	// and the attendance its venue.
+	UpdateGame(ctx context.Context, id int64, patch model.GamePatch) (model.Game, error)
+	// DeleteGame soft-deletes a game; RestoreGame brings it back with its score, events and stat lines.
+	DeleteGame(ctx context.Context, id int64) error
+	RestoreGame(ctx context.Context, id int64) (model.Game, error)
+	// TransitionGame moves a game through its lifecycle: scheduled to in_progress to finished,
+	// or scheduled to postponed or cancelled.
+	TransitionGame(ctx context.Context, id int64, status string) (model.GameTransition, error)
+	ListGameTransitions(ctx context.Context, id int64) ([]model.GameTransition, error)
+	// UpdateScore records the official final score and line score of a game.
+	UpdateScore(ctx context.Context, gameID int64, score model.GameScore) (model.Game, error)
+	// ReconcileScore compares the official score with the sum of player points; it never modifies data.
+	ReconcileScore(ctx context.Context, gameID int64) (model.ScoreReconciliation, error)
+	// GetBoxScore returns the game with its line score, every player line by team and the team totals.
+	GetBoxScore(ctx context.Context, gameID int64) (model.BoxScore, error)
+}
+
+// AvailabilityService defines use cases for per-game availability and player injury timelines.
+type AvailabilityService interface {
+	// SetAvailability records whether a player could play in a game; anything but active means they did not play.
+	SetAvailability(ctx context.Context, gameID, playerID int64, status string, reason *string) (model.PlayerAvailability, error)
+	ListGameAvailability(ctx context.Context, gameID int64) ([]model.PlayerAvailability, error)
+	ClearAvailability(ctx context.Context, gameID, playerID int64) error
+	RecordInjury(ctx context.Context, inj model.Injury) (model.Injury, error)
+	UpdateInjury(ctx context.Context, playerID, injuryID int64, patch model.InjuryPatch) (model.Injury, error)
+	// ListInjuries returns a player's injury timeline, most recent first.
+	ListInjuries(ctx context.Context, playerID int64) ([]model.Injury, error)
 }
 
 // StatsService defines stat line use cases.
 type StatsService interface {
 	UpsertStatLine(ctx context.Context, line model.PlayerStatLine) (model.PlayerStatLine, error)
 	ListStatsByGame(ctx context.Context, gameID int64) ([]model.PlayerStatLine, error)
+	// ListPlayerGameLog returns a player's stat lines joined with their games, most recent first.
+	ListPlayerGameLog(ctx context.Context, playerID int64, f model.GameLogFilter, page repository.Page) (repository.PageResult[model.GameLogEntry], error)
+}
+
+// EventService defines play-by-play use cases. Every write re-derives the affected box score lines.
+type EventService interface {
+	RecordEvent(ctx context.Context, e model.GameEvent) (model.GameEvent, error)
+	CorrectEvent(ctx context.Context, e model.GameEvent) (model.GameEvent, error)
+	DeleteEvent(ctx context.Context, gameID, eventID int64) error
+	ListEvents(ctx context.Context, gameID int64) ([]model.GameEvent, error)
+}
+
+// LineupService defines use cases for starters, five-man lineup stints and the ratings derived from them.
+type LineupService interface {
+	// SetStarters replaces a team's starting five for a game.
+	SetStarters(ctx context.Context, gameID, teamID int64, playerIDs []int64) ([]model.GameStarter, error)
+	ListStarters(ctx context.Context, gameID int64) ([]model.GameStarter, error)
+	RecordStint(ctx context.Context, st model.LineupStint) (model.LineupStint, error)
+	ListStints(ctx context.Context, gameID int64) ([]model.LineupStint, error)
+	DeleteStint(ctx context.Context, gameID, stintID int64) error
+	// ListTeamLineups returns minutes, points for and against and ratings per five-man unit, most minutes first.
+	ListTeamLineups(ctx context.Context, teamID int64, season string) ([]model.LineupStats, error)
+}
+
+// ShotService defines shot chart use cases. Charted shots never outnumber the shooter's box score line.
+type ShotService interface {
+	RecordShot(ctx context.Context, shot model.Shot) (model.Shot, error)
+	ListGameShots(ctx context.Context, gameID int64) ([]model.Shot, error)
+	DeleteShot(ctx context.Context, gameID, shotID int64) error
+	// GetShotChart returns attempts, makes and percentage per zone, optionally for one season.
+	GetShotChart(ctx context.Context, playerID int64, season *string) (model.ShotChart, error)
+}
+
+// AchievementService lists the feats detected from stat lines.
+type AchievementService interface {
+	ListPlayerAchievements(ctx context.Context, playerID int64, page repository.Page) (repository.PageResult[model.Achievement], error)
+	// ListAchievements lists feats across the league, optionally limited to a season and a kind.
+	ListAchievements(ctx context.Context, f model.AchievementFilter, page repository.Page) (repository.PageResult[model.Achievement], error)
+}
+
+// SearchService defines global name search.
+type SearchService interface {
+	// Search returns typo-tolerant, accent-insensitive matches for teams and players, best first.
+	// kind limits the results to team or player.
+	Search(ctx context.Context, q string, kind *string, page repository.Page) (repository.PageResult[model.SearchResult], error)
+}
+
+// LeadersService defines statistical leaderboards.
+type LeadersService interface {
+	// GetLeaders ranks qualified players by a stat as per-game averages or totals; tied players share a rank.
+	GetLeaders(ctx context.Context, stat, per string, season, phase *string, limit int) (model.Leaderboard, error)
+}
+
+// MetricsService defines advanced analytics use cases computed from stored stat lines.
+type MetricsService interface {
+	// GetPlayerAdvancedStats returns PER, Game Score, usage, AST/TO and per-36 rates for a season; season is required.
+	GetPlayerAdvancedStats(ctx context.Context, playerID int64, season string) (model.PlayerAdvancedStats, error)
 }
@@ -0,0 +1,318 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math"
+	"strings"
+
+	"github.com/maxviazov/basketball-stats-service/internal/model"
+	"github.com/maxviazov/basketball-stats-service/internal/repository"
+	"github.com/rs/zerolog"
+)
+
+// Shot types; free throws are not charted.
+const (
+	shotTwoPoint   = "two_pt"
+	shotThreePoint = "three_pt"
+)
+
+// Shot chart zones, in the order a chart lists them.
+const (
+	zoneRestrictedArea = "restricted_area"
+	zonePaint          = "paint"
+	zoneMidRange       = "mid_range"
+	zoneCorner3        = "corner_3"
+	zoneAboveBreak3    = "above_the_break_3"
+)
+
+var shotZones = []string{zoneRestrictedArea, zonePaint, zoneMidRange, zoneCorner3, zoneAboveBreak3}
+
+// Court geometry in feet, with the basket at the origin. The bounds fit both NBA and FIBA courts;
+// zone lines follow NBA markings.
+const (
+	courtHalfWidth   = 25.0
+	baselineY        = -5.25 // the basket is 5.25 ft in from the baseline
+	farBaselineY     = 88.75 // a 94 ft court
+	restrictedRadius = 4.0
+	laneHalfWidth    = 8.0
+	freeThrowLineY   = 13.75 // 19 ft from the baseline
+	cornerThreeMaxY  = 8.75  // corner threes are taken within 14 ft of the baseline
+)
+
+// Three-point lines in feet: an arc around the basket, cut off by straight lines along the sidelines.
+// A three has to be beyond the shorter FIBA line and a two inside the longer NBA line; a shot between them
+// is taken as charted, since the court's markings are not recorded.
+const (
+	fibaThreeRadius  = 22.15 // 6.75 m
+	fibaCornerThreeX = 21.65 // 6.60 m
+	nbaThreeRadius   = 23.75
+	nbaCornerThreeX  = 22.0
+)
+
+type shotService struct {
+	shots   repository.ShotRepository
+	stats   repository.StatsRepository
+	players repository.PlayerRepository
+	games   repository.GameRepository
+	rules   repository.RuleProfileRepository
+	tx      repository.TxManager
+	log     zerolog.Logger
+}
+
+func NewShotService(shots repository.ShotRepository, stats repository.StatsRepository, players repository.PlayerRepository, games repository.GameRepository, rules repository.RuleProfileRepository, tx repository.TxManager, logger zerolog.Logger) ShotService {
+	l := logger.With().Str("module", "service").Str("component", "shots").Logger()
+	return &shotService{shots: shots, stats: stats, players: players, games: games, rules: rules, tx: tx, log: l}
+}
+
+// RecordShot places a field goal attempt on the court and classifies its zone. The shot chart is kept within
+// the box score: a player's charted shots in a game never outnumber the attempts and makes of their stat line,
+// so the line has to be recorded first.
+func (s *shotService) RecordShot(ctx context.Context, shot model.Shot) (model.Shot, error) {
+	shot.ShotType = normalizeKeyword(shot.ShotType)
+	if err := NewInvalidInputError(validateShot(shot)); err != nil {
+		return model.Shot{}, err
+	}
+	shot.Zone = shotZone(shot.ShotType, shot.X, shot.Y)
+
+	var out model.Shot
+	err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.shots.LockGame(ctx, shot.GameID); err != nil {
+			return err
+		}
+		g, err := s.games.GetByID(ctx, shot.GameID)
+		if err != nil {
+			return err
+		}
+		profile, err := s.rules.GetByID(ctx, g.RuleProfileID)
+		if err != nil {
+			return err
+		}
+		var ferrs []FieldError
+		if shot.ClockSeconds > profile.PeriodSeconds(shot.Period) {
+			ferrs = append(ferrs, FieldError{Field: "clock_seconds", Message: "must be within the period length"})
+		}
+		if !acceptsStats(g.Status) {
+			ferrs = append(ferrs, FieldError{Field: "game_id", Message: "game is " + g.Status + "; shots are only accepted in progress or finished"})
+		}
+		if err := NewInvalidInputError(ferrs); err != nil {
+			return err
+		}
+		if _, err := s.players.GetByID(ctx, shot.PlayerID); err != nil {
+			if errors.Is(err, repository.ErrNotFound) {
+				return NewInvalidInputError([]FieldError{{Field: "player_id", Message: "player does not exist"}})
+			}
+			return err
+		}
+		if _, err := checkRoster(ctx, s.players, shot.PlayerID, g); err != nil {
+			return err
+		}
+		if err := s.checkLine(ctx, shot); err != nil {
+			return err
+		}
+		out, err = s.shots.Create(ctx, shot)
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, ErrInvalidInput) || errors.Is(err, repository.ErrNotFound) {
+			s.log.Debug().Err(err).Int64("game_id", shot.GameID).Interface("field_errors", FieldErrors(err)).Msg("record shot failed")
+		} else {
+			s.log.Error().Err(err).Int64("game_id", shot.GameID).Msg("record shot failed")
+		}
+		return model.Shot{}, err
+	}
+	s.log.Info().Int64("game_id", out.GameID).Int64("shot_id", out.ID).Str("zone", out.Zone).Msg("shot recorded")
+	return out, nil
+}
+
+// checkLine verifies the player's stat line still covers their charted shots once this one is added.
+func (s *shotService) checkLine(ctx context.Context, shot model.Shot) error {
+	lines, err := s.stats.ListByGame(ctx, shot.GameID)
+	if err != nil {
+		return err
+	}
+	var line *model.PlayerStatLine
+	for i := range lines {
+		if lines[i].PlayerID == shot.PlayerID {
+			line = &lines[i]
+			break
+		}
+	}
+	if line == nil {
+		return NewInvalidInputError([]FieldError{{Field: "player_id", Message: "player has no stat line for this game"}})
+	}
+	counts, err := s.shots.CountByPlayerGame(ctx, shot.PlayerID, shot.GameID)
+	if err != nil {
+		return err
+	}
+	counts.Attempts++
+	if shot.Made {
+		counts.Makes++
+	}
+	if shot.ShotType == shotThreePoint {
+		counts.ThreeAttempts++
+		if shot.Made {
+			counts.ThreeMakes++
+		}
+	}
+	if field := shotExcess(counts, *line); field != "" {
+		return NewInvalidInputError([]FieldError{{Field: "player_id", Message: "shot would exceed the player's " + field + " in the box score"}})
+	}
+	return nil
+}
+
+func (s *shotService) ListGameShots(ctx context.Context, gameID int64) ([]model.Shot, error) {
+	if gameID <= 0 {
+		return nil, NewInvalidInputError([]FieldError{{Field: "game_id", Message: "must be > 0"}})
+	}
+	if _, err := s.games.GetByID(ctx, gameID); err != nil {
+		return nil, err
+	}
+	return s.shots.ListByGame(ctx, gameID)
+}
+
+func (s *shotService) DeleteShot(ctx context.Context, gameID, shotID int64) error {
+	var ferrs []FieldError
+	if gameID <= 0 {
+		ferrs = append(ferrs, FieldError{Field: "game_id", Message: "must be > 0"})
+	}
+	if shotID <= 0 {
+		ferrs = append(ferrs, FieldError{Field: "shot_id", Message: "must be > 0"})
+	}
+	if err := NewInvalidInputError(ferrs); err != nil {
+		return err
+	}
+	if err := s.shots.Delete(ctx, gameID, shotID); err != nil {
+		return err
+	}
+	s.log.Info().Int64("game_id", gameID).Int64("shot_id", shotID).Msg("shot deleted")
+	return nil
+}
+
+// GetShotChart totals a player's shots per zone, optionally for one season.
+func (s *shotService) GetShotChart(ctx context.Context, playerID int64, season *string) (model.ShotChart, error) {
+	var ferrs []FieldError
+	if playerID <= 0 {
+		ferrs = append(ferrs, FieldError{Field: "id", Message: "must be > 0"})
+	}
+	if season != nil {
+		trimmed := strings.TrimSpace(*season)
+		season = &trimmed
+		if !IsValidSeason(trimmed) {
+			ferrs = append(ferrs, FieldError{Field: "season", Message: "must be in YYYY-YY format"})
+		}
+	}
+	if err := NewInvalidInputError(ferrs); err != nil {
+		return model.ShotChart{}, err
+	}
+	exists, err := s.players.Exists(ctx, playerID)
+	if err != nil {
+		return model.ShotChart{}, err
+	}
+	if !exists {
+		return model.ShotChart{}, repository.ErrNotFound
+	}
+	rows, err := s.shots.GetShotChart(ctx, playerID, season)
+	if err != nil {
+		s.log.Error().Err(err).Int64("player_id", playerID).Msg("get shot chart failed")
+		return model.ShotChart{}, err
+	}
+	byZone := make(map[string]model.ShotZoneStats, len(rows))
+	for _, r := range rows {
+		byZone[r.Zone] = r
+	}
+	out := model.ShotChart{PlayerID: playerID, Season: season, Zones: make([]model.ShotZoneStats, 0, len(shotZones))}
+	for _, zone := range shotZones {
+		z := byZone[zone]
+		z.Zone = zone
+		z.Pct = round(div(float64(z.Makes), float64(z.Attempts)), 3)
+		out.Zones = append(out.Zones, z)
+	}
+	return out, nil
+}
+
+func validateShot(shot model.Shot) []FieldError {
+	var ferrs []FieldError
+	if shot.GameID <= 0 {
+		ferrs = append(ferrs, FieldError{Field: "game_id", Message: "must be > 0"})
+	}
+	if shot.PlayerID <= 0 {
+		ferrs = append(ferrs, FieldError{Field: "player_id", Message: "must be > 0"})
+	}
+	if shot.Period < 1 {
+		ferrs = append(ferrs, FieldError{Field: "period", Message: "must be >= 1"})
+	} else if shot.ClockSeconds < 0 {
+		ferrs = append(ferrs, FieldError{Field: "clock_seconds", Message: "must be >= 0"})
+	}
+	if shot.ShotType != shotTwoPoint && shot.ShotType != shotThreePoint {
+		ferrs = append(ferrs, FieldError{Field: "shot_type", Message: "must be one of two_pt|three_pt"})
+	}
+	if math.IsNaN(shot.X) || shot.X < -courtHalfWidth || shot.X > courtHalfWidth {
+		ferrs = append(ferrs, FieldError{Field: "x", Message: "must be between -25 and 25 ft"})
+	}
+	if math.IsNaN(shot.Y) || shot.Y < baselineY || shot.Y > farBaselineY {
+		ferrs = append(ferrs, FieldError{Field: "y", Message: "must be between -5.25 and 88.75 ft"})
+	}
+	if len(ferrs) == 0 {
+		switch {
+		case shot.ShotType == shotThreePoint && !beyondThreePointLine(shot.X, shot.Y, fibaThreeRadius, fibaCornerThreeX):
+			ferrs = append(ferrs, FieldError{Field: "shot_type", Message: "a three must be taken beyond the three-point line"})
+		case shot.ShotType == shotTwoPoint && beyondThreePointLine(shot.X, shot.Y, nbaThreeRadius, nbaCornerThreeX):
+			ferrs = append(ferrs, FieldError{Field: "shot_type", Message: "a two must be taken inside the three-point line"})
+		}
+	}
+	return ferrs
+}
+
+// beyondThreePointLine reports whether a spot is on or behind a three-point line with the given arc radius
+// and corner distance.
+func beyondThreePointLine(x, y, radius, cornerX float64) bool {
+	return math.Abs(x) >= cornerX || math.Hypot(x, y) >= radius
+}
+
+// shotZone classifies a validated shot. Threes are split by the corner cut-off; twos by distance to the
+// basket first, then by whether they were taken inside the lane.
+func shotZone(shotType string, x, y float64) string {
+	if shotType == shotThreePoint {
+		if y <= cornerThreeMaxY {
+			return zoneCorner3
+		}
+		return zoneAboveBreak3
+	}
+	switch {
+	case math.Hypot(x, y) <= restrictedRadius:
+		return zoneRestrictedArea
+	case math.Abs(x) <= laneHalfWidth && y <= freeThrowLineY:
+		return zonePaint
+	default:
+		return zoneMidRange
+	}
+}
+
+// checkShotsCovered rejects a stat line that would no longer cover the player's charted shots in the game.
+// Callers run it in the same transaction as the write it guards.
+func checkShotsCovered(ctx context.Context, shots repository.ShotRepository, line model.PlayerStatLine) error {
+	counts, err := shots.CountByPlayerGame(ctx, line.PlayerID, line.GameID)
+	if err != nil {
+		return err
+	}
+	if field := shotExcess(counts, line); field != "" {
+		return NewInvalidInputError([]FieldError{{Field: field, Message: "must cover the shots charted for this game"}})
+	}
+	return nil
+}
+
+// shotExcess names the first box score total a player's charted shots exceed, or "" if the line covers them.
+func shotExcess(c model.ShotCounts, line model.PlayerStatLine) string {
+	switch {
+	case c.Attempts > line.FieldGoalsAttempted:
+		return "field_goals_attempted"
+	case c.Makes > line.FieldGoalsMade:
+		return "field_goals_made"
+	case c.ThreeAttempts > line.ThreePointersAttempted:
+		return "three_pointers_attempted"
+	case c.ThreeMakes > line.ThreePointersMade:
+		return "three_pointers_made"
+	}
+	return ""
+}
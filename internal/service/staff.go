@@ -0,0 +1,262 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/maxviazov/basketball-stats-service/internal/model"
+	"github.com/maxviazov/basketball-stats-service/internal/repository"
+	"github.com/rs/zerolog"
+)
+
+// Staff roles. Only head coach tenures count towards a coach's record.
+const (
+	roleHeadCoach      = "head_coach"
+	roleAssistantCoach = "assistant_coach"
+	roleTrainer        = "trainer"
+)
+
+type staffService struct {
+	staff repository.StaffRepository
+	teams repository.TeamRepository
+	tx    repository.TxManager
+	log   zerolog.Logger
+}
+
+func NewStaffService(staff repository.StaffRepository, teams repository.TeamRepository, tx repository.TxManager, logger zerolog.Logger) StaffService {
+	l := logger.With().Str("module", "service").Str("component", "staff").Logger()
+	return &staffService{staff: staff, teams: teams, tx: tx, log: l}
+}
+
+func (s *staffService) CreateStaff(ctx context.Context, firstName, lastName string) (model.Staff, error) {
+	firstName = strings.TrimSpace(firstName)
+	lastName = strings.TrimSpace(lastName)
+	var ferrs []FieldError
+	if ln := len([]rune(firstName)); ln < 1 || ln > 50 {
+		ferrs = append(ferrs, FieldError{Field: "first_name", Message: "length must be between 1 and 50"})
+	}
+	if ln := len([]rune(lastName)); ln < 1 || ln > 50 {
+		ferrs = append(ferrs, FieldError{Field: "last_name", Message: "length must be between 1 and 50"})
+	}
+	if err := NewInvalidInputError(ferrs); err != nil {
+		return model.Staff{}, err
+	}
+	out, err := s.staff.Create(ctx, model.Staff{FirstName: firstName, LastName: lastName})
+	if err != nil {
+		s.log.Error().Err(err).Str("first_name", firstName).Str("last_name", lastName).Msg("create staff failed")
+		return model.Staff{}, err
+	}
+	s.log.Info().Int64("staff_id", out.ID).Msg("staff created")
+	return out, nil
+}
+
+func (s *staffService) GetStaff(ctx context.Context, id int64) (model.Staff, error) {
+	if id <= 0 {
+		return model.Staff{}, NewInvalidInputError([]FieldError{{Field: "id", Message: "must be > 0"}})
+	}
+	return s.staff.GetByID(ctx, id)
+}
+
+func (s *staffService) ListStaff(ctx context.Context, page repository.Page) (repository.PageResult[model.Staff], error) {
+	p := normalizePage(page)
+	res, err := s.staff.List(ctx, p)
+	if err != nil {
+		s.log.Error().Err(err).Int("limit", p.Limit).Int("offset", p.Offset).Msg("list staff failed")
+		return repository.PageResult[model.Staff]{}, err
+	}
+	return res, nil
+}
+
+// AddTenure puts a staff member on a team's staff. Dates have day granularity and the period is half-open,
+// so a tenure may start on the day the previous one ends. A staff member holds one tenure at a time and a team
+// has one head coach at a time; both are checked under row locks on the staff member and the team.
+func (s *staffService) AddTenure(ctx context.Context, t model.StaffTenure) (model.StaffTenure, error) {
+	t.Role = normalizeKeyword(t.Role)
+	var ferrs []FieldError
+	if t.StaffID <= 0 {
+		ferrs = append(ferrs, FieldError{Field: "id", Message: "must be > 0"})
+	}
+	if t.TeamID <= 0 {
+		ferrs = append(ferrs, FieldError{Field: "team_id", Message: "must be > 0"})
+	}
+	if !isValidStaffRole(t.Role) {
+		ferrs = append(ferrs, FieldError{Field: "role", Message: "must be one of head_coach|assistant_coach|trainer"})
+	}
+	if t.StartDate.IsZero() {
+		ferrs = append(ferrs, FieldError{Field: "start_date", Message: "is required"})
+	}
+	t.StartDate = truncateToDate(t.StartDate)
+	if t.EndDate != nil {
+		end := truncateToDate(*t.EndDate)
+		t.EndDate = &end
+		if !t.StartDate.IsZero() && !end.After(t.StartDate) {
+			ferrs = append(ferrs, FieldError{Field: "end_date", Message: "must be after start_date"})
+		}
+	}
+	if err := NewInvalidInputError(ferrs); err != nil {
+		return model.StaffTenure{}, err
+	}
+
+	var out model.StaffTenure
+	err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.staff.Lock(ctx, t.StaffID, t.TeamID); err != nil {
+			return err
+		}
+		if _, err := s.teams.GetByID(ctx, t.TeamID); err != nil {
+			if errors.Is(err, repository.ErrNotFound) {
+				return NewInvalidInputError([]FieldError{{Field: "team_id", Message: "team does not exist"}})
+			}
+			return err
+		}
+		own, err := s.staff.ListTenures(ctx, t.StaffID)
+		if err != nil {
+			return err
+		}
+		for _, e := range own {
+			if tenuresOverlap(e, t) {
+				return NewInvalidInputError([]FieldError{{Field: "start_date", Message: "overlaps tenure " + strconv.FormatInt(e.ID, 10) + " with team " + strconv.FormatInt(e.TeamID, 10)}})
+			}
+		}
+		if t.Role == roleHeadCoach {
+			team, err := s.staff.ListTeamTenures(ctx, t.TeamID)
+			if err != nil {
+				return err
+			}
+			for _, e := range team {
+				if e.Role == roleHeadCoach && tenuresOverlap(e, t) {
+					return NewInvalidInputError([]FieldError{{Field: "role", Message: "team already has a head coach in that period (tenure " + strconv.FormatInt(e.ID, 10) + ")"}})
+				}
+			}
+		}
+		out, err = s.staff.CreateTenure(ctx, t)
+		return err
+	})
+	if err != nil {
+		if !errors.Is(err, ErrInvalidInput) && !errors.Is(err, repository.ErrNotFound) && !errors.Is(err, repository.ErrAlreadyExists) {
+			s.log.Error().Err(err).Int64("staff_id", t.StaffID).Int64("team_id", t.TeamID).Msg("add tenure failed")
+		}
+		return model.StaffTenure{}, err
+	}
+	s.log.Info().Int64("staff_id", out.StaffID).Int64("team_id", out.TeamID).Str("role", out.Role).Msg("tenure added")
+	return out, nil
+}
+
+// EndTenure only closes a current tenure. That only shortens the period, so it never creates an overlap.
+func (s *staffService) EndTenure(ctx context.Context, staffID, tenureID int64, end time.Time) (model.StaffTenure, error) {
+	var ferrs []FieldError
+	if staffID <= 0 {
+		ferrs = append(ferrs, FieldError{Field: "id", Message: "must be > 0"})
+	}
+	if tenureID <= 0 {
+		ferrs = append(ferrs, FieldError{Field: "tenure_id", Message: "must be > 0"})
+	}
+	if end.IsZero() {
+		ferrs = append(ferrs, FieldError{Field: "end_date", Message: "is required"})
+	}
+	if err := NewInvalidInputError(ferrs); err != nil {
+		return model.StaffTenure{}, err
+	}
+	end = truncateToDate(end)
+
+	tenures, err := s.ListTenures(ctx, staffID)
+	if err != nil {
+		return model.StaffTenure{}, err
+	}
+	idx := -1
+	for i, t := range tenures {
+		if t.ID == tenureID {
+			idx = i
+		}
+	}
+	if idx < 0 {
+		return model.StaffTenure{}, repository.ErrNotFound
+	}
+	if tenures[idx].EndDate != nil {
+		return model.StaffTenure{}, NewInvalidInputError([]FieldError{{Field: "end_date", Message: "tenure has already ended"}})
+	}
+	if !end.After(tenures[idx].StartDate) {
+		return model.StaffTenure{}, NewInvalidInputError([]FieldError{{Field: "end_date", Message: "must be after start_date"}})
+	}
+	out, err := s.staff.EndTenure(ctx, staffID, tenureID, end)
+	if err != nil {
+		if !errors.Is(err, repository.ErrNotFound) {
+			s.log.Error().Err(err).Int64("staff_id", staffID).Int64("tenure_id", tenureID).Msg("end tenure failed")
+		}
+		return model.StaffTenure{}, err
+	}
+	s.log.Info().Int64("staff_id", staffID).Int64("tenure_id", tenureID).Time("end_date", end).Msg("tenure ended")
+	return out, nil
+}
+
+func (s *staffService) ListTenures(ctx context.Context, staffID int64) ([]model.StaffTenure, error) {
+	if staffID <= 0 {
+		return nil, NewInvalidInputError([]FieldError{{Field: "id", Message: "must be > 0"}})
+	}
+	if _, err := s.staff.GetByID(ctx, staffID); err != nil {
+		return nil, err
+	}
+	return s.staff.ListTenures(ctx, staffID)
+}
+
+func (s *staffService) ListTeamStaff(ctx context.Context, teamID int64, asOf *time.Time) ([]model.StaffTenure, error) {
+	if teamID <= 0 {
+		return nil, NewInvalidInputError([]FieldError{{Field: "team_id", Message: "must be > 0"}})
+	}
+	if _, err := s.teams.GetByID(ctx, teamID); err != nil {
+		return nil, err
+	}
+	if asOf != nil {
+		d := truncateToDate(*asOf)
+		asOf = &d
+	}
+	return s.staff.ListByTeam(ctx, teamID, asOf)
+}
+
+// GetCoachRecord sums the per-season records into the career record. Win percentages are rounded like
+// standings, and a staff member who never was a head coach gets an empty record rather than an error.
+func (s *staffService) GetCoachRecord(ctx context.Context, staffID int64, phase *string) (model.CoachRecord, error) {
+	var ferrs []FieldError
+	if staffID <= 0 {
+		ferrs = append(ferrs, FieldError{Field: "id", Message: "must be > 0"})
+	}
+	phase, phaseErrs := validatePhaseFilter(phase)
+	ferrs = append(ferrs, phaseErrs...)
+	if err := NewInvalidInputError(ferrs); err != nil {
+		return model.CoachRecord{}, err
+	}
+	if _, err := s.staff.GetByID(ctx, staffID); err != nil {
+		return model.CoachRecord{}, err
+	}
+	seasons, err := s.staff.ListCoachSeasons(ctx, staffID, phase)
+	if err != nil {
+		s.log.Error().Err(err).Int64("staff_id", staffID).Msg("coach record failed")
+		return model.CoachRecord{}, err
+	}
+	out := model.CoachRecord{StaffID: staffID, Phase: phase, Seasons: make([]model.CoachSeasonRecord, 0, len(seasons))}
+	for _, r := range seasons {
+		r.WinPct = round(winPct(r.Wins, r.Losses), 3)
+		out.Wins += r.Wins
+		out.Losses += r.Losses
+		out.Seasons = append(out.Seasons, r)
+	}
+	out.WinPct = round(winPct(out.Wins, out.Losses), 3)
+	return out, nil
+}
+
+// tenuresOverlap compares two half-open periods; a nil end runs indefinitely.
+func tenuresOverlap(a, b model.StaffTenure) bool {
+	aEndsFirst := a.EndDate != nil && !a.EndDate.After(b.StartDate)
+	bEndsFirst := b.EndDate != nil && !b.EndDate.After(a.StartDate)
+	return !aEndsFirst && !bEndsFirst
+}
+
+func isValidStaffRole(role string) bool {
+	switch role {
+	case roleHeadCoach, roleAssistantCoach, roleTrainer:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/maxviazov/basketball-stats-service/internal/model"
+	"github.com/maxviazov/basketball-stats-service/internal/repository"
+	"github.com/rs/zerolog"
+)
+
+type standingsService struct {
+	teams   repository.TeamRepository
+	seasons repository.SeasonRepository
+	log     zerolog.Logger
+}
+
+func NewStandingsService(teams repository.TeamRepository, seasons repository.SeasonRepository, logger zerolog.Logger) StandingsService {
+	l := logger.With().Str("module", "service").Str("component", "standings").Logger()
+	return &standingsService{teams: teams, seasons: seasons, log: l}
+}
+
+// GetStandings ranks every team for a season phase; without a phase the regular season is used.
+func (s *standingsService) GetStandings(ctx context.Context, season string, phase *string) (model.Standings, error) {
+	season = strings.TrimSpace(season)
+	var ferrs []FieldError
+	if season == "" {
+		ferrs = append(ferrs, FieldError{Field: "season", Message: "is required"})
+	} else if !IsValidSeason(season) {
+		ferrs = append(ferrs, FieldError{Field: "season", Message: "must be in YYYY-YY format"})
+	}
+	phase, phaseErrs := validatePhaseFilter(phase)
+	ferrs = append(ferrs, phaseErrs...)
+	if err := NewInvalidInputError(ferrs); err != nil {
+		return model.Standings{}, err
+	}
+	if phase == nil {
+		regular := phaseRegular
+		phase = &regular
+	}
+
+	// An unknown season is a 404 rather than a table of empty records.
+	if _, err := s.seasons.GetByName(ctx, season); err != nil {
+		return model.Standings{}, err
+	}
+
+	rows, err := s.teams.ListStandings(ctx, season, phase)
+	if err != nil {
+		s.log.Error().Err(err).Str("season", season).Str("phase", *phase).Msg("failed to load standings")
+		return model.Standings{}, err
+	}
+	rankStandings(rows)
+	return model.Standings{Season: season, Phase: *phase, Teams: rows}, nil
+}
+
+// rankStandings orders teams by win percentage and fills in rank, win percentage and games behind the leader.
+// Teams level on win percentage are separated by their head-to-head record against each other, then by point
+// differential; team ID only keeps the order stable when everything else is equal.
+func rankStandings(rows []model.TeamStanding) {
+	sort.SliceStable(rows, func(i, j int) bool {
+		pi, pj := winPct(rows[i].Wins, rows[i].Losses), winPct(rows[j].Wins, rows[j].Losses)
+		if pi != pj {
+			return pi > pj
+		}
+		return rows[i].TeamID < rows[j].TeamID
+	})
+	for start := 0; start < len(rows); {
+		end := start + 1
+		for end < len(rows) && winPct(rows[end].Wins, rows[end].Losses) == winPct(rows[start].Wins, rows[start].Losses) {
+			end++
+		}
+		if end-start > 1 {
+			breakTies(rows[start:end])
+		}
+		start = end
+	}
+	if len(rows) == 0 {
+		return
+	}
+	leader := rows[0]
+	for i := range rows {
+		rows[i].Rank = i + 1
+		rows[i].WinPct = round(winPct(rows[i].Wins, rows[i].Losses), 3)
+		rows[i].GamesBehind = float64((leader.Wins-rows[i].Wins)+(rows[i].Losses-leader.Losses)) / 2
+	}
+}
+
+// breakTies orders a group of teams with the same win percentage. The head-to-head record only counts games
+// between members of the group, so a three-way tie is decided by the mini-league of those three teams.
+func breakTies(group []model.TeamStanding) {
+	h2h := make(map[int64]float64, len(group))
+	for _, t := range group {
+		var rec model.WinLoss
+		for _, opp := range group {
+			r := t.HeadToHead[opp.TeamID]
+			rec.Wins += r.Wins
+			rec.Losses += r.Losses
+		}
+		h2h[t.TeamID] = winPct(rec.Wins, rec.Losses)
+	}
+	sort.SliceStable(group, func(i, j int) bool {
+		if hi, hj := h2h[group[i].TeamID], h2h[group[j].TeamID]; hi != hj {
+			return hi > hj
+		}
+		if group[i].PointDiff != group[j].PointDiff {
+			return group[i].PointDiff > group[j].PointDiff
+		}
+		return group[i].TeamID < group[j].TeamID
+	})
+}
+
+// winPct is 0 for a team without games, so it sorts below any team with a win.
+func winPct(wins, losses int) float64 {
+	return div(float64(wins), float64(wins+losses))
+}
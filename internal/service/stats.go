@@ -9,87 +9,119 @@ import (
 	"github.com/rs/zerolog"
 )
 
-const (
-	maxFouls        = 6
-	maxMinutesFloat = 48.0
-)
-
 type statsService struct {
-	stats   repository.StatsRepository
-	players repository.PlayerRepository
-	games   repository.GameRepository
-	tx      repository.TxManager
-	log     zerolog.Logger
+	stats        repository.StatsRepository
+	players      repository.PlayerRepository
+	games        repository.GameRepository
+	rules        repository.RuleProfileRepository
+	achievements repository.AchievementRepository
+	availability repository.AvailabilityRepository
+	shots        repository.ShotRepository
+	tx           repository.TxManager
+	log          zerolog.Logger
 }
 
-func NewStatsService(stats repository.StatsRepository, players repository.PlayerRepository, games repository.GameRepository, tx repository.TxManager, logger zerolog.Logger) StatsService {
+func NewStatsService(stats repository.StatsRepository, players repository.PlayerRepository, games repository.GameRepository, rules repository.RuleProfileRepository, achievements repository.AchievementRepository, availability repository.AvailabilityRepository, shots repository.ShotRepository, tx repository.TxManager, logger zerolog.Logger) StatsService {
 	l := logger.With().Str("module", "service").Str("component", "stats").Logger()
-	return &statsService{stats: stats, players: players, games: games, tx: tx, log: l}
+	return &statsService{stats: stats, players: players, games: games, rules: rules, achievements: achievements, availability: availability, shots: shots, tx: tx, log: l}
 }
 
+// UpsertStatLine stores a line and re-detects the player's achievements in the same transaction,
+// so a corrected line also revokes feats it no longer qualifies for. Only games in progress or finished take lines,
+// and only for players on either team's roster on the game date. Fouls, minutes and the number of players
+// per team are limited by the game's rule profile; minutes allow for every overtime in the recorded line score.
+// A line showing the player on the floor is rejected while they are recorded as not playing in that game,
+// and a line may not drop below the shots charted for the player in that game.
 func (s *statsService) UpsertStatLine(ctx context.Context, line model.PlayerStatLine) (model.PlayerStatLine, error) {
-	var ferrs []FieldError
-	if line.PlayerID <= 0 {
-		ferrs = append(ferrs, FieldError{Field: "player_id", Message: "must be > 0"})
-	}
-	if line.GameID <= 0 {
-		ferrs = append(ferrs, FieldError{Field: "game_id", Message: "must be > 0"})
-	}
-	if line.Points < 0 {
-		ferrs = append(ferrs, FieldError{Field: "points", Message: "must be >= 0"})
-	}
-	if line.Rebounds < 0 {
-		ferrs = append(ferrs, FieldError{Field: "rebounds", Message: "must be >= 0"})
-	}
-	if line.Assists < 0 {
-		ferrs = append(ferrs, FieldError{Field: "assists", Message: "must be >= 0"})
-	}
-	if line.Steals < 0 {
-		ferrs = append(ferrs, FieldError{Field: "steals", Message: "must be >= 0"})
-	}
-	if line.Blocks < 0 {
-		ferrs = append(ferrs, FieldError{Field: "blocks", Message: "must be >= 0"})
-	}
-	if line.Fouls < 0 || line.Fouls > maxFouls {
-		ferrs = append(ferrs, FieldError{Field: "fouls", Message: "must be between 0 and 6"})
-	}
-	if line.Turnovers < 0 {
-		ferrs = append(ferrs, FieldError{Field: "turnovers", Message: "must be >= 0"})
-	}
-	if line.MinutesPlayed < 0 || float64(line.MinutesPlayed) > maxMinutesFloat {
-		ferrs = append(ferrs, FieldError{Field: "minutes_played", Message: "must be between 0 and 48.0"})
-	}
-
+	ferrs := validateStatLine(line)
 	if err := NewInvalidInputError(ferrs); err != nil {
 		return model.PlayerStatLine{}, err
 	}
 
-	var existenceErrs []FieldError
+	var out model.PlayerStatLine
 	if err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		var existenceErrs []FieldError
 		if _, err := s.players.GetByID(ctx, line.PlayerID); err != nil {
-			if errors.Is(err, repository.ErrNotFound) {
-				existenceErrs = append(existenceErrs, FieldError{Field: "player_id", Message: "player does not exist"})
-				return nil // continue checks
+			if !errors.Is(err, repository.ErrNotFound) {
+				return err
 			}
+			existenceErrs = append(existenceErrs, FieldError{Field: "player_id", Message: "player does not exist"})
+		}
+		g, err := s.games.GetByID(ctx, line.GameID)
+		switch {
+		case errors.Is(err, repository.ErrNotFound):
+			existenceErrs = append(existenceErrs, FieldError{Field: "game_id", Message: "game does not exist"})
+		case err != nil:
 			return err
+		case !acceptsStats(g.Status):
+			existenceErrs = append(existenceErrs, FieldError{Field: "game_id", Message: "game is " + g.Status + "; stats are only accepted in progress or finished"})
 		}
-		if _, err := s.games.GetByID(ctx, line.GameID); err != nil {
-			if errors.Is(err, repository.ErrNotFound) {
-				existenceErrs = append(existenceErrs, FieldError{Field: "game_id", Message: "game does not exist"})
-				return nil // continue checks
-			}
+		if err := NewInvalidInputError(existenceErrs); err != nil {
 			return err
 		}
-		return nil
+		teamID, err := checkRoster(ctx, s.players, line.PlayerID, g)
+		if err != nil {
+			return err
+		}
+		if err := checkAvailable(ctx, s.availability, line); err != nil {
+			return err
+		}
+		profile, overtimes, err := loadGameRules(ctx, s.rules, s.games, g)
+		if err != nil {
+			return err
+		}
+		if err := NewInvalidInputError(validateStatLineRules(line, profile, overtimes)); err != nil {
+			return err
+		}
+		if err := checkRosterSize(ctx, s.games, g, teamID, line.PlayerID, profile); err != nil {
+			return err
+		}
+		if err := checkShotsCovered(ctx, s.shots, line); err != nil {
+			return err
+		}
+
+		saved, err := s.stats.UpsertStatLine(ctx, line)
+		if err != nil {
+			return err
+		}
+		out = saved
+		return refreshAchievements(ctx, s.achievements, saved.PlayerID, saved.GameID, &saved)
 	}); err != nil {
+		if !errors.Is(err, ErrInvalidInput) {
+			s.log.Error().Err(err).Int64("player_id", line.PlayerID).Int64("game_id", line.GameID).Msg("upsert stat line failed")
+		}
 		return model.PlayerStatLine{}, err
 	}
+	return out, nil
+}
 
-	if err := NewInvalidInputError(existenceErrs); err != nil {
-		return model.PlayerStatLine{}, err
+// checkAvailable rejects a line showing the player on the floor while they are recorded as not playing in that game.
+// A player without an availability record is available.
+func checkAvailable(ctx context.Context, availability repository.AvailabilityRepository, line model.PlayerStatLine) error {
+	if !line.Played() {
+		return nil
+	}
+	a, err := availability.GetAvailability(ctx, line.GameID, line.PlayerID)
+	if err != nil && !errors.Is(err, repository.ErrNotFound) {
+		return err
 	}
+	if err == nil && a.Status != availabilityActive {
+		return NewInvalidInputError([]FieldError{{Field: "player_id", Message: "player is recorded as " + a.Status + " for this game"}})
+	}
+	return nil
+}
 
-	return s.stats.UpsertStatLine(ctx, line)
+// checkRoster verifies the player was on the home or away roster on the game date and returns that team.
+// Callers run it in the same transaction as the write it guards.
+func checkRoster(ctx context.Context, players repository.PlayerRepository, playerID int64, g model.Game) (int64, error) {
+	teamID, err := players.TeamOn(ctx, playerID, truncateToDate(g.Date.UTC()))
+	if err != nil && !errors.Is(err, repository.ErrNotFound) {
+		return 0, err
+	}
+	if err != nil || (teamID != g.HomeTeamID && teamID != g.AwayTeamID) {
+		return 0, NewInvalidInputError([]FieldError{{Field: "player_id", Message: "player was not on either team's roster on the game date"}})
+	}
+	return teamID, nil
 }
 
 func (s *statsService) ListStatsByGame(ctx context.Context, gameID int64) ([]model.PlayerStatLine, error) {
@@ -98,3 +130,121 @@ func (s *statsService) ListStatsByGame(ctx context.Context, gameID int64) ([]mod
 	}
 	return s.stats.ListByGame(ctx, gameID)
 }
+
+// ListPlayerGameLog returns a player's game lines, most recent first, with the filters applied before paging.
+func (s *statsService) ListPlayerGameLog(ctx context.Context, playerID int64, f model.GameLogFilter, page repository.Page) (repository.PageResult[model.GameLogEntry], error) {
+	var ferrs []FieldError
+	if playerID <= 0 {
+		ferrs = append(ferrs, FieldError{Field: "id", Message: "must be > 0"})
+	}
+	if f.Season != nil && !IsValidSeason(*f.Season) {
+		ferrs = append(ferrs, FieldError{Field: "season", Message: "must be in YYYY-YY format"})
+	}
+	if f.From != nil && f.To != nil && f.To.Before(*f.From) {
+		ferrs = append(ferrs, FieldError{Field: "to", Message: "must not be before from"})
+	}
+	if f.OpponentID != nil && *f.OpponentID <= 0 {
+		ferrs = append(ferrs, FieldError{Field: "opponent_id", Message: "must be > 0"})
+	}
+	if f.Last < 0 {
+		ferrs = append(ferrs, FieldError{Field: "last", Message: "must be >= 0"})
+	}
+	if err := NewInvalidInputError(ferrs); err != nil {
+		return repository.PageResult[model.GameLogEntry]{}, err
+	}
+	if f.From != nil {
+		from := truncateToDate(*f.From)
+		f.From = &from
+	}
+	if f.To != nil {
+		to := truncateToDate(*f.To)
+		f.To = &to
+	}
+
+	exists, err := s.players.Exists(ctx, playerID)
+	if err != nil {
+		s.log.Error().Err(err).Int64("player_id", playerID).Msg("failed to check player existence")
+		return repository.PageResult[model.GameLogEntry]{}, err
+	}
+	if !exists {
+		return repository.PageResult[model.GameLogEntry]{}, repository.ErrNotFound
+	}
+
+	p := normalizePage(page)
+	res, err := s.stats.ListByPlayer(ctx, playerID, f, p)
+	if err != nil {
+		s.log.Error().Err(err).Int64("player_id", playerID).Msg("list game log failed")
+		return repository.PageResult[model.GameLogEntry]{}, err
+	}
+	return res, nil
+}
+
+// validateStatLine checks a stat line in isolation: ranges first, then the cross-field box score invariants.
+// Invariants are only checked once the individual values are sane, so clients get one clear message per field.
+// Limits that depend on the game's rules are left to validateStatLineRules.
+func validateStatLine(line model.PlayerStatLine) []FieldError {
+	var ferrs []FieldError
+	if line.PlayerID <= 0 {
+		ferrs = append(ferrs, FieldError{Field: "player_id", Message: "must be > 0"})
+	}
+	if line.GameID <= 0 {
+		ferrs = append(ferrs, FieldError{Field: "game_id", Message: "must be > 0"})
+	}
+	nonNegative := []struct {
+		field string
+		value int
+	}{
+		{"points", line.Points},
+		{"rebounds", line.Rebounds},
+		{"offensive_rebounds", line.OffensiveRebounds},
+		{"defensive_rebounds", line.DefensiveRebounds},
+		{"assists", line.Assists},
+		{"steals", line.Steals},
+		{"blocks", line.Blocks},
+		{"turnovers", line.Turnovers},
+		{"field_goals_made", line.FieldGoalsMade},
+		{"field_goals_attempted", line.FieldGoalsAttempted},
+		{"three_pointers_made", line.ThreePointersMade},
+		{"three_pointers_attempted", line.ThreePointersAttempted},
+		{"free_throws_made", line.FreeThrowsMade},
+		{"free_throws_attempted", line.FreeThrowsAttempted},
+	}
+	for _, nn := range nonNegative {
+		if nn.value < 0 {
+			ferrs = append(ferrs, FieldError{Field: nn.field, Message: "must be >= 0"})
+		}
+	}
+	if line.Fouls < 0 {
+		ferrs = append(ferrs, FieldError{Field: "fouls", Message: "must be >= 0"})
+	}
+	if line.MinutesPlayed < 0 {
+		ferrs = append(ferrs, FieldError{Field: "minutes_played", Message: "must be >= 0"})
+	}
+	if len(ferrs) > 0 {
+		return ferrs
+	}
+
+	if line.FieldGoalsMade > line.FieldGoalsAttempted {
+		ferrs = append(ferrs, FieldError{Field: "field_goals_made", Message: "must be <= field_goals_attempted"})
+	}
+	if line.ThreePointersMade > line.ThreePointersAttempted {
+		ferrs = append(ferrs, FieldError{Field: "three_pointers_made", Message: "must be <= three_pointers_attempted"})
+	}
+	if line.FreeThrowsMade > line.FreeThrowsAttempted {
+		ferrs = append(ferrs, FieldError{Field: "free_throws_made", Message: "must be <= free_throws_attempted"})
+	}
+	if line.ThreePointersMade > line.FieldGoalsMade {
+		ferrs = append(ferrs, FieldError{Field: "three_pointers_made", Message: "must be <= field_goals_made"})
+	}
+	if line.ThreePointersAttempted > line.FieldGoalsAttempted {
+		ferrs = append(ferrs, FieldError{Field: "three_pointers_attempted", Message: "must be <= field_goals_attempted"})
+	}
+	// Three-pointers are counted inside field goals, so each one adds a single point on top of the base two.
+	if want := 2*line.FieldGoalsMade + line.ThreePointersMade + line.FreeThrowsMade; line.Points != want {
+		ferrs = append(ferrs, FieldError{Field: "points", Message: "must equal 2*field_goals_made + three_pointers_made + free_throws_made"})
+	}
+	if line.OffensiveRebounds+line.DefensiveRebounds != line.Rebounds {
+		ferrs = append(ferrs, FieldError{Field: "rebounds", Message: "must equal offensive_rebounds + defensive_rebounds"})
+	}
+	return ferrs
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"errors"
 	"strings"
 	"time"
 
@@ -12,34 +13,31 @@ import (
 
 // teamService holds team use-case logic: validation + orchestration, no transport / SQL details.
 type teamService struct {
-	repo repository.TeamRepository
-	log  zerolog.Logger
+	repo   repository.TeamRepository
+	venues repository.VenueRepository
+	log    zerolog.Logger
 }
 
-func NewTeamService(repo repository.TeamRepository, logger zerolog.Logger) TeamService {
+func NewTeamService(repo repository.TeamRepository, venues repository.VenueRepository, logger zerolog.Logger) TeamService {
 	l := logger.With().Str("module", "service").Str("component", "team").Logger()
-	return &teamService{repo: repo, log: l}
+	return &teamService{repo: repo, venues: venues, log: l}
 }
 
-func (s *teamService) CreateTeam(ctx context.Context, name string) (model.Team, error) {
+func (s *teamService) CreateTeam(ctx context.Context, t model.Team) (model.Team, error) {
 	start := time.Now()
-	original := name
-	name = strings.TrimSpace(name)
+	original := t.Name
+	name := strings.TrimSpace(t.Name)
 
-	var ferrs []FieldError
-	if name == "" {
-		ferrs = append(ferrs, FieldError{Field: "name", Message: "must not be empty"})
-	} else {
-		if ln := len([]rune(name)); ln < 2 || ln > 50 {
-			ferrs = append(ferrs, FieldError{Field: "name", Message: "length must be between 2 and 50"})
-		}
-	}
+	ferrs := append(validateTeamName(name), validateVenueRef(t.HomeVenueID, "home_venue_id")...)
 	if err := NewInvalidInputError(ferrs); err != nil {
 		s.log.Debug().Str("name_raw", original).Interface("field_errors", ferrs).Msg("team validation failed")
 		return model.Team{}, err
 	}
+	if err := s.checkVenueExists(ctx, t.HomeVenueID); err != nil {
+		return model.Team{}, err
+	}
 
-	out, err := s.repo.Create(ctx, model.Team{Name: name})
+	out, err := s.repo.Create(ctx, model.Team{Name: name, HomeVenueID: t.HomeVenueID})
 	if err != nil {
 		// Repository surfaces domain-level errors already, do not wrap.
 		s.log.Error().Err(err).Str("name", name).Msg("create team failed")
@@ -49,6 +47,106 @@ func (s *teamService) CreateTeam(ctx context.Context, name string) (model.Team,
 	return out, nil
 }
 
+// validateTeamName checks an already trimmed team name.
+func validateTeamName(name string) []FieldError {
+	if name == "" {
+		return []FieldError{{Field: "name", Message: "must not be empty"}}
+	}
+	if ln := len([]rune(name)); ln < 2 || ln > 50 {
+		return []FieldError{{Field: "name", Message: "length must be between 2 and 50"}}
+	}
+	return nil
+}
+
+// validateVenueRef checks an optional venue id before it is looked up.
+func validateVenueRef(id *int64, field string) []FieldError {
+	if id != nil && *id <= 0 {
+		return []FieldError{{Field: field, Message: "must be > 0"}}
+	}
+	return nil
+}
+
+// checkVenueExists reports a home venue that does not exist as a field error rather than a foreign key conflict.
+func (s *teamService) checkVenueExists(ctx context.Context, id *int64) error {
+	if id == nil {
+		return nil
+	}
+	if _, err := s.venues.GetByID(ctx, *id); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return NewInvalidInputError([]FieldError{{Field: "home_venue_id", Message: "venue does not exist"}})
+		}
+		return err
+	}
+	return nil
+}
+
+// UpdateTeam applies a partial update; an empty patch returns the team unchanged.
+func (s *teamService) UpdateTeam(ctx context.Context, id int64, patch model.TeamPatch) (model.Team, error) {
+	var ferrs []FieldError
+	if id <= 0 {
+		ferrs = append(ferrs, FieldError{Field: "id", Message: "must be > 0"})
+	}
+	if patch.Name != nil {
+		name := strings.TrimSpace(*patch.Name)
+		patch.Name = &name
+		ferrs = append(ferrs, validateTeamName(name)...)
+	}
+	ferrs = append(ferrs, validateVenueRef(patch.HomeVenueID, "home_venue_id")...)
+	if err := NewInvalidInputError(ferrs); err != nil {
+		return model.Team{}, err
+	}
+
+	team, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return model.Team{}, err
+	}
+	if patch.Name == nil && patch.HomeVenueID == nil {
+		return team, nil
+	}
+	if err := s.checkVenueExists(ctx, patch.HomeVenueID); err != nil {
+		return model.Team{}, err
+	}
+	if patch.Name != nil {
+		team.Name = *patch.Name
+	}
+	if patch.HomeVenueID != nil {
+		team.HomeVenueID = patch.HomeVenueID
+	}
+	out, err := s.repo.Update(ctx, team)
+	if err != nil {
+		if !errors.Is(err, repository.ErrNotFound) && !errors.Is(err, repository.ErrAlreadyExists) {
+			s.log.Error().Err(err).Int64("team_id", id).Msg("update team failed")
+		}
+		return model.Team{}, err
+	}
+	s.log.Info().Int64("team_id", id).Msg("team updated")
+	return out, nil
+}
+
+// DeleteTeam soft-deletes a team; its players and games stay stored.
+func (s *teamService) DeleteTeam(ctx context.Context, id int64) error {
+	if id <= 0 {
+		return NewInvalidInputError([]FieldError{{Field: "id", Message: "must be > 0"}})
+	}
+	if err := s.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+	s.log.Info().Int64("team_id", id).Msg("team deleted")
+	return nil
+}
+
+func (s *teamService) RestoreTeam(ctx context.Context, id int64) (model.Team, error) {
+	if id <= 0 {
+		return model.Team{}, NewInvalidInputError([]FieldError{{Field: "id", Message: "must be > 0"}})
+	}
+	out, err := s.repo.Restore(ctx, id)
+	if err != nil {
+		return model.Team{}, err
+	}
+	s.log.Info().Int64("team_id", id).Msg("team restored")
+	return out, nil
+}
+
 func (s *teamService) GetTeam(ctx context.Context, id int64) (model.Team, error) {
 	if id <= 0 {
 		return model.Team{}, NewInvalidInputError([]FieldError{{Field: "id", Message: "must be > 0"}})
@@ -68,7 +166,7 @@ func (s *teamService) ListTeams(ctx context.Context, page repository.Page) (repo
 
 // GetTeamAggregatedStats retrieves and validates parameters for fetching team statistics.
 // It ensures the team ID is valid and the season format is correct if provided.
-func (s *teamService) GetTeamAggregatedStats(ctx context.Context, teamID int64, season *string) (model.TeamAggregatedStats, error) {
+func (s *teamService) GetTeamAggregatedStats(ctx context.Context, teamID int64, season, phase *string) (model.TeamAggregatedStats, error) {
 	var ferrs []FieldError
 	if teamID <= 0 {
 		ferrs = append(ferrs, FieldError{Field: "id", Message: "must be > 0"})
@@ -77,6 +175,8 @@ func (s *teamService) GetTeamAggregatedStats(ctx context.Context, teamID int64,
 	if season != nil && !IsValidSeason(*season) {
 		ferrs = append(ferrs, FieldError{Field: "season", Message: "must be in YYYY-YY format"})
 	}
+	phase, phaseErrs := validatePhaseFilter(phase)
+	ferrs = append(ferrs, phaseErrs...)
 	if err := NewInvalidInputError(ferrs); err != nil {
 		return model.TeamAggregatedStats{}, err
 	}
@@ -91,7 +191,7 @@ func (s *teamService) GetTeamAggregatedStats(ctx context.Context, teamID int64,
 		return model.TeamAggregatedStats{}, repository.ErrNotFound
 	}
 
-	stats, err := s.repo.GetTeamAggregatedStats(ctx, teamID, season)
+	stats, err := s.repo.GetTeamAggregatedStats(ctx, teamID, season, phase)
 	if err != nil {
 		// Not expecting ErrNotFound here, but logging just in case.
 		s.log.Error().Err(err).Int64("team_id", teamID).Msg("failed to get team aggregated stats")
@@ -100,3 +200,67 @@ func (s *teamService) GetTeamAggregatedStats(ctx context.Context, teamID int64,
 
 	return stats, nil
 }
+
+// GetTeamSplits returns the team's record per bucket of each requested split dimension.
+func (s *teamService) GetTeamSplits(ctx context.Context, teamID int64, season, phase *string, dimensions []string) (model.TeamSplits, error) {
+	var ferrs []FieldError
+	if teamID <= 0 {
+		ferrs = append(ferrs, FieldError{Field: "id", Message: "must be > 0"})
+	}
+	if season != nil && !IsValidSeason(*season) {
+		ferrs = append(ferrs, FieldError{Field: "season", Message: "must be in YYYY-YY format"})
+	}
+	phase, phaseErrs := validatePhaseFilter(phase)
+	ferrs = append(ferrs, phaseErrs...)
+	dimensions, dimErrs := validateSplitDimensions(dimensions)
+	ferrs = append(ferrs, dimErrs...)
+	if err := NewInvalidInputError(ferrs); err != nil {
+		return model.TeamSplits{}, err
+	}
+
+	exists, err := s.repo.Exists(ctx, teamID)
+	if err != nil {
+		s.log.Error().Err(err).Int64("team_id", teamID).Msg("failed to check team existence")
+		return model.TeamSplits{}, err
+	}
+	if !exists {
+		return model.TeamSplits{}, repository.ErrNotFound
+	}
+
+	splits, err := s.repo.GetTeamSplits(ctx, teamID, season, phase, dimensions)
+	if err != nil {
+		s.log.Error().Err(err).Int64("team_id", teamID).Msg("failed to get team splits")
+		return model.TeamSplits{}, err
+	}
+	return model.TeamSplits{TeamID: teamID, Season: season, Phase: phase, Splits: splits}, nil
+}
+
+// GetAttendance reports average home attendance per team and season.
+func (s *teamService) GetAttendance(ctx context.Context, season *string, teamID *int64) ([]model.TeamAttendance, error) {
+	var ferrs []FieldError
+	if season != nil && !IsValidSeason(*season) {
+		ferrs = append(ferrs, FieldError{Field: "season", Message: "must be in YYYY-YY format"})
+	}
+	if teamID != nil && *teamID <= 0 {
+		ferrs = append(ferrs, FieldError{Field: "team_id", Message: "must be > 0"})
+	}
+	if err := NewInvalidInputError(ferrs); err != nil {
+		return nil, err
+	}
+	if teamID != nil {
+		exists, err := s.repo.Exists(ctx, *teamID)
+		if err != nil {
+			s.log.Error().Err(err).Int64("team_id", *teamID).Msg("failed to check team existence")
+			return nil, err
+		}
+		if !exists {
+			return nil, repository.ErrNotFound
+		}
+	}
+	res, err := s.repo.ListAttendance(ctx, season, teamID)
+	if err != nil {
+		s.log.Error().Err(err).Msg("list attendance failed")
+		return nil, err
+	}
+	return res, nil
+}
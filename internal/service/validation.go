@@ -2,6 +2,7 @@ package service
 
 import (
 	"regexp"
+	"slices"
 	"strings"
 
 	"github.com/maxviazov/basketball-stats-service/internal/repository"
@@ -12,6 +13,31 @@ const (
 	maxLimit     = 100
 )
 
+// Season phases. Games default to the regular season.
+const (
+	phasePreseason = "preseason"
+	phaseRegular   = "regular"
+	phasePlayoffs  = "playoffs"
+)
+
+// Game statuses. A game starts out scheduled and moves only along gameTransitions.
+const (
+	statusScheduled  = "scheduled"
+	statusInProgress = "in_progress"
+	statusFinished   = "finished"
+	statusPostponed  = "postponed"
+	statusCancelled  = "cancelled"
+)
+
+// gameTransitions lists the statuses each status may move to; finished, postponed and cancelled are final.
+var gameTransitions = map[string][]string{
+	statusScheduled:  {statusInProgress, statusPostponed, statusCancelled},
+	statusInProgress: {statusFinished},
+}
+
+// splitDimensions are the supported split dimensions, in the order splits are returned.
+var splitDimensions = []string{"location", "result", "month", "opponent", "rest"}
+
 var seasonRe = regexp.MustCompile(`^\d{4}-\d{2}$`)
 
 func normalizePage(p repository.Page) repository.Page {
@@ -43,17 +69,75 @@ func isValidPosition(pos string) bool {
 
 func normalizeStatus(status string) string { return strings.ToLower(strings.TrimSpace(status)) }
 
+// isValidGameStatus reports whether a game may be created with status. A game created in progress or finished
+// still starts out scheduled and is moved there through recorded transitions; postponed and cancelled are only
+// reached through an explicit transition.
 func isValidGameStatus(status string) bool {
 	switch normalizeStatus(status) {
-	case "scheduled", "in_progress", "finished":
+	case statusScheduled, statusInProgress, statusFinished:
 		return true
 	default:
 		return false
 	}
 }
 
+func isKnownGameStatus(status string) bool {
+	return isValidGameStatus(status) || status == statusPostponed || status == statusCancelled
+}
+
+func canTransition(from, to string) bool { return slices.Contains(gameTransitions[from], to) }
+
+// acceptsStats reports whether stat lines may be recorded for a game with status.
+func acceptsStats(status string) bool { return status == statusInProgress || status == statusFinished }
+
+func normalizePhase(phase string) string { return strings.ToLower(strings.TrimSpace(phase)) }
+
+func isValidPhase(phase string) bool {
+	switch normalizePhase(phase) {
+	case phasePreseason, phaseRegular, phasePlayoffs:
+		return true
+	default:
+		return false
+	}
+}
+
+// validatePhaseFilter checks an optional phase query filter and returns its canonical form.
+func validatePhaseFilter(phase *string) (*string, []FieldError) {
+	if phase == nil {
+		return nil, nil
+	}
+	p := normalizePhase(*phase)
+	if !isValidPhase(p) {
+		return nil, []FieldError{{Field: "phase", Message: "must be one of preseason|regular|playoffs"}}
+	}
+	return &p, nil
+}
+
 // IsValidSeason checks if the season string conforms to the YYYY-YY format.
 func IsValidSeason(season string) bool {
 	s := strings.TrimSpace(season)
 	return seasonRe.MatchString(s)
 }
+
+// validateSplitDimensions checks the requested split dimensions and returns them in canonical order without duplicates.
+// No dimensions means all of them.
+func validateSplitDimensions(dims []string) ([]string, []FieldError) {
+	if len(dims) == 0 {
+		return splitDimensions, nil
+	}
+	want := make(map[string]bool, len(dims))
+	for _, d := range dims {
+		d = strings.ToLower(strings.TrimSpace(d))
+		if !slices.Contains(splitDimensions, d) {
+			return nil, []FieldError{{Field: "by", Message: "must be a list of " + strings.Join(splitDimensions, "|")}}
+		}
+		want[d] = true
+	}
+	out := make([]string, 0, len(want))
+	for _, d := range splitDimensions {
+		if want[d] {
+			out = append(out, d)
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,149 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+	_ "time/tzdata" // venue timezones must resolve the same way on hosts without a zoneinfo database
+
+	"github.com/maxviazov/basketball-stats-service/internal/model"
+	"github.com/maxviazov/basketball-stats-service/internal/repository"
+	"github.com/rs/zerolog"
+)
+
+// maxVenueCapacity keeps obvious typos out; the largest basketball crowds on record are well below it.
+const maxVenueCapacity = 200000
+
+type venueService struct {
+	venues repository.VenueRepository
+	log    zerolog.Logger
+}
+
+func NewVenueService(venues repository.VenueRepository, logger zerolog.Logger) VenueService {
+	l := logger.With().Str("module", "service").Str("component", "venue").Logger()
+	return &venueService{venues: venues, log: l}
+}
+
+func (s *venueService) CreateVenue(ctx context.Context, v model.Venue) (model.Venue, error) {
+	v.Name = strings.TrimSpace(v.Name)
+	v.City = strings.TrimSpace(v.City)
+	v.Timezone = strings.TrimSpace(v.Timezone)
+	if err := NewInvalidInputError(validateVenue(v)); err != nil {
+		return model.Venue{}, err
+	}
+	out, err := s.venues.Create(ctx, v)
+	if err != nil {
+		if !errors.Is(err, repository.ErrAlreadyExists) {
+			s.log.Error().Err(err).Str("name", v.Name).Msg("create venue failed")
+		}
+		return model.Venue{}, err
+	}
+	s.log.Info().Int64("venue_id", out.ID).Str("name", out.Name).Msg("venue created")
+	return out, nil
+}
+
+func (s *venueService) GetVenue(ctx context.Context, id int64) (model.Venue, error) {
+	if id <= 0 {
+		return model.Venue{}, NewInvalidInputError([]FieldError{{Field: "id", Message: "must be > 0"}})
+	}
+	return s.venues.GetByID(ctx, id)
+}
+
+func (s *venueService) ListVenues(ctx context.Context, page repository.Page) (repository.PageResult[model.Venue], error) {
+	p := normalizePage(page)
+	res, err := s.venues.List(ctx, p)
+	if err != nil {
+		s.log.Error().Err(err).Int("limit", p.Limit).Int("offset", p.Offset).Msg("list venues failed")
+		return repository.PageResult[model.Venue]{}, err
+	}
+	return res, nil
+}
+
+// UpdateVenue merges the patch into the stored venue and validates the result as on creation.
+func (s *venueService) UpdateVenue(ctx context.Context, id int64, patch model.VenuePatch) (model.Venue, error) {
+	if id <= 0 {
+		return model.Venue{}, NewInvalidInputError([]FieldError{{Field: "id", Message: "must be > 0"}})
+	}
+	v, err := s.venues.GetByID(ctx, id)
+	if err != nil {
+		return model.Venue{}, err
+	}
+	if patch.Name != nil {
+		v.Name = strings.TrimSpace(*patch.Name)
+	}
+	if patch.City != nil {
+		v.City = strings.TrimSpace(*patch.City)
+	}
+	if patch.Capacity != nil {
+		v.Capacity = *patch.Capacity
+	}
+	if patch.Timezone != nil {
+		v.Timezone = strings.TrimSpace(*patch.Timezone)
+	}
+	if patch.Latitude != nil {
+		v.Latitude = patch.Latitude
+	}
+	if patch.Longitude != nil {
+		v.Longitude = patch.Longitude
+	}
+	if err := NewInvalidInputError(validateVenue(v)); err != nil {
+		return model.Venue{}, err
+	}
+	out, err := s.venues.Update(ctx, v)
+	if err != nil {
+		if !errors.Is(err, repository.ErrNotFound) && !errors.Is(err, repository.ErrAlreadyExists) {
+			s.log.Error().Err(err).Int64("venue_id", id).Msg("update venue failed")
+		}
+		return model.Venue{}, err
+	}
+	s.log.Info().Int64("venue_id", id).Msg("venue updated")
+	return out, nil
+}
+
+func (s *venueService) DeleteVenue(ctx context.Context, id int64) error {
+	if id <= 0 {
+		return NewInvalidInputError([]FieldError{{Field: "id", Message: "must be > 0"}})
+	}
+	if err := s.venues.Delete(ctx, id); err != nil {
+		return err
+	}
+	s.log.Info().Int64("venue_id", id).Msg("venue deleted")
+	return nil
+}
+
+// validateVenue checks an already trimmed venue.
+func validateVenue(v model.Venue) []FieldError {
+	var ferrs []FieldError
+	if ln := len([]rune(v.Name)); ln < 2 || ln > 100 {
+		ferrs = append(ferrs, FieldError{Field: "name", Message: "length must be between 2 and 100"})
+	}
+	if ln := len([]rune(v.City)); ln < 1 || ln > 100 {
+		ferrs = append(ferrs, FieldError{Field: "city", Message: "length must be between 1 and 100"})
+	}
+	if v.Capacity < 1 || v.Capacity > maxVenueCapacity {
+		ferrs = append(ferrs, FieldError{Field: "capacity", Message: "must be between 1 and 200000"})
+	}
+	if !isValidTimezone(v.Timezone) {
+		ferrs = append(ferrs, FieldError{Field: "timezone", Message: "must be an IANA timezone, e.g. America/New_York"})
+	}
+	if (v.Latitude == nil) != (v.Longitude == nil) {
+		ferrs = append(ferrs, FieldError{Field: "coordinates", Message: "latitude and longitude go together"})
+	}
+	if v.Latitude != nil && (*v.Latitude < -90 || *v.Latitude > 90) {
+		ferrs = append(ferrs, FieldError{Field: "latitude", Message: "must be between -90 and 90"})
+	}
+	if v.Longitude != nil && (*v.Longitude < -180 || *v.Longitude > 180) {
+		ferrs = append(ferrs, FieldError{Field: "longitude", Message: "must be between -180 and 180"})
+	}
+	return ferrs
+}
+
+// isValidTimezone accepts IANA names only. The empty name and "Local" load fine but depend on the host.
+func isValidTimezone(name string) bool {
+	if name == "" || name == "Local" {
+		return false
+	}
+	_, err := time.LoadLocation(name)
+	return err == nil
+}
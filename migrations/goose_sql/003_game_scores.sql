@@ -0,0 +1,46 @@
+-- +goose Up
+-- Official final and per-period scores stored on the game itself.
+-- Player points are no longer the source of truth for results; they are reconciled against these.
+ALTER TABLE games
+    ADD COLUMN IF NOT EXISTS home_score INT CHECK (home_score >= 0),
+    ADD COLUMN IF NOT EXISTS away_score INT CHECK (away_score >= 0);
+
+-- A finished game cannot end in a tie.
+ALTER TABLE games
+    ADD CONSTRAINT games_finished_not_tied
+    CHECK (status <> 'finished' OR home_score IS NULL OR away_score IS NULL OR home_score <> away_score);
+
+-- Line score: periods 1..N, where anything past regulation is an overtime.
+CREATE TABLE IF NOT EXISTS game_period_scores (
+    game_id INT NOT NULL REFERENCES games(id) ON DELETE CASCADE,
+    period INT NOT NULL CHECK (period >= 1),
+    home_points INT NOT NULL DEFAULT 0 CHECK (home_points >= 0),
+    away_points INT NOT NULL DEFAULT 0 CHECK (away_points >= 0),
+    PRIMARY KEY (game_id, period)
+);
+
+-- game_results is the single place that decides winners and losers.
+-- Only finished games with an official score are counted.
+CREATE OR REPLACE VIEW game_results AS
+SELECT
+    g.id AS game_id,
+    g.season,
+    g.date,
+    g.home_team_id,
+    g.away_team_id,
+    g.home_score AS home_points,
+    g.away_score AS away_points,
+    CASE WHEN g.home_score > g.away_score THEN g.home_team_id ELSE g.away_team_id END AS winner_id,
+    CASE WHEN g.home_score > g.away_score THEN g.away_team_id ELSE g.home_team_id END AS loser_id
+FROM games g
+WHERE g.status = 'finished'
+  AND g.home_score IS NOT NULL
+  AND g.away_score IS NOT NULL;
+
+-- +goose Down
+DROP VIEW IF EXISTS game_results;
+DROP TABLE IF EXISTS game_period_scores;
+ALTER TABLE games DROP CONSTRAINT IF EXISTS games_finished_not_tied;
+ALTER TABLE games
+    DROP COLUMN IF EXISTS home_score,
+    DROP COLUMN IF EXISTS away_score;
@@ -0,0 +1,37 @@
+-- +goose Up
+-- Shooting splits and rebound breakdown on player stat lines.
+-- Cross-column invariants (points formula, OREB + DREB = REB) are enforced in the service layer,
+-- since legacy rows predate these columns; only the per-pair bounds live here.
+ALTER TABLE player_stats
+    ADD COLUMN IF NOT EXISTS field_goals_made INT NOT NULL DEFAULT 0 CHECK (field_goals_made >= 0),
+    ADD COLUMN IF NOT EXISTS field_goals_attempted INT NOT NULL DEFAULT 0 CHECK (field_goals_attempted >= 0),
+    ADD COLUMN IF NOT EXISTS three_pointers_made INT NOT NULL DEFAULT 0 CHECK (three_pointers_made >= 0),
+    ADD COLUMN IF NOT EXISTS three_pointers_attempted INT NOT NULL DEFAULT 0 CHECK (three_pointers_attempted >= 0),
+    ADD COLUMN IF NOT EXISTS free_throws_made INT NOT NULL DEFAULT 0 CHECK (free_throws_made >= 0),
+    ADD COLUMN IF NOT EXISTS free_throws_attempted INT NOT NULL DEFAULT 0 CHECK (free_throws_attempted >= 0),
+    ADD COLUMN IF NOT EXISTS offensive_rebounds INT NOT NULL DEFAULT 0 CHECK (offensive_rebounds >= 0),
+    ADD COLUMN IF NOT EXISTS defensive_rebounds INT NOT NULL DEFAULT 0 CHECK (defensive_rebounds >= 0);
+
+ALTER TABLE player_stats
+    ADD CONSTRAINT player_stats_fg_made_le_attempted CHECK (field_goals_made <= field_goals_attempted),
+    ADD CONSTRAINT player_stats_3p_made_le_attempted CHECK (three_pointers_made <= three_pointers_attempted),
+    ADD CONSTRAINT player_stats_ft_made_le_attempted CHECK (free_throws_made <= free_throws_attempted),
+    ADD CONSTRAINT player_stats_3p_within_fg CHECK (
+        three_pointers_made <= field_goals_made AND three_pointers_attempted <= field_goals_attempted
+    );
+
+-- +goose Down
+ALTER TABLE player_stats
+    DROP CONSTRAINT IF EXISTS player_stats_3p_within_fg,
+    DROP CONSTRAINT IF EXISTS player_stats_ft_made_le_attempted,
+    DROP CONSTRAINT IF EXISTS player_stats_3p_made_le_attempted,
+    DROP CONSTRAINT IF EXISTS player_stats_fg_made_le_attempted;
+ALTER TABLE player_stats
+    DROP COLUMN IF EXISTS defensive_rebounds,
+    DROP COLUMN IF EXISTS offensive_rebounds,
+    DROP COLUMN IF EXISTS free_throws_attempted,
+    DROP COLUMN IF EXISTS free_throws_made,
+    DROP COLUMN IF EXISTS three_pointers_attempted,
+    DROP COLUMN IF EXISTS three_pointers_made,
+    DROP COLUMN IF EXISTS field_goals_attempted,
+    DROP COLUMN IF EXISTS field_goals_made;
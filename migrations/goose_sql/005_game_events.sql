@@ -0,0 +1,30 @@
+-- +goose Up
+-- Play-by-play event stream. Box score lines in player_stats are derived from these events
+-- by the service layer, inside the same transaction as the event write.
+CREATE TABLE IF NOT EXISTS game_events (
+    id SERIAL PRIMARY KEY,
+    game_id INT NOT NULL REFERENCES games(id) ON DELETE CASCADE,
+    period INT NOT NULL CHECK (period >= 1),
+    -- Game clock: seconds remaining in the period when the event happened.
+    clock_seconds INT NOT NULL CHECK (clock_seconds >= 0),
+    team_id INT NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    player_id INT NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    event_type TEXT NOT NULL CHECK (event_type IN (
+        'two_pt_made', 'two_pt_missed', 'three_pt_made', 'three_pt_missed',
+        'free_throw_made', 'free_throw_missed',
+        'offensive_rebound', 'defensive_rebound',
+        'assist', 'steal', 'block', 'turnover', 'foul',
+        'substitution_in', 'substitution_out'
+    )),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Replay order within a game: period ascending, clock descending.
+CREATE INDEX IF NOT EXISTS idx_game_events_game_order ON game_events(game_id, period, clock_seconds DESC, id);
+CREATE INDEX IF NOT EXISTS idx_game_events_player_game ON game_events(player_id, game_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_game_events_player_game;
+DROP INDEX IF EXISTS idx_game_events_game_order;
+DROP TABLE IF EXISTS game_events;
@@ -0,0 +1,43 @@
+-- +goose Up
+-- Roster history. players.team_id stays as the player's current team; which team a stat line
+-- belongs to is decided by the membership that was valid on the game's date.
+-- Periods are half-open: [start_date, end_date).
+CREATE TABLE IF NOT EXISTS player_team_memberships (
+    id SERIAL PRIMARY KEY,
+    player_id INT NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    team_id INT NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    -- NULL start: since the player was registered. NULL end: current membership.
+    start_date DATE,
+    end_date DATE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CHECK (start_date IS NULL OR end_date IS NULL OR end_date > start_date)
+);
+
+-- At most one open membership per player; transfers close it before opening the next one.
+CREATE UNIQUE INDEX IF NOT EXISTS ux_memberships_player_current ON player_team_memberships(player_id) WHERE end_date IS NULL;
+CREATE INDEX IF NOT EXISTS idx_memberships_team ON player_team_memberships(team_id);
+CREATE INDEX IF NOT EXISTS idx_memberships_player ON player_team_memberships(player_id, start_date);
+
+-- Every existing player has been with their team since registration.
+INSERT INTO player_team_memberships (player_id, team_id)
+SELECT p.id, p.team_id
+FROM players p
+WHERE NOT EXISTS (SELECT 1 FROM player_team_memberships m WHERE m.player_id = p.id);
+
+-- player_game_teams resolves the team of every stat line through the membership valid on the game date.
+-- Lines of a player without a matching membership are left out rather than guessed.
+CREATE OR REPLACE VIEW player_game_teams AS
+SELECT ps.player_id, ps.game_id, m.team_id
+FROM player_stats ps
+JOIN games g ON g.id = ps.game_id
+JOIN player_team_memberships m
+  ON m.player_id = ps.player_id
+ AND (m.start_date IS NULL OR m.start_date <= g.date)
+ AND (m.end_date IS NULL OR g.date < m.end_date);
+
+-- +goose Down
+DROP VIEW IF EXISTS player_game_teams;
+DROP INDEX IF EXISTS idx_memberships_player;
+DROP INDEX IF EXISTS idx_memberships_team;
+DROP INDEX IF EXISTS ux_memberships_player_current;
+DROP TABLE IF EXISTS player_team_memberships;
@@ -0,0 +1,90 @@
+-- +goose Up
+-- Seasons become first-class: a named date range split into phases.
+-- games.season keeps the season name for filtering; season_id is the reference.
+CREATE TABLE IF NOT EXISTS seasons (
+    id SERIAL PRIMARY KEY,
+    name TEXT NOT NULL UNIQUE,
+    start_date DATE NOT NULL,
+    end_date DATE NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CHECK (end_date >= start_date)
+);
+
+-- Phase date ranges are inclusive on both ends, like the season's.
+CREATE TABLE IF NOT EXISTS season_phases (
+    season_id INT NOT NULL REFERENCES seasons(id) ON DELETE CASCADE,
+    phase TEXT NOT NULL CHECK (phase IN ('preseason', 'regular', 'playoffs')),
+    start_date DATE NOT NULL,
+    end_date DATE NOT NULL,
+    PRIMARY KEY (season_id, phase),
+    CHECK (end_date >= start_date)
+);
+
+-- Backfill one season per existing season name, spanning its games, with a single regular phase.
+INSERT INTO seasons (name, start_date, end_date)
+SELECT season, MIN(date)::DATE, MAX(date)::DATE
+FROM games
+GROUP BY season
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO season_phases (season_id, phase, start_date, end_date)
+SELECT id, 'regular', start_date, end_date FROM seasons
+ON CONFLICT DO NOTHING;
+
+ALTER TABLE games
+    ADD COLUMN IF NOT EXISTS season_id INT,
+    ADD COLUMN IF NOT EXISTS phase TEXT NOT NULL DEFAULT 'regular';
+
+UPDATE games g SET season_id = s.id FROM seasons s WHERE s.name = g.season AND g.season_id IS NULL;
+
+ALTER TABLE games ALTER COLUMN season_id SET NOT NULL;
+-- A game can only be played in a phase its season actually has.
+ALTER TABLE games
+    ADD CONSTRAINT games_season_phase_fk
+    FOREIGN KEY (season_id, phase) REFERENCES season_phases(season_id, phase);
+
+CREATE INDEX IF NOT EXISTS idx_games_season_phase ON games(season_id, phase);
+
+-- game_results gains the phase so results can be split into regular season and playoffs.
+CREATE OR REPLACE VIEW game_results AS
+SELECT
+    g.id AS game_id,
+    g.season,
+    g.date,
+    g.home_team_id,
+    g.away_team_id,
+    g.home_score AS home_points,
+    g.away_score AS away_points,
+    CASE WHEN g.home_score > g.away_score THEN g.home_team_id ELSE g.away_team_id END AS winner_id,
+    CASE WHEN g.home_score > g.away_score THEN g.away_team_id ELSE g.home_team_id END AS loser_id,
+    g.phase
+FROM games g
+WHERE g.status = 'finished'
+  AND g.home_score IS NOT NULL
+  AND g.away_score IS NOT NULL;
+
+-- +goose Down
+DROP VIEW IF EXISTS game_results;
+CREATE VIEW game_results AS
+SELECT
+    g.id AS game_id,
+    g.season,
+    g.date,
+    g.home_team_id,
+    g.away_team_id,
+    g.home_score AS home_points,
+    g.away_score AS away_points,
+    CASE WHEN g.home_score > g.away_score THEN g.home_team_id ELSE g.away_team_id END AS winner_id,
+    CASE WHEN g.home_score > g.away_score THEN g.away_team_id ELSE g.home_team_id END AS loser_id
+FROM games g
+WHERE g.status = 'finished'
+  AND g.home_score IS NOT NULL
+  AND g.away_score IS NOT NULL;
+DROP INDEX IF EXISTS idx_games_season_phase;
+ALTER TABLE games DROP CONSTRAINT IF EXISTS games_season_phase_fk;
+ALTER TABLE games
+    DROP COLUMN IF EXISTS season_id,
+    DROP COLUMN IF EXISTS phase;
+DROP TABLE IF EXISTS season_phases;
+DROP TABLE IF EXISTS seasons;
@@ -0,0 +1,26 @@
+-- +goose Up
+-- Statistical feats detected by the service layer whenever a stat line is written, in the same transaction.
+-- Single-game feats (double-doubles, 40-point games, ...) belong to the line they were detected in;
+-- career milestones are recorded in the game where the running total first reached them.
+CREATE TABLE IF NOT EXISTS player_achievements (
+    id SERIAL PRIMARY KEY,
+    player_id INT NOT NULL,
+    game_id INT NOT NULL,
+    kind TEXT NOT NULL CHECK (kind IN (
+        'double_double', 'triple_double', 'forty_points', 'twenty_rebounds',
+        'career_points', 'career_rebounds', 'career_assists'
+    )),
+    -- The stat value for single-game feats, the threshold reached for milestones.
+    value INT NOT NULL,
+    milestone BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    -- Removing a line removes its feats; milestones are recomputed by the service afterwards.
+    FOREIGN KEY (player_id, game_id) REFERENCES player_stats(player_id, game_id) ON DELETE CASCADE,
+    UNIQUE (player_id, game_id, kind, value)
+);
+
+CREATE INDEX IF NOT EXISTS idx_achievements_game ON player_achievements(game_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_achievements_game;
+DROP TABLE IF EXISTS player_achievements;
@@ -0,0 +1,24 @@
+-- +goose Up
+-- Fuzzy name search: trigram matching on lower-cased, unaccented names, so "Doncic" finds "Dončić"
+-- and small typos still match.
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+CREATE EXTENSION IF NOT EXISTS unaccent;
+
+-- unaccent() is only STABLE because its dictionary could change, which rules it out for index expressions.
+-- Pinning the dictionary makes the wrapper safe to declare IMMUTABLE.
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION search_key(txt TEXT) RETURNS TEXT
+LANGUAGE sql IMMUTABLE PARALLEL SAFE STRICT AS $$
+    SELECT lower(public.unaccent('public.unaccent'::regdictionary, txt))
+$$;
+-- +goose StatementEnd
+
+CREATE INDEX IF NOT EXISTS idx_teams_name_search ON teams USING gin (search_key(name) gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_players_name_search ON players USING gin (search_key(first_name || ' ' || last_name) gin_trgm_ops);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_players_name_search;
+DROP INDEX IF EXISTS idx_teams_name_search;
+DROP FUNCTION IF EXISTS search_key(TEXT);
+DROP EXTENSION IF EXISTS unaccent;
+DROP EXTENSION IF EXISTS pg_trgm;
@@ -0,0 +1,132 @@
+-- +goose Up
+-- Soft delete for teams, players and games. A deleted row keeps its id and everything that references it,
+-- so a restore brings the whole history back; reads filter on deleted_at IS NULL instead.
+ALTER TABLE teams ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+ALTER TABLE players ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+ALTER TABLE games ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+
+-- A deleted team releases its name; restoring it fails while another active team uses the name.
+ALTER TABLE teams DROP CONSTRAINT IF EXISTS teams_name_key;
+CREATE UNIQUE INDEX IF NOT EXISTS ux_teams_name_active ON teams(name) WHERE deleted_at IS NULL;
+
+-- Hard deletes must never wipe stat history again: every reference to a team, player or game now restricts.
+ALTER TABLE players
+    DROP CONSTRAINT IF EXISTS players_team_id_fkey,
+    ADD CONSTRAINT players_team_id_fkey FOREIGN KEY (team_id) REFERENCES teams(id) ON DELETE RESTRICT;
+ALTER TABLE games
+    DROP CONSTRAINT IF EXISTS games_home_team_id_fkey,
+    ADD CONSTRAINT games_home_team_id_fkey FOREIGN KEY (home_team_id) REFERENCES teams(id) ON DELETE RESTRICT,
+    DROP CONSTRAINT IF EXISTS games_away_team_id_fkey,
+    ADD CONSTRAINT games_away_team_id_fkey FOREIGN KEY (away_team_id) REFERENCES teams(id) ON DELETE RESTRICT;
+ALTER TABLE player_stats
+    DROP CONSTRAINT IF EXISTS player_stats_player_id_fkey,
+    ADD CONSTRAINT player_stats_player_id_fkey FOREIGN KEY (player_id) REFERENCES players(id) ON DELETE RESTRICT,
+    DROP CONSTRAINT IF EXISTS player_stats_game_id_fkey,
+    ADD CONSTRAINT player_stats_game_id_fkey FOREIGN KEY (game_id) REFERENCES games(id) ON DELETE RESTRICT;
+ALTER TABLE game_period_scores
+    DROP CONSTRAINT IF EXISTS game_period_scores_game_id_fkey,
+    ADD CONSTRAINT game_period_scores_game_id_fkey FOREIGN KEY (game_id) REFERENCES games(id) ON DELETE RESTRICT;
+ALTER TABLE game_events
+    DROP CONSTRAINT IF EXISTS game_events_game_id_fkey,
+    ADD CONSTRAINT game_events_game_id_fkey FOREIGN KEY (game_id) REFERENCES games(id) ON DELETE RESTRICT,
+    DROP CONSTRAINT IF EXISTS game_events_team_id_fkey,
+    ADD CONSTRAINT game_events_team_id_fkey FOREIGN KEY (team_id) REFERENCES teams(id) ON DELETE RESTRICT,
+    DROP CONSTRAINT IF EXISTS game_events_player_id_fkey,
+    ADD CONSTRAINT game_events_player_id_fkey FOREIGN KEY (player_id) REFERENCES players(id) ON DELETE RESTRICT;
+ALTER TABLE player_team_memberships
+    DROP CONSTRAINT IF EXISTS player_team_memberships_player_id_fkey,
+    ADD CONSTRAINT player_team_memberships_player_id_fkey FOREIGN KEY (player_id) REFERENCES players(id) ON DELETE RESTRICT,
+    DROP CONSTRAINT IF EXISTS player_team_memberships_team_id_fkey,
+    ADD CONSTRAINT player_team_memberships_team_id_fkey FOREIGN KEY (team_id) REFERENCES teams(id) ON DELETE RESTRICT;
+
+-- Both views feed standings and every aggregate, so deleted games and players drop out of them here.
+CREATE OR REPLACE VIEW game_results AS
+SELECT
+    g.id AS game_id,
+    g.season,
+    g.date,
+    g.home_team_id,
+    g.away_team_id,
+    g.home_score AS home_points,
+    g.away_score AS away_points,
+    CASE WHEN g.home_score > g.away_score THEN g.home_team_id ELSE g.away_team_id END AS winner_id,
+    CASE WHEN g.home_score > g.away_score THEN g.away_team_id ELSE g.home_team_id END AS loser_id,
+    g.phase
+FROM games g
+WHERE g.status = 'finished'
+  AND g.home_score IS NOT NULL
+  AND g.away_score IS NOT NULL
+  AND g.deleted_at IS NULL;
+
+CREATE OR REPLACE VIEW player_game_teams AS
+SELECT ps.player_id, ps.game_id, m.team_id
+FROM player_stats ps
+JOIN games g ON g.id = ps.game_id AND g.deleted_at IS NULL
+JOIN players p ON p.id = ps.player_id AND p.deleted_at IS NULL
+JOIN player_team_memberships m
+  ON m.player_id = ps.player_id
+ AND (m.start_date IS NULL OR m.start_date <= g.date)
+ AND (m.end_date IS NULL OR g.date < m.end_date);
+
+-- +goose Down
+CREATE OR REPLACE VIEW player_game_teams AS
+SELECT ps.player_id, ps.game_id, m.team_id
+FROM player_stats ps
+JOIN games g ON g.id = ps.game_id
+JOIN player_team_memberships m
+  ON m.player_id = ps.player_id
+ AND (m.start_date IS NULL OR m.start_date <= g.date)
+ AND (m.end_date IS NULL OR g.date < m.end_date);
+
+CREATE OR REPLACE VIEW game_results AS
+SELECT
+    g.id AS game_id,
+    g.season,
+    g.date,
+    g.home_team_id,
+    g.away_team_id,
+    g.home_score AS home_points,
+    g.away_score AS away_points,
+    CASE WHEN g.home_score > g.away_score THEN g.home_team_id ELSE g.away_team_id END AS winner_id,
+    CASE WHEN g.home_score > g.away_score THEN g.away_team_id ELSE g.home_team_id END AS loser_id,
+    g.phase
+FROM games g
+WHERE g.status = 'finished'
+  AND g.home_score IS NOT NULL
+  AND g.away_score IS NOT NULL;
+
+ALTER TABLE player_team_memberships
+    DROP CONSTRAINT IF EXISTS player_team_memberships_player_id_fkey,
+    ADD CONSTRAINT player_team_memberships_player_id_fkey FOREIGN KEY (player_id) REFERENCES players(id) ON DELETE CASCADE,
+    DROP CONSTRAINT IF EXISTS player_team_memberships_team_id_fkey,
+    ADD CONSTRAINT player_team_memberships_team_id_fkey FOREIGN KEY (team_id) REFERENCES teams(id) ON DELETE CASCADE;
+ALTER TABLE game_events
+    DROP CONSTRAINT IF EXISTS game_events_game_id_fkey,
+    ADD CONSTRAINT game_events_game_id_fkey FOREIGN KEY (game_id) REFERENCES games(id) ON DELETE CASCADE,
+    DROP CONSTRAINT IF EXISTS game_events_team_id_fkey,
+    ADD CONSTRAINT game_events_team_id_fkey FOREIGN KEY (team_id) REFERENCES teams(id) ON DELETE CASCADE,
+    DROP CONSTRAINT IF EXISTS game_events_player_id_fkey,
+    ADD CONSTRAINT game_events_player_id_fkey FOREIGN KEY (player_id) REFERENCES players(id) ON DELETE CASCADE;
+ALTER TABLE game_period_scores
+    DROP CONSTRAINT IF EXISTS game_period_scores_game_id_fkey,
+    ADD CONSTRAINT game_period_scores_game_id_fkey FOREIGN KEY (game_id) REFERENCES games(id) ON DELETE CASCADE;
+ALTER TABLE player_stats
+    DROP CONSTRAINT IF EXISTS player_stats_player_id_fkey,
+    ADD CONSTRAINT player_stats_player_id_fkey FOREIGN KEY (player_id) REFERENCES players(id) ON DELETE CASCADE,
+    DROP CONSTRAINT IF EXISTS player_stats_game_id_fkey,
+    ADD CONSTRAINT player_stats_game_id_fkey FOREIGN KEY (game_id) REFERENCES games(id) ON DELETE CASCADE;
+ALTER TABLE games
+    DROP CONSTRAINT IF EXISTS games_home_team_id_fkey,
+    ADD CONSTRAINT games_home_team_id_fkey FOREIGN KEY (home_team_id) REFERENCES teams(id) ON DELETE CASCADE,
+    DROP CONSTRAINT IF EXISTS games_away_team_id_fkey,
+    ADD CONSTRAINT games_away_team_id_fkey FOREIGN KEY (away_team_id) REFERENCES teams(id) ON DELETE CASCADE;
+ALTER TABLE players
+    DROP CONSTRAINT IF EXISTS players_team_id_fkey,
+    ADD CONSTRAINT players_team_id_fkey FOREIGN KEY (team_id) REFERENCES teams(id) ON DELETE CASCADE;
+
+DROP INDEX IF EXISTS ux_teams_name_active;
+ALTER TABLE teams ADD CONSTRAINT teams_name_key UNIQUE (name);
+
+ALTER TABLE games DROP COLUMN IF EXISTS deleted_at;
+ALTER TABLE players DROP COLUMN IF EXISTS deleted_at;
+ALTER TABLE teams DROP COLUMN IF EXISTS deleted_at;
@@ -0,0 +1,24 @@
+-- +goose Up
+-- Game lifecycle: scheduled -> in_progress -> finished, or scheduled -> postponed / cancelled.
+-- The allowed moves live in the service; the table only records which ones happened and when.
+ALTER TABLE games DROP CONSTRAINT IF EXISTS games_status_check;
+ALTER TABLE games ADD CONSTRAINT games_status_check
+    CHECK (status IN ('scheduled', 'in_progress', 'finished', 'postponed', 'cancelled'));
+
+CREATE TABLE IF NOT EXISTS game_status_transitions (
+    id BIGSERIAL PRIMARY KEY,
+    game_id INT NOT NULL REFERENCES games(id) ON DELETE RESTRICT,
+    from_status TEXT NOT NULL,
+    to_status TEXT NOT NULL,
+    transitioned_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_game_status_transitions_game ON game_status_transitions(game_id, transitioned_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS game_status_transitions;
+
+UPDATE games SET status = 'scheduled' WHERE status IN ('postponed', 'cancelled');
+ALTER TABLE games DROP CONSTRAINT IF EXISTS games_status_check;
+ALTER TABLE games ADD CONSTRAINT games_status_check
+    CHECK (status IN ('scheduled', 'in_progress', 'finished'));
@@ -0,0 +1,82 @@
+-- +goose Up
+-- Rule profiles describe how a competition is played: clock, periods, overtimes, foul-out limit and roster size.
+-- Seasons carry a default profile and every game stores the one it is played under.
+CREATE TABLE IF NOT EXISTS rule_profiles (
+    id SERIAL PRIMARY KEY,
+    name TEXT NOT NULL UNIQUE,
+    period_minutes INT NOT NULL CHECK (period_minutes > 0),
+    periods INT NOT NULL CHECK (periods > 0),
+    overtime_minutes INT NOT NULL CHECK (overtime_minutes > 0),
+    foul_limit INT NOT NULL CHECK (foul_limit > 0),
+    roster_size INT NOT NULL CHECK (roster_size >= 5),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+INSERT INTO rule_profiles (name, period_minutes, periods, overtime_minutes, foul_limit, roster_size) VALUES
+    ('nba', 12, 4, 5, 6, 15),
+    ('fiba', 10, 4, 5, 5, 12),
+    ('ncaa', 20, 2, 5, 5, 15),
+    ('youth', 8, 4, 4, 5, 12)
+ON CONFLICT (name) DO NOTHING;
+
+-- Existing seasons and games were all played under NBA rules, which the old hard limits encoded.
+ALTER TABLE seasons ADD COLUMN IF NOT EXISTS rule_profile_id INT REFERENCES rule_profiles(id) ON DELETE RESTRICT;
+UPDATE seasons SET rule_profile_id = (SELECT id FROM rule_profiles WHERE name = 'nba') WHERE rule_profile_id IS NULL;
+ALTER TABLE seasons ALTER COLUMN rule_profile_id SET NOT NULL;
+
+ALTER TABLE games ADD COLUMN IF NOT EXISTS rule_profile_id INT REFERENCES rule_profiles(id) ON DELETE RESTRICT;
+UPDATE games g SET rule_profile_id = s.rule_profile_id FROM seasons s WHERE s.id = g.season_id AND g.rule_profile_id IS NULL;
+ALTER TABLE games ALTER COLUMN rule_profile_id SET NOT NULL;
+
+-- The 6-foul and 48-minute caps move from fixed CHECKs to a trigger that reads the game's profile.
+-- Overtimes count from the recorded line score, the same way the service computes them.
+ALTER TABLE player_stats DROP CONSTRAINT IF EXISTS player_stats_fouls_check;
+ALTER TABLE player_stats ADD CONSTRAINT player_stats_fouls_check CHECK (fouls >= 0);
+ALTER TABLE player_stats DROP CONSTRAINT IF EXISTS player_stats_minutes_played_check;
+ALTER TABLE player_stats ADD CONSTRAINT player_stats_minutes_played_check CHECK (minutes_played >= 0);
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION check_player_stats_rules() RETURNS TRIGGER
+LANGUAGE plpgsql AS $$
+DECLARE
+    rp rule_profiles%ROWTYPE;
+    played INT;
+BEGIN
+    SELECT r.* INTO rp
+    FROM games g JOIN rule_profiles r ON r.id = g.rule_profile_id
+    WHERE g.id = NEW.game_id;
+    IF NOT FOUND THEN
+        RETURN NEW; -- the foreign key reports a missing game
+    END IF;
+    SELECT COUNT(*) INTO played FROM game_period_scores WHERE game_id = NEW.game_id;
+    IF NEW.fouls > rp.foul_limit THEN
+        RAISE EXCEPTION 'fouls % exceed the % foul limit of %', NEW.fouls, rp.foul_limit, rp.name
+            USING ERRCODE = 'check_violation';
+    END IF;
+    IF NEW.minutes_played > rp.periods * rp.period_minutes + GREATEST(played - rp.periods, 0) * rp.overtime_minutes THEN
+        RAISE EXCEPTION 'minutes_played % exceed the game length under %', NEW.minutes_played, rp.name
+            USING ERRCODE = 'check_violation';
+    END IF;
+    RETURN NEW;
+END;
+$$;
+-- +goose StatementEnd
+
+DROP TRIGGER IF EXISTS player_stats_rules ON player_stats;
+CREATE TRIGGER player_stats_rules
+    BEFORE INSERT OR UPDATE ON player_stats
+    FOR EACH ROW EXECUTE FUNCTION check_player_stats_rules();
+
+-- +goose Down
+DROP TRIGGER IF EXISTS player_stats_rules ON player_stats;
+DROP FUNCTION IF EXISTS check_player_stats_rules();
+
+ALTER TABLE player_stats DROP CONSTRAINT IF EXISTS player_stats_minutes_played_check;
+ALTER TABLE player_stats ADD CONSTRAINT player_stats_minutes_played_check CHECK (minutes_played >= 0 AND minutes_played <= 48);
+ALTER TABLE player_stats DROP CONSTRAINT IF EXISTS player_stats_fouls_check;
+ALTER TABLE player_stats ADD CONSTRAINT player_stats_fouls_check CHECK (fouls >= 0 AND fouls <= 6);
+
+ALTER TABLE games DROP COLUMN IF EXISTS rule_profile_id;
+ALTER TABLE seasons DROP COLUMN IF EXISTS rule_profile_id;
+DROP TABLE IF EXISTS rule_profiles;
@@ -0,0 +1,107 @@
+-- +goose Up
+-- Leagues are independent tenants. Teams, players, seasons and games each belong to exactly one league,
+-- and everything else (stat lines, events, memberships, achievements) belongs to one through them.
+CREATE TABLE IF NOT EXISTS leagues (
+    id SERIAL PRIMARY KEY,
+    name TEXT NOT NULL UNIQUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- The default league keeps id 1: existing data moves into it and unscoped routes keep addressing it.
+INSERT INTO leagues (id, name) VALUES (1, 'default') ON CONFLICT (id) DO NOTHING;
+SELECT setval(pg_get_serial_sequence('leagues', 'id'), GREATEST((SELECT MAX(id) FROM leagues), 1));
+
+ALTER TABLE teams ADD COLUMN IF NOT EXISTS league_id INT REFERENCES leagues(id) ON DELETE RESTRICT;
+ALTER TABLE players ADD COLUMN IF NOT EXISTS league_id INT REFERENCES leagues(id) ON DELETE RESTRICT;
+ALTER TABLE seasons ADD COLUMN IF NOT EXISTS league_id INT REFERENCES leagues(id) ON DELETE RESTRICT;
+ALTER TABLE games ADD COLUMN IF NOT EXISTS league_id INT REFERENCES leagues(id) ON DELETE RESTRICT;
+UPDATE teams SET league_id = 1 WHERE league_id IS NULL;
+UPDATE players SET league_id = 1 WHERE league_id IS NULL;
+UPDATE seasons SET league_id = 1 WHERE league_id IS NULL;
+UPDATE games SET league_id = 1 WHERE league_id IS NULL;
+ALTER TABLE teams ALTER COLUMN league_id SET NOT NULL;
+ALTER TABLE players ALTER COLUMN league_id SET NOT NULL;
+ALTER TABLE seasons ALTER COLUMN league_id SET NOT NULL;
+ALTER TABLE games ALTER COLUMN league_id SET NOT NULL;
+
+-- Composite keys let the references below carry the league, so a player, game or season
+-- can never point at a team or season of another league.
+ALTER TABLE teams ADD CONSTRAINT teams_league_id_id_key UNIQUE (league_id, id);
+ALTER TABLE seasons ADD CONSTRAINT seasons_league_id_id_key UNIQUE (league_id, id);
+ALTER TABLE players
+    ADD CONSTRAINT players_league_team_fk FOREIGN KEY (league_id, team_id) REFERENCES teams(league_id, id) ON DELETE RESTRICT;
+ALTER TABLE games
+    ADD CONSTRAINT games_league_home_team_fk FOREIGN KEY (league_id, home_team_id) REFERENCES teams(league_id, id) ON DELETE RESTRICT,
+    ADD CONSTRAINT games_league_away_team_fk FOREIGN KEY (league_id, away_team_id) REFERENCES teams(league_id, id) ON DELETE RESTRICT,
+    ADD CONSTRAINT games_league_season_fk FOREIGN KEY (league_id, season_id) REFERENCES seasons(league_id, id) ON DELETE RESTRICT;
+
+-- Names are unique per league only.
+DROP INDEX IF EXISTS ux_teams_name_active;
+CREATE UNIQUE INDEX IF NOT EXISTS ux_teams_league_name_active ON teams(league_id, name) WHERE deleted_at IS NULL;
+ALTER TABLE seasons DROP CONSTRAINT IF EXISTS seasons_name_key;
+ALTER TABLE seasons ADD CONSTRAINT seasons_league_id_name_key UNIQUE (league_id, name);
+
+CREATE INDEX IF NOT EXISTS idx_players_league ON players(league_id);
+CREATE INDEX IF NOT EXISTS idx_games_league_date ON games(league_id, date);
+
+CREATE OR REPLACE VIEW game_results AS
+SELECT
+    g.id AS game_id,
+    g.season,
+    g.date,
+    g.home_team_id,
+    g.away_team_id,
+    g.home_score AS home_points,
+    g.away_score AS away_points,
+    CASE WHEN g.home_score > g.away_score THEN g.home_team_id ELSE g.away_team_id END AS winner_id,
+    CASE WHEN g.home_score > g.away_score THEN g.away_team_id ELSE g.home_team_id END AS loser_id,
+    g.phase,
+    g.league_id
+FROM games g
+WHERE g.status = 'finished'
+  AND g.home_score IS NOT NULL
+  AND g.away_score IS NOT NULL
+  AND g.deleted_at IS NULL;
+
+-- +goose Down
+DROP VIEW IF EXISTS game_results;
+CREATE VIEW game_results AS
+SELECT
+    g.id AS game_id,
+    g.season,
+    g.date,
+    g.home_team_id,
+    g.away_team_id,
+    g.home_score AS home_points,
+    g.away_score AS away_points,
+    CASE WHEN g.home_score > g.away_score THEN g.home_team_id ELSE g.away_team_id END AS winner_id,
+    CASE WHEN g.home_score > g.away_score THEN g.away_team_id ELSE g.home_team_id END AS loser_id,
+    g.phase
+FROM games g
+WHERE g.status = 'finished'
+  AND g.home_score IS NOT NULL
+  AND g.away_score IS NOT NULL
+  AND g.deleted_at IS NULL;
+
+DROP INDEX IF EXISTS idx_games_league_date;
+DROP INDEX IF EXISTS idx_players_league;
+
+ALTER TABLE seasons DROP CONSTRAINT IF EXISTS seasons_league_id_name_key;
+ALTER TABLE seasons ADD CONSTRAINT seasons_name_key UNIQUE (name);
+DROP INDEX IF EXISTS ux_teams_league_name_active;
+CREATE UNIQUE INDEX IF NOT EXISTS ux_teams_name_active ON teams(name) WHERE deleted_at IS NULL;
+
+ALTER TABLE games
+    DROP CONSTRAINT IF EXISTS games_league_season_fk,
+    DROP CONSTRAINT IF EXISTS games_league_away_team_fk,
+    DROP CONSTRAINT IF EXISTS games_league_home_team_fk;
+ALTER TABLE players DROP CONSTRAINT IF EXISTS players_league_team_fk;
+ALTER TABLE seasons DROP CONSTRAINT IF EXISTS seasons_league_id_id_key;
+ALTER TABLE teams DROP CONSTRAINT IF EXISTS teams_league_id_id_key;
+
+ALTER TABLE games DROP COLUMN IF EXISTS league_id;
+ALTER TABLE seasons DROP COLUMN IF EXISTS league_id;
+ALTER TABLE players DROP COLUMN IF EXISTS league_id;
+ALTER TABLE teams DROP COLUMN IF EXISTS league_id;
+DROP TABLE IF EXISTS leagues;
@@ -0,0 +1,30 @@
+-- +goose Up
+-- Venues are physical arenas and, like rule profiles, are shared by every league.
+-- The timezone is an IANA name used to render a game's local tip-off time.
+CREATE TABLE IF NOT EXISTS venues (
+    id SERIAL PRIMARY KEY,
+    name TEXT NOT NULL,
+    city TEXT NOT NULL,
+    capacity INT NOT NULL CHECK (capacity > 0),
+    timezone TEXT NOT NULL,
+    latitude DOUBLE PRECISION CHECK (latitude BETWEEN -90 AND 90),
+    longitude DOUBLE PRECISION CHECK (longitude BETWEEN -180 AND 180),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (name, city),
+    CHECK ((latitude IS NULL) = (longitude IS NULL))
+);
+
+ALTER TABLE teams ADD COLUMN IF NOT EXISTS home_venue_id INT REFERENCES venues(id) ON DELETE RESTRICT;
+
+ALTER TABLE games ADD COLUMN IF NOT EXISTS venue_id INT REFERENCES venues(id) ON DELETE RESTRICT;
+ALTER TABLE games ADD COLUMN IF NOT EXISTS attendance INT CHECK (attendance >= 0);
+
+CREATE INDEX IF NOT EXISTS idx_games_venue ON games(venue_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_games_venue;
+ALTER TABLE games DROP COLUMN IF EXISTS attendance;
+ALTER TABLE games DROP COLUMN IF EXISTS venue_id;
+ALTER TABLE teams DROP COLUMN IF EXISTS home_venue_id;
+DROP TABLE IF EXISTS venues;
@@ -0,0 +1,48 @@
+-- +goose Up
+-- Officials belong to a league like teams and players. An assignment puts one official on one game's crew.
+CREATE TABLE IF NOT EXISTS officials (
+    id SERIAL PRIMARY KEY,
+    league_id INT NOT NULL REFERENCES leagues(id) ON DELETE RESTRICT,
+    first_name TEXT NOT NULL,
+    last_name TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_officials_league ON officials(league_id);
+
+-- game_day is the game's calendar day in UTC, copied so the unique constraint can stop double-booking.
+CREATE TABLE IF NOT EXISTS game_officials (
+    game_id INT NOT NULL REFERENCES games(id) ON DELETE RESTRICT,
+    official_id INT NOT NULL REFERENCES officials(id) ON DELETE RESTRICT,
+    role TEXT NOT NULL CHECK (role IN ('crew_chief', 'referee', 'umpire')),
+    game_day DATE NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (game_id, official_id),
+    CONSTRAINT game_officials_one_game_a_day UNIQUE (official_id, game_day)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS ux_game_officials_crew_chief ON game_officials(game_id) WHERE role = 'crew_chief';
+
+-- Rescheduling a game moves its crew along; a move onto a day an official already works fails the constraint.
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION sync_game_officials_day() RETURNS TRIGGER
+LANGUAGE plpgsql AS $$
+BEGIN
+    UPDATE game_officials SET game_day = (NEW.date AT TIME ZONE 'UTC')::DATE WHERE game_id = NEW.id;
+    RETURN NEW;
+END;
+$$;
+-- +goose StatementEnd
+
+DROP TRIGGER IF EXISTS games_sync_officials_day ON games;
+CREATE TRIGGER games_sync_officials_day
+    AFTER UPDATE OF date ON games
+    FOR EACH ROW WHEN (OLD.date IS DISTINCT FROM NEW.date)
+    EXECUTE FUNCTION sync_game_officials_day();
+
+-- +goose Down
+DROP TRIGGER IF EXISTS games_sync_officials_day ON games;
+DROP FUNCTION IF EXISTS sync_game_officials_day();
+DROP TABLE IF EXISTS game_officials;
+DROP TABLE IF EXISTS officials;
@@ -0,0 +1,34 @@
+-- +goose Up
+-- A stat line says what a player did in a game; an availability record says whether they could play and,
+-- if they did not, why. A player with neither is simply unknown for that game.
+CREATE TABLE IF NOT EXISTS player_game_availability (
+    game_id INT NOT NULL REFERENCES games(id) ON DELETE CASCADE,
+    player_id INT NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    status TEXT NOT NULL CHECK (status IN ('active', 'dnp_coach', 'inactive', 'injured', 'suspended')),
+    reason TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (game_id, player_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_availability_player ON player_game_availability(player_id);
+
+-- Injury timeline. Injuries may overlap; returned_on stays NULL while the player is out.
+CREATE TABLE IF NOT EXISTS player_injuries (
+    id SERIAL PRIMARY KEY,
+    player_id INT NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    description TEXT NOT NULL,
+    start_date DATE NOT NULL,
+    expected_return_date DATE,
+    returned_on DATE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CHECK (expected_return_date IS NULL OR expected_return_date >= start_date),
+    CHECK (returned_on IS NULL OR returned_on >= start_date)
+);
+
+CREATE INDEX IF NOT EXISTS idx_injuries_player ON player_injuries(player_id, start_date);
+
+-- +goose Down
+DROP TABLE IF EXISTS player_injuries;
+DROP TABLE IF EXISTS player_game_availability;
@@ -0,0 +1,32 @@
+-- +goose Up
+-- The five players each team started a game with.
+CREATE TABLE IF NOT EXISTS game_starters (
+    game_id INT NOT NULL REFERENCES games(id) ON DELETE CASCADE,
+    team_id INT NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    player_id INT NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (game_id, player_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_game_starters_team ON game_starters(game_id, team_id);
+
+-- A stint is a stretch of one period a five-man unit spent on court, between two game clock readings
+-- (seconds remaining, as in game_events). player_ids is kept sorted so a unit groups by equality.
+CREATE TABLE IF NOT EXISTS lineup_stints (
+    id SERIAL PRIMARY KEY,
+    game_id INT NOT NULL REFERENCES games(id) ON DELETE CASCADE,
+    team_id INT NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    period INT NOT NULL CHECK (period >= 1),
+    start_clock INT NOT NULL,
+    end_clock INT NOT NULL CHECK (end_clock >= 0),
+    player_ids INT[] NOT NULL CHECK (cardinality(player_ids) = 5),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CHECK (start_clock > end_clock)
+);
+
+CREATE INDEX IF NOT EXISTS idx_lineup_stints_game ON lineup_stints(game_id, team_id, period);
+CREATE INDEX IF NOT EXISTS idx_lineup_stints_team ON lineup_stints(team_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS lineup_stints;
+DROP TABLE IF EXISTS game_starters;
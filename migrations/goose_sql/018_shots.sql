@@ -0,0 +1,23 @@
+-- +goose Up
+-- Field goal attempts placed on the court, in feet from the center of the basket the shooter attacked:
+-- x runs from sideline to sideline, y from behind the basket (the baseline is at -5.25) to the far baseline.
+-- zone is classified by the service when the shot is recorded.
+CREATE TABLE IF NOT EXISTS shots (
+    id SERIAL PRIMARY KEY,
+    game_id INT NOT NULL REFERENCES games(id) ON DELETE CASCADE,
+    player_id INT NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    period INT NOT NULL CHECK (period >= 1),
+    clock_seconds INT NOT NULL CHECK (clock_seconds >= 0),
+    x DOUBLE PRECISION NOT NULL CHECK (x BETWEEN -25 AND 25),
+    y DOUBLE PRECISION NOT NULL CHECK (y BETWEEN -5.25 AND 88.75),
+    shot_type TEXT NOT NULL CHECK (shot_type IN ('two_pt', 'three_pt')),
+    made BOOLEAN NOT NULL,
+    zone TEXT NOT NULL CHECK (zone IN ('restricted_area', 'paint', 'mid_range', 'corner_3', 'above_the_break_3')),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_shots_game_order ON shots(game_id, period, clock_seconds DESC, id);
+CREATE INDEX IF NOT EXISTS idx_shots_player_game ON shots(player_id, game_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS shots;
@@ -0,0 +1,26 @@
+-- +goose Up
+-- Player bio. Every field is optional so existing players stay valid. Jersey numbers are text so that 0 and 00
+-- are different numbers; height is in centimetres, weight in kilograms and nationality an ISO 3166-1 alpha-2 code.
+ALTER TABLE players
+    ADD COLUMN IF NOT EXISTS jersey_number TEXT CHECK (jersey_number ~ '^[0-9]{1,2}$'),
+    ADD COLUMN IF NOT EXISTS height_cm INT CHECK (height_cm BETWEEN 120 AND 250),
+    ADD COLUMN IF NOT EXISTS weight_kg INT CHECK (weight_kg BETWEEN 40 AND 200),
+    ADD COLUMN IF NOT EXISTS birth_date DATE,
+    ADD COLUMN IF NOT EXISTS nationality TEXT CHECK (nationality ~ '^[A-Z]{2}$'),
+    ADD COLUMN IF NOT EXISTS handedness TEXT CHECK (handedness IN ('left', 'right')),
+    ADD COLUMN IF NOT EXISTS secondary_positions TEXT[] NOT NULL DEFAULT '{}';
+
+-- A number is worn by one active player of a team at a time; deleted players release theirs.
+CREATE UNIQUE INDEX IF NOT EXISTS ux_players_team_jersey_active ON players(team_id, jersey_number)
+    WHERE deleted_at IS NULL AND jersey_number IS NOT NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS ux_players_team_jersey_active;
+ALTER TABLE players
+    DROP COLUMN IF EXISTS secondary_positions,
+    DROP COLUMN IF EXISTS handedness,
+    DROP COLUMN IF EXISTS nationality,
+    DROP COLUMN IF EXISTS birth_date,
+    DROP COLUMN IF EXISTS weight_kg,
+    DROP COLUMN IF EXISTS height_cm,
+    DROP COLUMN IF EXISTS jersey_number;
@@ -0,0 +1,34 @@
+-- +goose Up
+-- Coaches and trainers belong to a league like officials. A tenure puts a staff member on a team's staff in one
+-- role for a half-open period [start_date, end_date); a NULL end is the current tenure.
+CREATE TABLE IF NOT EXISTS staff (
+    id SERIAL PRIMARY KEY,
+    league_id INT NOT NULL REFERENCES leagues(id) ON DELETE RESTRICT,
+    first_name TEXT NOT NULL,
+    last_name TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_staff_league ON staff(league_id);
+
+CREATE TABLE IF NOT EXISTS staff_tenures (
+    id SERIAL PRIMARY KEY,
+    staff_id INT NOT NULL REFERENCES staff(id) ON DELETE RESTRICT,
+    team_id INT NOT NULL REFERENCES teams(id) ON DELETE RESTRICT,
+    role TEXT NOT NULL CHECK (role IN ('head_coach', 'assistant_coach', 'trainer')),
+    start_date DATE NOT NULL,
+    end_date DATE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CHECK (end_date IS NULL OR end_date > start_date)
+);
+
+-- Overlapping periods are rejected by the service; the index keeps a team from having two open head coach tenures.
+CREATE UNIQUE INDEX IF NOT EXISTS ux_staff_tenures_head_coach_current ON staff_tenures(team_id)
+    WHERE role = 'head_coach' AND end_date IS NULL;
+CREATE INDEX IF NOT EXISTS idx_staff_tenures_team ON staff_tenures(team_id, start_date);
+CREATE INDEX IF NOT EXISTS idx_staff_tenures_staff ON staff_tenures(staff_id, start_date);
+
+-- +goose Down
+DROP TABLE IF EXISTS staff_tenures;
+DROP TABLE IF EXISTS staff;
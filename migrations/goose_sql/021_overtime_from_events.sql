@@ -0,0 +1,60 @@
+-- +goose Up
+-- Overtimes count from the line score or from the event stream, whichever reaches the later period,
+-- so a live overtime is known before its period score is entered. The service reads the same two sources.
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION check_player_stats_rules() RETURNS TRIGGER
+LANGUAGE plpgsql AS $$
+DECLARE
+    rp rule_profiles%ROWTYPE;
+    played INT;
+BEGIN
+    SELECT r.* INTO rp
+    FROM games g JOIN rule_profiles r ON r.id = g.rule_profile_id
+    WHERE g.id = NEW.game_id;
+    IF NOT FOUND THEN
+        RETURN NEW; -- the foreign key reports a missing game
+    END IF;
+    SELECT GREATEST(
+        (SELECT COUNT(*) FROM game_period_scores WHERE game_id = NEW.game_id),
+        (SELECT COALESCE(MAX(period), 0) FROM game_events WHERE game_id = NEW.game_id)
+    ) INTO played;
+    IF NEW.fouls > rp.foul_limit THEN
+        RAISE EXCEPTION 'fouls % exceed the % foul limit of %', NEW.fouls, rp.foul_limit, rp.name
+            USING ERRCODE = 'check_violation';
+    END IF;
+    IF NEW.minutes_played > rp.periods * rp.period_minutes + GREATEST(played - rp.periods, 0) * rp.overtime_minutes THEN
+        RAISE EXCEPTION 'minutes_played % exceed the game length under %', NEW.minutes_played, rp.name
+            USING ERRCODE = 'check_violation';
+    END IF;
+    RETURN NEW;
+END;
+$$;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION check_player_stats_rules() RETURNS TRIGGER
+LANGUAGE plpgsql AS $$
+DECLARE
+    rp rule_profiles%ROWTYPE;
+    played INT;
+BEGIN
+    SELECT r.* INTO rp
+    FROM games g JOIN rule_profiles r ON r.id = g.rule_profile_id
+    WHERE g.id = NEW.game_id;
+    IF NOT FOUND THEN
+        RETURN NEW; -- the foreign key reports a missing game
+    END IF;
+    SELECT COUNT(*) INTO played FROM game_period_scores WHERE game_id = NEW.game_id;
+    IF NEW.fouls > rp.foul_limit THEN
+        RAISE EXCEPTION 'fouls % exceed the % foul limit of %', NEW.fouls, rp.foul_limit, rp.name
+            USING ERRCODE = 'check_violation';
+    END IF;
+    IF NEW.minutes_played > rp.periods * rp.period_minutes + GREATEST(played - rp.periods, 0) * rp.overtime_minutes THEN
+        RAISE EXCEPTION 'minutes_played % exceed the game length under %', NEW.minutes_played, rp.name
+            USING ERRCODE = 'check_violation';
+    END IF;
+    RETURN NEW;
+END;
+$$;
+-- +goose StatementEnd
@@ -0,0 +1,42 @@
+-- +goose Up
+-- Venues belong to a league like officials and staff. Each venue moves to the league of the teams and games
+-- that use it, or to the default league when unused; a venue used by several leagues is copied into each of
+-- the others and their teams and games are pointed at their own copy.
+ALTER TABLE venues ADD COLUMN IF NOT EXISTS league_id INT REFERENCES leagues(id) ON DELETE RESTRICT;
+
+CREATE TEMP TABLE venue_uses ON COMMIT DROP AS
+SELECT home_venue_id AS venue_id, league_id FROM teams WHERE home_venue_id IS NOT NULL
+UNION
+SELECT venue_id, league_id FROM games WHERE venue_id IS NOT NULL;
+
+UPDATE venues v
+SET league_id = COALESCE((SELECT MIN(u.league_id) FROM venue_uses u WHERE u.venue_id = v.id), 1)
+WHERE v.league_id IS NULL;
+ALTER TABLE venues ALTER COLUMN league_id SET NOT NULL;
+
+-- Names are unique per city within a league only.
+ALTER TABLE venues DROP CONSTRAINT IF EXISTS venues_name_city_key;
+ALTER TABLE venues ADD CONSTRAINT venues_league_id_name_city_key UNIQUE (league_id, name, city);
+
+INSERT INTO venues (league_id, name, city, capacity, timezone, latitude, longitude)
+SELECT u.league_id, v.name, v.city, v.capacity, v.timezone, v.latitude, v.longitude
+FROM venue_uses u
+INNER JOIN venues v ON v.id = u.venue_id AND v.league_id <> u.league_id;
+
+UPDATE teams t SET home_venue_id = c.id
+FROM venues o
+INNER JOIN venues c ON c.name = o.name AND c.city = o.city
+WHERE t.home_venue_id = o.id AND o.league_id <> t.league_id AND c.league_id = t.league_id;
+
+UPDATE games g SET venue_id = c.id
+FROM venues o
+INNER JOIN venues c ON c.name = o.name AND c.city = o.city
+WHERE g.venue_id = o.id AND o.league_id <> g.league_id AND c.league_id = g.league_id;
+
+CREATE INDEX IF NOT EXISTS idx_venues_league ON venues(league_id);
+
+-- +goose Down
+-- Copies made for other leagues stay as separate venues, so names are no longer unique per city.
+DROP INDEX IF EXISTS idx_venues_league;
+ALTER TABLE venues DROP CONSTRAINT IF EXISTS venues_league_id_name_city_key;
+ALTER TABLE venues DROP COLUMN IF EXISTS league_id;
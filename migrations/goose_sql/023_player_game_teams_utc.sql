@@ -0,0 +1,23 @@
+-- +goose Up
+-- Memberships are compared with the game's calendar day in UTC, the same day the roster checks use,
+-- instead of casting the membership dates to midnight in the session time zone.
+CREATE OR REPLACE VIEW player_game_teams AS
+SELECT ps.player_id, ps.game_id, m.team_id
+FROM player_stats ps
+JOIN games g ON g.id = ps.game_id AND g.deleted_at IS NULL
+JOIN players p ON p.id = ps.player_id AND p.deleted_at IS NULL
+JOIN player_team_memberships m
+  ON m.player_id = ps.player_id
+ AND (m.start_date IS NULL OR m.start_date <= (g.date AT TIME ZONE 'UTC')::DATE)
+ AND (m.end_date IS NULL OR (g.date AT TIME ZONE 'UTC')::DATE < m.end_date);
+
+-- +goose Down
+CREATE OR REPLACE VIEW player_game_teams AS
+SELECT ps.player_id, ps.game_id, m.team_id
+FROM player_stats ps
+JOIN games g ON g.id = ps.game_id AND g.deleted_at IS NULL
+JOIN players p ON p.id = ps.player_id AND p.deleted_at IS NULL
+JOIN player_team_memberships m
+  ON m.player_id = ps.player_id
+ AND (m.start_date IS NULL OR m.start_date <= g.date)
+ AND (m.end_date IS NULL OR g.date < m.end_date);
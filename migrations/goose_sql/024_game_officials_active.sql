@@ -0,0 +1,65 @@
+-- +goose Up
+-- Postponed, cancelled and deleted games no longer tie up their officials' day. The assignment stays on record
+-- but is marked inactive, and only active assignments count towards the one-game-a-day rule.
+ALTER TABLE game_officials ADD COLUMN IF NOT EXISTS active BOOLEAN NOT NULL DEFAULT TRUE;
+
+UPDATE game_officials ga SET active = (g.status NOT IN ('postponed', 'cancelled') AND g.deleted_at IS NULL)
+FROM games g
+WHERE g.id = ga.game_id;
+
+ALTER TABLE game_officials DROP CONSTRAINT IF EXISTS game_officials_one_game_a_day;
+CREATE UNIQUE INDEX IF NOT EXISTS ux_game_officials_one_game_a_day ON game_officials(official_id, game_day) WHERE active;
+
+-- New assignments take the flag from their game.
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION set_game_official_active() RETURNS TRIGGER
+LANGUAGE plpgsql AS $$
+BEGIN
+    SELECT g.status NOT IN ('postponed', 'cancelled') AND g.deleted_at IS NULL INTO NEW.active
+    FROM games g WHERE g.id = NEW.game_id;
+    RETURN NEW;
+END;
+$$;
+-- +goose StatementEnd
+
+DROP TRIGGER IF EXISTS game_officials_set_active ON game_officials;
+CREATE TRIGGER game_officials_set_active
+    BEFORE INSERT ON game_officials
+    FOR EACH ROW
+    EXECUTE FUNCTION set_game_official_active();
+
+-- Postponing, cancelling or deleting a game releases its crew; restoring it onto a day one of its officials
+-- already works fails the index.
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION sync_game_officials_active() RETURNS TRIGGER
+LANGUAGE plpgsql AS $$
+BEGIN
+    UPDATE game_officials SET active = (NEW.status NOT IN ('postponed', 'cancelled') AND NEW.deleted_at IS NULL)
+    WHERE game_id = NEW.id;
+    RETURN NEW;
+END;
+$$;
+-- +goose StatementEnd
+
+DROP TRIGGER IF EXISTS games_sync_officials_active ON games;
+CREATE TRIGGER games_sync_officials_active
+    AFTER UPDATE OF status, deleted_at ON games
+    FOR EACH ROW WHEN (OLD.status IS DISTINCT FROM NEW.status OR OLD.deleted_at IS DISTINCT FROM NEW.deleted_at)
+    EXECUTE FUNCTION sync_game_officials_active();
+
+-- +goose Down
+DROP TRIGGER IF EXISTS games_sync_officials_active ON games;
+DROP TRIGGER IF EXISTS game_officials_set_active ON game_officials;
+DROP FUNCTION IF EXISTS sync_game_officials_active();
+DROP FUNCTION IF EXISTS set_game_official_active();
+DROP INDEX IF EXISTS ux_game_officials_one_game_a_day;
+-- Released assignments that now clash with an active one are dropped so the old constraint can come back.
+DELETE FROM game_officials ga
+WHERE NOT ga.active
+  AND EXISTS (
+    SELECT 1 FROM game_officials other
+    WHERE other.official_id = ga.official_id AND other.game_day = ga.game_day AND other.game_id <> ga.game_id
+      AND (other.active OR other.game_id < ga.game_id)
+  );
+ALTER TABLE game_officials ADD CONSTRAINT game_officials_one_game_a_day UNIQUE (official_id, game_day);
+ALTER TABLE game_officials DROP COLUMN IF EXISTS active;
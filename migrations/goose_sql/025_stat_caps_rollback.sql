@@ -0,0 +1,30 @@
+-- +goose Up
+-- Rolling back past 012 restores the fixed 48-minute and 6-foul caps, which lines stored under the rule
+-- profiles may break. The Down step parks the original values of such lines and clamps them so the old
+-- constraints validate; migrating up again puts the parked values back.
+CREATE TABLE IF NOT EXISTS player_stats_over_caps (
+    stat_id INT PRIMARY KEY,
+    minutes_played NUMERIC(4,1) NOT NULL,
+    fouls INT NOT NULL
+);
+
+UPDATE player_stats ps SET minutes_played = oc.minutes_played, fouls = oc.fouls
+FROM player_stats_over_caps oc
+WHERE oc.stat_id = ps.id;
+
+DROP TABLE player_stats_over_caps;
+
+-- +goose Down
+CREATE TABLE IF NOT EXISTS player_stats_over_caps (
+    stat_id INT PRIMARY KEY,
+    minutes_played NUMERIC(4,1) NOT NULL,
+    fouls INT NOT NULL
+);
+
+INSERT INTO player_stats_over_caps (stat_id, minutes_played, fouls)
+SELECT id, minutes_played, fouls FROM player_stats
+WHERE minutes_played > 48 OR fouls > 6
+ON CONFLICT (stat_id) DO NOTHING;
+
+UPDATE player_stats SET minutes_played = LEAST(minutes_played, 48), fouls = LEAST(fouls, 6)
+WHERE minutes_played > 48 OR fouls > 6;
@@ -37,7 +37,11 @@ func MapError(err error) (int, ErrorPayload) {
 	case errors.Is(err, repository.ErrNotFound):
 		return http.StatusNotFound, ErrorPayload{Error: "not_found"}
 	case errors.Is(err, repository.ErrAlreadyExists):
-		return http.StatusConflict, ErrorPayload{Error: "already_exists"}
+		var fe []service.FieldError
+		for _, f := range repository.AlreadyExistsFields(err) {
+			fe = append(fe, service.FieldError{Field: f, Message: "is already taken"})
+		}
+		return http.StatusConflict, ErrorPayload{Error: "already_exists", FieldErrors: fe}
 	case errors.Is(err, repository.ErrConflict):
 		return http.StatusConflict, ErrorPayload{Error: "conflict"}
 	default:
@@ -21,9 +21,17 @@ type stubPlayerServiceForStats struct {
 	service.PlayerService // Embed interface to avoid implementing all methods
 	statsRes              model.PlayerAggregatedStats
 	statsErr              error
+	lastPhase             *string
+	lastDims              []string
 }
 
-func (s *stubPlayerServiceForStats) GetPlayerAggregatedStats(ctx context.Context, playerID int64, season *string) (model.PlayerAggregatedStats, error) {
+func (s *stubPlayerServiceForStats) GetPlayerSplits(ctx context.Context, playerID int64, season, phase *string, dims []string) (model.PlayerSplits, error) {
+	s.lastDims = dims
+	return model.PlayerSplits{PlayerID: playerID, Season: season}, s.statsErr
+}
+
+func (s *stubPlayerServiceForStats) GetPlayerAggregatedStats(ctx context.Context, playerID int64, season, phase *string) (model.PlayerAggregatedStats, error) {
+	s.lastPhase = phase
 	return s.statsRes, s.statsErr
 }
 
@@ -34,7 +42,7 @@ type stubTeamServiceForStats struct {
 	statsErr            error
 }
 
-func (s *stubTeamServiceForStats) GetTeamAggregatedStats(ctx context.Context, teamID int64, season *string) (model.TeamAggregatedStats, error) {
+func (s *stubTeamServiceForStats) GetTeamAggregatedStats(ctx context.Context, teamID int64, season, phase *string) (model.TeamAggregatedStats, error) {
 	return s.statsRes, s.statsErr
 }
 
@@ -66,6 +74,18 @@ func TestPlayerStatsHandler(t *testing.T) {
 		require.Equal(t, 24600, body.TotalPoints)
 	})
 
+	t.Run("Success - Phase Filter", func(t *testing.T) {
+		stub.statsErr = nil
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/players/1/aggregates?season=2023-24&phase=playoffs", nil)
+		r.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		require.NotNil(t, stub.lastPhase)
+		require.Equal(t, "playoffs", *stub.lastPhase)
+	})
+
 	t.Run("Not Found", func(t *testing.T) {
 		stub.statsErr = repository.ErrNotFound
 
@@ -97,6 +117,26 @@ func TestPlayerStatsHandler(t *testing.T) {
 	})
 }
 
+func TestPlayerSplitsHandler(t *testing.T) {
+	stub := &stubPlayerServiceForStats{}
+	r := setupRouterForStats(stub, nil)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/players/7/splits?season=2025-26&by=location,,month", nil)
+	r.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, []string{"location", "month"}, stub.lastDims)
+	var body model.PlayerSplits
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	require.Equal(t, int64(7), body.PlayerID)
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest(http.MethodGet, "/api/v1/players/abc/splits", nil)
+	r.ServeHTTP(w, req)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
+
 func TestTeamStatsHandler(t *testing.T) {
 	stub := &stubTeamServiceForStats{}
 	r := setupRouterForStats(nil, stub)
@@ -0,0 +1,63 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/maxviazov/basketball-stats-service/internal/handler"
+	"github.com/maxviazov/basketball-stats-service/internal/model"
+	"github.com/maxviazov/basketball-stats-service/internal/repository"
+	"github.com/maxviazov/basketball-stats-service/internal/service"
+	"github.com/stretchr/testify/require"
+)
+
+// stubLeagueService knows a single league, id 7.
+type stubLeagueService struct {
+	service.LeagueService
+}
+
+func (s *stubLeagueService) GetLeague(_ context.Context, id int64) (model.League, error) {
+	if id != 7 {
+		return model.League{}, repository.ErrNotFound
+	}
+	return model.League{ID: 7, Name: "EuroLeague"}, nil
+}
+
+// leagueRecordingTeamService records the league the request context was scoped to.
+type leagueRecordingTeamService struct {
+	service.TeamService
+	league int64
+}
+
+func (s *leagueRecordingTeamService) ListTeams(ctx context.Context, _ repository.Page) (repository.PageResult[model.Team], error) {
+	s.league = repository.LeagueID(ctx)
+	return repository.PageResult[model.Team]{Items: []model.Team{}}, nil
+}
+
+func TestLeagueScope(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	teams := &leagueRecordingTeamService{}
+	r := gin.New()
+	handler.Register(r, stubPingerNoop{}, handler.Services{Leagues: &stubLeagueService{}, Teams: teams})
+
+	get := func(path string) int {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, path, nil)
+		r.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	require.Equal(t, http.StatusOK, get("/api/v1/leagues/7/teams"))
+	require.Equal(t, int64(7), teams.league)
+
+	require.Equal(t, http.StatusOK, get("/api/v1/teams"))
+	require.Equal(t, repository.DefaultLeagueID, teams.league, "unscoped routes address the default league")
+
+	teams.league = 0
+	require.Equal(t, http.StatusNotFound, get("/api/v1/leagues/8/teams"))
+	require.Equal(t, http.StatusBadRequest, get("/api/v1/leagues/abc/teams"))
+	require.Zero(t, teams.league, "the handler must not run for an unknown league")
+}
@@ -45,9 +45,19 @@ type stubTeamService struct {
 		res model.TeamAggregatedStats
 		err error
 	}
+	update struct {
+		patch model.TeamPatch // captured
+		team  model.Team
+		err   error
+	}
+	deleteErr error
+	restore   struct {
+		team model.Team
+		err  error
+	}
 }
 
-func (s *stubTeamService) CreateTeam(ctx context.Context, name string) (model.Team, error) {
+func (s *stubTeamService) CreateTeam(ctx context.Context, t model.Team) (model.Team, error) {
 	return s.create.team, s.create.err
 }
 func (s *stubTeamService) GetTeam(ctx context.Context, id int64) (model.Team, error) {
@@ -56,14 +66,31 @@ func (s *stubTeamService) GetTeam(ctx context.Context, id int64) (model.Team, er
 func (s *stubTeamService) ListTeams(ctx context.Context, p repository.Page) (repository.PageResult[model.Team], error) {
 	return s.list.res, s.list.err
 }
-func (s *stubTeamService) GetTeamAggregatedStats(ctx context.Context, teamID int64, season *string) (model.TeamAggregatedStats, error) {
+func (s *stubTeamService) GetTeamAggregatedStats(ctx context.Context, teamID int64, season, phase *string) (model.TeamAggregatedStats, error) {
 	return s.stats.res, s.stats.err // Dummy implementation
 }
+func (s *stubTeamService) GetTeamSplits(ctx context.Context, teamID int64, season, phase *string, dims []string) (model.TeamSplits, error) {
+	return model.TeamSplits{TeamID: teamID}, nil
+}
+func (s *stubTeamService) GetAttendance(ctx context.Context, season *string, teamID *int64) ([]model.TeamAttendance, error) {
+	return nil, nil
+}
+
+func (s *stubTeamService) UpdateTeam(ctx context.Context, id int64, patch model.TeamPatch) (model.Team, error) {
+	s.update.patch = patch
+	return s.update.team, s.update.err
+}
+func (s *stubTeamService) DeleteTeam(ctx context.Context, id int64) error {
+	return s.deleteErr
+}
+func (s *stubTeamService) RestoreTeam(ctx context.Context, id int64) (model.Team, error) {
+	return s.restore.team, s.restore.err
+}
 
 func newRouter(ts service.TeamService) *gin.Engine {
 	gin.SetMode(gin.TestMode)
 	r := gin.New()
-	handler.Register(r, stubPingerNoop{}, ts, nil, nil, nil)
+	handler.Register(r, stubPingerNoop{}, handler.Services{Teams: ts})
 	return r
 }
 
@@ -122,3 +149,42 @@ func TestTeamHandler_Get_OK(t *testing.T) {
 		t.Fatalf("expected body to contain Heat: %s", w.Body.String())
 	}
 }
+
+func TestTeamHandler_Update_OK(t *testing.T) {
+	stub := &stubTeamService{}
+	stub.update.team = model.Team{ID: 7, Name: "Miami Heat"}
+	r := newRouter(stub)
+	body, _ := json.Marshal(map[string]string{"name": "Miami Heat"})
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodPatch, "/api/v1/teams/7", bytes.NewReader(body)))
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", w.Code, w.Body.String())
+	}
+	if stub.update.patch.Name == nil || *stub.update.patch.Name != "Miami Heat" {
+		t.Fatalf("expected name in patch, got %+v", stub.update.patch)
+	}
+}
+
+func TestTeamHandler_DeleteAndRestore(t *testing.T) {
+	stub := &stubTeamService{}
+	r := newRouter(stub)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodDelete, "/api/v1/teams/7", nil))
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("expected 204, got %d: %s", w.Code, w.Body.String())
+	}
+
+	stub.deleteErr = repository.ErrNotFound
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodDelete, "/api/v1/teams/7", nil))
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("expected 404 for a second delete, got %d", w.Code)
+	}
+
+	stub.restore.err = repository.ErrAlreadyExists
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/api/v1/teams/7/restore", nil))
+	if w.Code != http.StatusConflict {
+		t.Fatalf("expected 409 when the name was taken meanwhile, got %d: %s", w.Code, w.Body.String())
+	}
+}
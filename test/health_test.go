@@ -20,7 +20,7 @@ func newEngine(p handler.Pinger) *gin.Engine {
 	gin.SetMode(gin.TestMode)
 	r := gin.New()
 	// pass nil services – we only exercise health routes here
-	handler.Register(r, p, nil, nil, nil, nil)
+	handler.Register(r, p, handler.Services{})
 	return r
 }
 
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/maxviazov/basketball-stats-service/internal/model"
+	"github.com/maxviazov/basketball-stats-service/internal/repository"
 	pg "github.com/maxviazov/basketball-stats-service/internal/repository/postgres"
 	"github.com/stretchr/testify/require"
 )
@@ -52,7 +53,7 @@ func TestAdvancedStatsPostgres(t *testing.T) {
 	// 2. Run Player Aggregated Stats Tests
 	t.Run("PlayerAggregatedStats", func(t *testing.T) {
 		t.Run("Career Stats", func(t *testing.T) {
-			stats, err := playerRepo.GetPlayerAggregatedStats(ctx, p1.ID, nil)
+			stats, err := playerRepo.GetPlayerAggregatedStats(ctx, p1.ID, nil, nil)
 			require.NoError(t, err)
 			require.Equal(t, 3, stats.GamesPlayed)
 			require.Equal(t, 77, stats.TotalPoints)   // 25 + 30 + 22
@@ -63,7 +64,7 @@ func TestAdvancedStatsPostgres(t *testing.T) {
 
 		t.Run("Seasonal Stats", func(t *testing.T) {
 			season := "2023-24"
-			stats, err := playerRepo.GetPlayerAggregatedStats(ctx, p1.ID, &season)
+			stats, err := playerRepo.GetPlayerAggregatedStats(ctx, p1.ID, &season, nil)
 			require.NoError(t, err)
 			require.Equal(t, 2, stats.GamesPlayed)
 			require.Equal(t, 55, stats.TotalPoints) // 25 + 30
@@ -71,6 +72,27 @@ func TestAdvancedStatsPostgres(t *testing.T) {
 			require.Equal(t, 12, stats.TotalAssists)
 			require.InEpsilon(t, 27.5, stats.AvgPoints, 0.01)
 		})
+
+		t.Run("Shooting Percentages", func(t *testing.T) {
+			shooter, err := playerRepo.Create(ctx, model.Player{TeamID: t1.ID, FirstName: "Steph", LastName: "Shooter", Position: "PG"})
+			require.NoError(t, err)
+			// 8/16 FG, 4/8 3PT, 6/8 FT -> 26 points; 1 OREB + 5 DREB
+			_, err = statsRepo.UpsertStatLine(ctx, model.PlayerStatLine{
+				PlayerID: shooter.ID, GameID: g1.ID, Points: 26, Rebounds: 6, OffensiveRebounds: 1, DefensiveRebounds: 5,
+				FieldGoalsMade: 8, FieldGoalsAttempted: 16, ThreePointersMade: 4, ThreePointersAttempted: 8,
+				FreeThrowsMade: 6, FreeThrowsAttempted: 8,
+			})
+			require.NoError(t, err)
+			stats, err := playerRepo.GetPlayerAggregatedStats(ctx, shooter.ID, nil, nil)
+			require.NoError(t, err)
+			require.Equal(t, 16, stats.TotalFieldGoalsAttempted)
+			require.Equal(t, 5, stats.TotalDefensiveRebounds)
+			require.InDelta(t, 0.5, stats.FieldGoalPct, 0.001)
+			require.InDelta(t, 0.5, stats.ThreePointPct, 0.001)
+			require.InDelta(t, 0.75, stats.FreeThrowPct, 0.001)
+			require.InDelta(t, 0.625, stats.EffectiveFGPct, 0.001)  // (8 + 2) / 16
+			require.InDelta(t, 0.677, stats.TrueShootingPct, 0.001) // 26 / (2 * (16 + 3.52))
+		})
 	})
 
 	// 3. Run Team Aggregated Stats Tests
@@ -87,9 +109,16 @@ func TestAdvancedStatsPostgres(t *testing.T) {
 		// Game 3: Lakers (p1: 22) vs Clippers (p2: 18) -> Lakers win
 		_, err = statsRepo.UpsertStatLine(ctx, model.PlayerStatLine{PlayerID: p2.ID, GameID: g3.ID, Points: 18})
 		require.NoError(t, err)
+		// Results come from the official score, so record it for each game.
+		_, err = gameRepo.UpdateScore(ctx, g1.ID, model.GameScore{HomeScore: 25, AwayScore: 20})
+		require.NoError(t, err)
+		_, err = gameRepo.UpdateScore(ctx, g2.ID, model.GameScore{HomeScore: 35, AwayScore: 30})
+		require.NoError(t, err)
+		_, err = gameRepo.UpdateScore(ctx, g3.ID, model.GameScore{HomeScore: 22, AwayScore: 18})
+		require.NoError(t, err)
 
 		t.Run("Career Stats", func(t *testing.T) {
-			stats, err := teamRepo.GetTeamAggregatedStats(ctx, t1.ID, nil) // Lakers
+			stats, err := teamRepo.GetTeamAggregatedStats(ctx, t1.ID, nil, nil) // Lakers
 			require.NoError(t, err)
 			require.Equal(t, 2, stats.Wins)                // g1, g3
 			require.Equal(t, 1, stats.Losses)              // g2
@@ -99,12 +128,320 @@ func TestAdvancedStatsPostgres(t *testing.T) {
 
 		t.Run("Seasonal Stats", func(t *testing.T) {
 			season := "2023-24"
-			stats, err := teamRepo.GetTeamAggregatedStats(ctx, t1.ID, &season) // Lakers
+			stats, err := teamRepo.GetTeamAggregatedStats(ctx, t1.ID, &season, nil) // Lakers
 			require.NoError(t, err)
 			require.Equal(t, 1, stats.Wins)
 			require.Equal(t, 1, stats.Losses)
 			require.Equal(t, 55, stats.TotalPointsScored)  // 25 + 30
 			require.Equal(t, 55, stats.TotalPointsAllowed) // 20 + 35
 		})
+
+		t.Run("Official Score Wins Over Player Points", func(t *testing.T) {
+			// A finished game without any stat lines still counts through its official score.
+			g4, err := gameRepo.Create(ctx, model.Game{Season: "2024-25", Date: time.Now(), HomeTeamID: t2.ID, AwayTeamID: t1.ID, Status: "finished"})
+			require.NoError(t, err)
+			_, err = gameRepo.UpdateScore(ctx, g4.ID, model.GameScore{HomeScore: 90, AwayScore: 101})
+			require.NoError(t, err)
+
+			season := "2024-25"
+			stats, err := teamRepo.GetTeamAggregatedStats(ctx, t1.ID, &season, nil) // Lakers
+			require.NoError(t, err)
+			require.Equal(t, 2, stats.Wins) // g3, g4
+			require.Equal(t, 0, stats.Losses)
+			require.Equal(t, 123, stats.TotalPointsScored) // 22 + 101
+
+			home, away, err := gameRepo.GetPlayerPointTotals(ctx, g4.ID)
+			require.NoError(t, err)
+			require.Equal(t, 0, home)
+			require.Equal(t, 0, away)
+		})
+
+		t.Run("Phase Filter", func(t *testing.T) {
+			po, err := gameRepo.Create(ctx, model.Game{Season: "2024-25", Phase: "playoffs", Date: time.Now(), HomeTeamID: t1.ID, AwayTeamID: t2.ID, Status: "finished"})
+			require.NoError(t, err)
+			require.Equal(t, "playoffs", po.Phase)
+			_, err = gameRepo.UpdateScore(ctx, po.ID, model.GameScore{HomeScore: 88, AwayScore: 95})
+			require.NoError(t, err)
+
+			season, regular, playoffs := "2024-25", "regular", "playoffs"
+			stats, err := teamRepo.GetTeamAggregatedStats(ctx, t1.ID, &season, &playoffs)
+			require.NoError(t, err)
+			require.Equal(t, 0, stats.Wins)
+			require.Equal(t, 1, stats.Losses)
+			require.Equal(t, 88, stats.TotalPointsScored)
+
+			stats, err = teamRepo.GetTeamAggregatedStats(ctx, t1.ID, &season, &regular)
+			require.NoError(t, err)
+			require.Equal(t, 2, stats.Wins) // g3, g4
+			require.Equal(t, 0, stats.Losses)
+		})
+	})
+
+	// 4. League table built from the same results
+	t.Run("Standings", func(t *testing.T) {
+		regular := "regular"
+		rows, err := teamRepo.ListStandings(ctx, "2023-24", &regular)
+		require.NoError(t, err)
+		require.Len(t, rows, 2)
+
+		lakers, clippers := rows[0], rows[1]
+		require.Equal(t, t1.ID, lakers.TeamID)
+		require.Equal(t, 1, lakers.Wins)
+		require.Equal(t, 1, lakers.Losses)
+		require.Equal(t, model.WinLoss{Wins: 1}, lakers.Home)
+		require.Equal(t, model.WinLoss{Losses: 1}, lakers.Away)
+		require.Equal(t, model.WinLoss{Wins: 1, Losses: 1}, lakers.Last10)
+		require.Equal(t, "L1", lakers.Streak) // g2 is the later game
+		require.Equal(t, 0, lakers.PointDiff)
+		require.Equal(t, model.WinLoss{Wins: 1, Losses: 1}, lakers.HeadToHead[t2.ID])
+		require.Equal(t, "W1", clippers.Streak)
+
+		rows, err = teamRepo.ListStandings(ctx, "2025-26", nil)
+		require.NoError(t, err)
+		require.Len(t, rows, 2, "teams without games are listed")
+		require.Empty(t, rows[0].Streak)
+		require.Empty(t, rows[0].HeadToHead)
+	})
+
+	// 5. Leaderboards share ranks on ties and honour the games threshold
+	t.Run("Leaders", func(t *testing.T) {
+		leadersRepo := pg.NewLeadersRepository(pool)
+		season := "2023-24"
+
+		// p1 (25, 30) and p2 (20, 35) both average 27.5; the shooter has 26 in a single game.
+		rows, err := leadersRepo.ListLeaders(ctx, model.LeaderQuery{Stat: "points", PerGame: true, Season: &season, Limit: 10})
+		require.NoError(t, err)
+		require.Len(t, rows, 3)
+		require.Equal(t, 1, rows[0].Rank)
+		require.Equal(t, 1, rows[1].Rank)
+		require.Equal(t, 27.5, rows[0].Value)
+		require.Equal(t, 3, rows[2].Rank)
+		require.Equal(t, 26.0, rows[2].Value)
+
+		rows, err = leadersRepo.ListLeaders(ctx, model.LeaderQuery{Stat: "points", Season: &season, MinGames: 2, Limit: 1})
+		require.NoError(t, err)
+		require.Len(t, rows, 2, "players tied on the last rank are all returned")
+		require.Equal(t, 55.0, rows[0].Value)
+		require.Equal(t, 2, rows[0].GamesPlayed)
+
+		_, err = leadersRepo.ListLeaders(ctx, model.LeaderQuery{Stat: "points; DROP TABLE players", Limit: 1})
+		require.Error(t, err)
+	})
+
+	// 6. Game log seen from the player's side
+	t.Run("GameLog", func(t *testing.T) {
+		log, err := statsRepo.ListByPlayer(ctx, p1.ID, model.GameLogFilter{}, repository.Page{Limit: 10})
+		require.NoError(t, err)
+		require.Equal(t, 3, log.Total)
+		require.Equal(t, g3.ID, log.Items[0].GameID, "most recent first")
+		require.True(t, log.Items[0].Home)
+		require.Equal(t, "W", log.Items[0].Result)
+		require.Equal(t, t2.ID, log.Items[0].OpponentID)
+
+		season := "2023-24"
+		log, err = statsRepo.ListByPlayer(ctx, p1.ID, model.GameLogFilter{Season: &season, OpponentID: &t2.ID, Last: 1}, repository.Page{Limit: 10})
+		require.NoError(t, err)
+		require.Equal(t, 1, log.Total)
+		got := log.Items[0]
+		require.Equal(t, g2.ID, got.GameID)
+		require.False(t, got.Home)
+		require.Equal(t, "L", got.Result)
+		require.Equal(t, 30, *got.TeamScore)
+		require.Equal(t, 35, *got.OpponentScore)
+		require.Equal(t, 30, got.Points)
+
+		log, err = statsRepo.ListByPlayer(ctx, p1.ID, model.GameLogFilter{OpponentID: &t1.ID}, repository.Page{Limit: 10})
+		require.NoError(t, err)
+		require.Zero(t, log.Total, "a player's own team is never the opponent")
+	})
+
+	// 7. Season totals feeding the advanced metrics engine
+	t.Run("MetricsSeasonTotals", func(t *testing.T) {
+		metricsRepo := pg.NewMetricsRepository(pool)
+		rows, err := metricsRepo.ListSeasonTotals(ctx, "2023-24")
+		require.NoError(t, err)
+		byPlayer := make(map[int64]model.PlayerSeasonTotals, len(rows))
+		for _, r := range rows {
+			byPlayer[r.PlayerID] = r
+		}
+		require.Len(t, byPlayer, 3) // p1, the shooter and p2
+
+		lakers := byPlayer[p1.ID]
+		require.Equal(t, t1.ID, lakers.TeamID)
+		require.Equal(t, 2, lakers.Player.Games)
+		require.Equal(t, 55, lakers.Player.Points)
+		require.Equal(t, 2, lakers.Team.Games)   // g1 and g2, counted once each
+		require.Equal(t, 81, lakers.Team.Points) // 25 + 30 + 26
+		require.Equal(t, 96.0, lakers.TeamGameMinutes) // two 48-minute NBA games
+
+		lines, err := metricsRepo.ListPlayerSeasonLines(ctx, p1.ID, "2023-24")
+		require.NoError(t, err)
+		require.Len(t, lines, 2)
+		require.Equal(t, 25, lines[0].Points)
+	})
+
+	// 8. A transfer does not move past lines to the new team
+	t.Run("TradedPlayerLinesStayWithOldTeam", func(t *testing.T) {
+		traded, err := playerRepo.Create(ctx, model.Player{TeamID: t2.ID, FirstName: "Traded", LastName: "Wing", Position: "SF"})
+		require.NoError(t, err)
+		g5, err := gameRepo.Create(ctx, model.Game{Season: "2023-24", Date: time.Date(2024, 1, 10, 19, 0, 0, 0, time.UTC), HomeTeamID: t1.ID, AwayTeamID: t2.ID, Status: "finished"})
+		require.NoError(t, err)
+		_, err = statsRepo.UpsertStatLine(ctx, model.PlayerStatLine{PlayerID: traded.ID, GameID: g5.ID, Points: 10, FieldGoalsMade: 5, FieldGoalsAttempted: 9})
+		require.NoError(t, err)
+		_, err = playerRepo.Transfer(ctx, traded.ID, t1.ID, time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+
+		home, away, err := gameRepo.GetPlayerPointTotals(ctx, g5.ID)
+		require.NoError(t, err)
+		require.Equal(t, 0, home)
+		require.Equal(t, 10, away)
+	})
+
+	// 9. Box score of a game, players split by side in one query
+	t.Run("BoxScore", func(t *testing.T) {
+		box, err := gameRepo.GetBoxScore(ctx, g1.ID)
+		require.NoError(t, err)
+		require.Equal(t, g1.ID, box.Game.ID)
+		require.Equal(t, "Lakers", box.Home.Name)
+		require.Equal(t, "Clippers", box.Away.Name)
+		require.Len(t, box.Home.Players, 2) // p1 and the shooter
+		require.Len(t, box.Away.Players, 1)
+		require.Equal(t, "Kawhi", box.Away.Players[0].FirstName)
+		require.Equal(t, "SF", box.Away.Players[0].Position)
+		require.Equal(t, 20, box.Away.Players[0].Points)
+
+		_, err = gameRepo.GetBoxScore(ctx, -1)
+		require.ErrorIs(t, err, repository.ErrNotFound)
+	})
+
+	// 10. Splits are bucketed in SQL, one row per dimension and key
+	t.Run("Splits", func(t *testing.T) {
+		season := "2023-24"
+		splits, err := playerRepo.GetPlayerSplits(ctx, p1.ID, &season, nil, []string{"location", "result"})
+		require.NoError(t, err)
+		require.Len(t, splits, 4)
+		require.Equal(t, "location", splits[0].Dimension)
+		require.Equal(t, "away", splits[0].Key)
+		require.Equal(t, 30, splits[0].TotalPoints) // g2
+		require.Equal(t, "home", splits[1].Key)
+		require.Equal(t, 25, splits[1].TotalPoints) // g1
+		require.Equal(t, "result", splits[2].Dimension)
+		require.Equal(t, "L", splits[2].Key)
+		require.Equal(t, "W", splits[3].Key)
+
+		// g5 has no official score, so only g1 and g2 count for the team.
+		teamSplits, err := teamRepo.GetTeamSplits(ctx, t1.ID, &season, nil, []string{"location", "rest"})
+		require.NoError(t, err)
+		require.Equal(t, "away", teamSplits[0].Key)
+		require.Equal(t, 1, teamSplits[0].Losses)
+		require.Equal(t, 35, teamSplits[0].TotalPointsAllowed)
+		require.Equal(t, "home", teamSplits[1].Key)
+		require.Equal(t, 1, teamSplits[1].Wins)
+		require.Equal(t, "rest", teamSplits[2].Dimension)
+	})
+
+	// 11. Achievements: single-game feats are replaced, milestones follow the running totals
+	t.Run("Achievements", func(t *testing.T) {
+		achievementRepo := pg.NewAchievementRepository(pool)
+		milestones := []model.CareerMilestone{{Stat: "points", Threshold: 50}, {Stat: "points", Threshold: 100}}
+
+		// p1 scored 25, 30 and 22 in g1, g2 and g3: 50 points are first reached in g2, 100 never.
+		require.NoError(t, achievementRepo.SyncMilestones(ctx, p1.ID, milestones))
+		require.NoError(t, achievementRepo.ReplaceGameFeats(ctx, p1.ID, g3.ID, []model.Achievement{{Kind: "double_double", Value: 2}}))
+		res, err := achievementRepo.ListByPlayer(ctx, p1.ID, repository.Page{Limit: 10})
+		require.NoError(t, err)
+		require.Equal(t, 2, res.Total)
+		byKind := map[string]model.Achievement{}
+		for _, a := range res.Items {
+			byKind[a.Kind] = a
+		}
+		require.Equal(t, g2.ID, byKind["career_points"].GameID)
+		require.Equal(t, 50, byKind["career_points"].Value)
+		require.True(t, byKind["career_points"].Milestone)
+		require.Equal(t, "2024-25", byKind["double_double"].Season)
+
+		// Re-running both writes replaces rather than duplicates.
+		require.NoError(t, achievementRepo.SyncMilestones(ctx, p1.ID, milestones))
+		require.NoError(t, achievementRepo.ReplaceGameFeats(ctx, p1.ID, g3.ID, nil))
+		season, kind := "2023-24", "career_points"
+		res, err = achievementRepo.List(ctx, model.AchievementFilter{Season: &season, Kind: &kind}, repository.Page{Limit: 10})
+		require.NoError(t, err)
+		require.Equal(t, 1, res.Total)
+
+		// Deleting a line removes the feats recorded in it.
+		require.NoError(t, statsRepo.DeleteStatLine(ctx, p1.ID, g2.ID))
+		res, err = achievementRepo.ListByPlayer(ctx, p1.ID, repository.Page{Limit: 10})
+		require.NoError(t, err)
+		require.Zero(t, res.Total)
+	})
+
+	// 12. Global search is typo-tolerant and accent-insensitive
+	t.Run("Search", func(t *testing.T) {
+		searchRepo := pg.NewSearchRepository(pool)
+		luka, err := playerRepo.Create(ctx, model.Player{TeamID: t2.ID, FirstName: "Luka", LastName: "Dončić", Position: "PG"})
+		require.NoError(t, err)
+
+		res, err := searchRepo.Search(ctx, "luka doncic", nil, repository.Page{Limit: 10})
+		require.NoError(t, err)
+		require.NotZero(t, res.Total)
+		require.Equal(t, "player", res.Items[0].Type)
+		require.Equal(t, luka.ID, res.Items[0].ID)
+		require.Equal(t, t2.ID, *res.Items[0].TeamID)
+
+		res, err = searchRepo.Search(ctx, "lebron jmaes", nil, repository.Page{Limit: 10})
+		require.NoError(t, err)
+		require.NotZero(t, res.Total)
+		require.Equal(t, p1.ID, res.Items[0].ID)
+
+		team := "team"
+		res, err = searchRepo.Search(ctx, "clipers", &team, repository.Page{Limit: 10})
+		require.NoError(t, err)
+		require.Equal(t, 1, res.Total)
+		require.Equal(t, "Clippers", res.Items[0].Name)
+		require.Nil(t, res.Items[0].TeamID)
+	})
+
+	// 13. Soft-deleted games and players drop out of aggregates and come back on restore
+	t.Run("SoftDelete", func(t *testing.T) {
+		before, err := playerRepo.GetPlayerAggregatedStats(ctx, p1.ID, nil, nil)
+		require.NoError(t, err)
+		lines, err := statsRepo.ListByGame(ctx, g3.ID)
+		require.NoError(t, err)
+		var g3Points int
+		for _, l := range lines {
+			if l.PlayerID == p1.ID {
+				g3Points = l.Points
+			}
+		}
+
+		require.NoError(t, gameRepo.Delete(ctx, g3.ID))
+		after, err := playerRepo.GetPlayerAggregatedStats(ctx, p1.ID, nil, nil)
+		require.NoError(t, err)
+		require.Equal(t, before.GamesPlayed-1, after.GamesPlayed)
+		require.Equal(t, before.TotalPoints-g3Points, after.TotalPoints)
+		season := "2024-25"
+		teamStats, err := teamRepo.GetTeamAggregatedStats(ctx, t1.ID, &season, nil)
+		require.NoError(t, err)
+		require.Zero(t, teamStats.Wins+teamStats.Losses, "a deleted game is not a result")
+		_, err = gameRepo.Restore(ctx, g3.ID)
+		require.NoError(t, err)
+
+		searchRepo := pg.NewSearchRepository(pool)
+		require.NoError(t, playerRepo.Delete(ctx, p1.ID))
+		res, err := searchRepo.Search(ctx, "lebron james", nil, repository.Page{Limit: 10})
+		require.NoError(t, err)
+		for _, it := range res.Items {
+			require.False(t, it.Type == "player" && it.ID == p1.ID, "deleted players are not searchable")
+		}
+		leaders, err := pg.NewLeadersRepository(pool).ListLeaders(ctx, model.LeaderQuery{Stat: "points", PerGame: false, MinGames: 1, Limit: 50})
+		require.NoError(t, err)
+		for _, l := range leaders {
+			require.NotEqual(t, p1.ID, l.PlayerID)
+		}
+		_, err = playerRepo.Restore(ctx, p1.ID)
+		require.NoError(t, err)
+		restored, err := playerRepo.GetPlayerAggregatedStats(ctx, p1.ID, nil, nil)
+		require.NoError(t, err)
+		require.Equal(t, before, restored)
 	})
 }
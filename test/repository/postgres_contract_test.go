@@ -103,16 +103,57 @@ func firstNonEmpty(vals ...string) string {
 
 func truncateAll(t *testing.T) {
 	stmts := []string{
+		"TRUNCATE TABLE player_team_memberships RESTART IDENTITY CASCADE",
+		"TRUNCATE TABLE player_achievements RESTART IDENTITY CASCADE",
+		"TRUNCATE TABLE game_events RESTART IDENTITY CASCADE",
+		"TRUNCATE TABLE game_status_transitions RESTART IDENTITY CASCADE",
+		"TRUNCATE TABLE player_game_availability RESTART IDENTITY CASCADE",
+		"TRUNCATE TABLE player_injuries RESTART IDENTITY CASCADE",
+		"TRUNCATE TABLE shots RESTART IDENTITY CASCADE",
+		"TRUNCATE TABLE lineup_stints RESTART IDENTITY CASCADE",
+		"TRUNCATE TABLE game_starters RESTART IDENTITY CASCADE",
 		"TRUNCATE TABLE player_stats RESTART IDENTITY CASCADE",
+		"TRUNCATE TABLE game_officials RESTART IDENTITY CASCADE",
+		"TRUNCATE TABLE officials RESTART IDENTITY CASCADE",
+		"TRUNCATE TABLE staff_tenures RESTART IDENTITY CASCADE",
+		"TRUNCATE TABLE staff RESTART IDENTITY CASCADE",
 		"TRUNCATE TABLE players RESTART IDENTITY CASCADE",
 		"TRUNCATE TABLE games RESTART IDENTITY CASCADE",
 		"TRUNCATE TABLE teams RESTART IDENTITY CASCADE",
+		"TRUNCATE TABLE seasons RESTART IDENTITY CASCADE",
+		"TRUNCATE TABLE venues RESTART IDENTITY CASCADE",
+		// The default league and the seeded rule profiles are part of the schema and stay.
+		"DELETE FROM leagues WHERE id <> 1",
 	}
 	for _, s := range stmts {
 		if _, err := db.Exec(s); err != nil {
 			t.Fatalf("truncate: %v", err)
 		}
 	}
+	seedSeasons(t)
+}
+
+// seedSeasons registers the seasons the suites create games in. Games reference their season by name,
+// and the repository does not check game dates, so each season gets wide regular and playoff phases.
+func seedSeasons(t *testing.T) {
+	stmts := []string{
+		`INSERT INTO seasons (league_id, name, start_date, end_date, rule_profile_id)
+		 SELECT 1, v.name, v.start_date::DATE, v.end_date::DATE, r.id
+		 FROM (VALUES
+			('2023-24', '2023-01-01', '2024-12-31'),
+			('2024-25', '2024-01-01', '2025-12-31'),
+			('2025-26', '2025-01-01', '2026-12-31')) AS v(name, start_date, end_date)
+		 JOIN rule_profiles r ON r.name = 'nba'`,
+		`INSERT INTO season_phases (season_id, phase, start_date, end_date)
+		 SELECT id, 'regular', start_date, end_date - 90 FROM seasons`,
+		`INSERT INTO season_phases (season_id, phase, start_date, end_date)
+		 SELECT id, 'playoffs', end_date - 89, end_date FROM seasons`,
+	}
+	for _, s := range stmts {
+		if _, err := db.Exec(s); err != nil {
+			t.Fatalf("seed seasons: %v", err)
+		}
+	}
 }
 
 func makeTeamRepo(t *testing.T) (repository.TeamRepository, func()) {
@@ -178,6 +219,204 @@ func makeStatsRepo(t *testing.T) (repository.StatsRepository, func(ctx context.C
 	return pg.NewStatsRepository(pool), mkPlayer, mkGame, func() { truncateAll(t) }
 }
 
+func makeEventRepo(t *testing.T) (repository.EventRepository, func(ctx context.Context) (int64, int64, int64, error), func()) {
+	skipIfNeeded(t)
+	truncateAll(t)
+	teamRepo := pg.NewTeamRepository(pool)
+	playerRepo := pg.NewPlayerRepository(pool)
+	gameRepo := pg.NewGameRepository(pool)
+	mkGame := func(ctx context.Context) (int64, int64, int64, error) {
+		h, err := teamRepo.Create(ctx, model.Team{Name: "EventsHome"})
+		if err != nil {
+			return 0, 0, 0, err
+		}
+		a, err := teamRepo.Create(ctx, model.Team{Name: "EventsAway"})
+		if err != nil {
+			return 0, 0, 0, err
+		}
+		p, err := playerRepo.Create(ctx, model.Player{TeamID: h.ID, FirstName: "Play", LastName: "ByPlay", Position: "PG"})
+		if err != nil {
+			return 0, 0, 0, err
+		}
+		g, err := gameRepo.Create(ctx, model.Game{Season: "2025-26", Date: time.Now().UTC(), HomeTeamID: h.ID, AwayTeamID: a.ID, Status: "in_progress"})
+		if err != nil {
+			return 0, 0, 0, err
+		}
+		return g.ID, h.ID, p.ID, nil
+	}
+	return pg.NewEventRepository(pool), mkGame, func() { truncateAll(t) }
+}
+
+func makeLeagueRepo(t *testing.T) (repository.LeagueRepository, repository.TeamRepository, func()) {
+	skipIfNeeded(t)
+	truncateAll(t)
+	return pg.NewLeagueRepository(pool), pg.NewTeamRepository(pool), func() { truncateAll(t) }
+}
+
+func makeVenueRepo(t *testing.T) (repository.VenueRepository, repository.TeamRepository, repository.GameRepository, func()) {
+	skipIfNeeded(t)
+	truncateAll(t)
+	return pg.NewVenueRepository(pool), pg.NewTeamRepository(pool), pg.NewGameRepository(pool), func() { truncateAll(t) }
+}
+
+func makeOfficialRepo(t *testing.T) (repository.OfficialRepository, repository.GameRepository, func(ctx context.Context, date time.Time) (int64, error), func(ctx context.Context, gameID int64, fouls int) error, func()) {
+	skipIfNeeded(t)
+	truncateAll(t)
+	teamRepo := pg.NewTeamRepository(pool)
+	playerRepo := pg.NewPlayerRepository(pool)
+	gameRepo := pg.NewGameRepository(pool)
+	statsRepo := pg.NewStatsRepository(pool)
+	homeTeams := map[int64]int64{}
+	mkGame := func(ctx context.Context, date time.Time) (int64, error) {
+		h, err := teamRepo.Create(ctx, model.Team{Name: "Home " + date.Format(time.RFC3339)})
+		if err != nil {
+			return 0, err
+		}
+		a, err := teamRepo.Create(ctx, model.Team{Name: "Away " + date.Format(time.RFC3339)})
+		if err != nil {
+			return 0, err
+		}
+		g, err := gameRepo.Create(ctx, model.Game{Season: "2025-26", Date: date, HomeTeamID: h.ID, AwayTeamID: a.ID, Status: "finished"})
+		if err != nil {
+			return 0, err
+		}
+		homeTeams[g.ID] = h.ID
+		return g.ID, nil
+	}
+	addFouls := func(ctx context.Context, gameID int64, fouls int) error {
+		p, err := playerRepo.Create(ctx, model.Player{TeamID: homeTeams[gameID], FirstName: "John", LastName: "Doe", Position: "PF"})
+		if err != nil {
+			return err
+		}
+		_, err = statsRepo.UpsertStatLine(ctx, model.PlayerStatLine{PlayerID: p.ID, GameID: gameID, Fouls: fouls, MinutesPlayed: 20})
+		return err
+	}
+	return pg.NewOfficialRepository(pool), gameRepo, mkGame, addFouls, func() { truncateAll(t) }
+}
+
+func makeAvailabilityRepo(t *testing.T) (repository.AvailabilityRepository, repository.PlayerRepository, repository.StatsRepository, func(ctx context.Context) (int64, error), func(ctx context.Context, playerID int64, date time.Time) (int64, error), func()) {
+	skipIfNeeded(t)
+	truncateAll(t)
+	teamRepo := pg.NewTeamRepository(pool)
+	playerRepo := pg.NewPlayerRepository(pool)
+	gameRepo := pg.NewGameRepository(pool)
+	teams := map[int64]int64{}
+	mkPlayer := func(ctx context.Context) (int64, error) {
+		team, err := teamRepo.Create(ctx, model.Team{Name: "Availability Home"})
+		if err != nil {
+			return 0, err
+		}
+		p, err := playerRepo.Create(ctx, model.Player{TeamID: team.ID, FirstName: "John", LastName: "Doe", Position: "SF"})
+		if err != nil {
+			return 0, err
+		}
+		teams[p.ID] = team.ID
+		return p.ID, nil
+	}
+	mkGame := func(ctx context.Context, playerID int64, date time.Time) (int64, error) {
+		a, err := teamRepo.Create(ctx, model.Team{Name: "Away " + date.Format(time.RFC3339)})
+		if err != nil {
+			return 0, err
+		}
+		g, err := gameRepo.Create(ctx, model.Game{Season: "2025-26", Date: date, HomeTeamID: teams[playerID], AwayTeamID: a.ID, Status: "finished"})
+		if err != nil {
+			return 0, err
+		}
+		return g.ID, nil
+	}
+	return pg.NewAvailabilityRepository(pool), playerRepo, pg.NewStatsRepository(pool), mkPlayer, mkGame, func() { truncateAll(t) }
+}
+
+func makeLineupRepo(t *testing.T) (repository.LineupRepository, repository.EventRepository, func(ctx context.Context) (int64, int64, int64, []int64, []int64, error), func()) {
+	skipIfNeeded(t)
+	truncateAll(t)
+	teamRepo := pg.NewTeamRepository(pool)
+	playerRepo := pg.NewPlayerRepository(pool)
+	gameRepo := pg.NewGameRepository(pool)
+	mkGame := func(ctx context.Context) (int64, int64, int64, []int64, []int64, error) {
+		var ids [2]int64
+		var rosters [2][]int64
+		for i, side := range []struct {
+			name    string
+			players int
+		}{{"Lineups Home", 6}, {"Lineups Away", 5}} {
+			team, err := teamRepo.Create(ctx, model.Team{Name: side.name})
+			if err != nil {
+				return 0, 0, 0, nil, nil, err
+			}
+			ids[i] = team.ID
+			for n := 0; n < side.players; n++ {
+				p, err := playerRepo.Create(ctx, model.Player{TeamID: team.ID, FirstName: "John", LastName: "Doe", Position: "SG"})
+				if err != nil {
+					return 0, 0, 0, nil, nil, err
+				}
+				rosters[i] = append(rosters[i], p.ID)
+			}
+		}
+		g, err := gameRepo.Create(ctx, model.Game{Season: "2025-26", Date: time.Now().UTC(), HomeTeamID: ids[0], AwayTeamID: ids[1], Status: "in_progress"})
+		if err != nil {
+			return 0, 0, 0, nil, nil, err
+		}
+		return g.ID, ids[0], ids[1], rosters[0], rosters[1], nil
+	}
+	return pg.NewLineupRepository(pool), pg.NewEventRepository(pool), mkGame, func() { truncateAll(t) }
+}
+
+func makeShotRepo(t *testing.T) (repository.ShotRepository, func(ctx context.Context) (int64, []int64, error), func()) {
+	skipIfNeeded(t)
+	truncateAll(t)
+	teamRepo := pg.NewTeamRepository(pool)
+	playerRepo := pg.NewPlayerRepository(pool)
+	gameRepo := pg.NewGameRepository(pool)
+	mkGames := func(ctx context.Context) (int64, []int64, error) {
+		home, err := teamRepo.Create(ctx, model.Team{Name: "Shots Home"})
+		if err != nil {
+			return 0, nil, err
+		}
+		away, err := teamRepo.Create(ctx, model.Team{Name: "Shots Away"})
+		if err != nil {
+			return 0, nil, err
+		}
+		p, err := playerRepo.Create(ctx, model.Player{TeamID: home.ID, FirstName: "John", LastName: "Doe", Position: "SG"})
+		if err != nil {
+			return 0, nil, err
+		}
+		var games []int64
+		for i, season := range []string{"2024-25", "2025-26"} {
+			g, err := gameRepo.Create(ctx, model.Game{Season: season, Date: time.Now().UTC().AddDate(-1+i, 0, 0), HomeTeamID: home.ID, AwayTeamID: away.ID, Status: "in_progress"})
+			if err != nil {
+				return 0, nil, err
+			}
+			games = append(games, g.ID)
+		}
+		return p.ID, games, nil
+	}
+	return pg.NewShotRepository(pool), mkGames, func() { truncateAll(t) }
+}
+
+func makeStaffRepo(t *testing.T) (repository.StaffRepository, func(ctx context.Context, name string) (int64, error), func(ctx context.Context, season string, date time.Time, homeID, awayID int64, homeScore, awayScore int) error, func()) {
+	skipIfNeeded(t)
+	truncateAll(t)
+	teamRepo := pg.NewTeamRepository(pool)
+	gameRepo := pg.NewGameRepository(pool)
+	mkTeam := func(ctx context.Context, name string) (int64, error) {
+		tm, err := teamRepo.Create(ctx, model.Team{Name: name})
+		if err != nil {
+			return 0, err
+		}
+		return tm.ID, nil
+	}
+	mkGame := func(ctx context.Context, season string, date time.Time, homeID, awayID int64, homeScore, awayScore int) error {
+		g, err := gameRepo.Create(ctx, model.Game{Season: season, Date: date, HomeTeamID: homeID, AwayTeamID: awayID, Status: "finished"})
+		if err != nil {
+			return err
+		}
+		_, err = gameRepo.UpdateScore(ctx, g.ID, model.GameScore{HomeScore: homeScore, AwayScore: awayScore})
+		return err
+	}
+	return pg.NewStaffRepository(pool), mkTeam, mkGame, func() { truncateAll(t) }
+}
+
 func makeTx(t *testing.T) (repository.TxManager, repository.TeamRepository, func()) {
 	skipIfNeeded(t)
 	truncateAll(t)
@@ -189,6 +428,27 @@ func makePinger(t *testing.T) (repository.Pinger, func()) {
 	return pg.NewPinger(pool), func() {}
 }
 
+func TestLeagueRepository_PostgresContract(t *testing.T) {
+	contract.RunLeagueRepositoryContract(t, makeLeagueRepo)
+}
+func TestVenueRepository_PostgresContract(t *testing.T) {
+	contract.RunVenueRepositoryContract(t, makeVenueRepo)
+}
+func TestOfficialRepository_PostgresContract(t *testing.T) {
+	contract.RunOfficialRepositoryContract(t, makeOfficialRepo)
+}
+func TestAvailabilityRepository_PostgresContract(t *testing.T) {
+	contract.RunAvailabilityRepositoryContract(t, makeAvailabilityRepo)
+}
+func TestLineupRepository_PostgresContract(t *testing.T) {
+	contract.RunLineupRepositoryContract(t, makeLineupRepo)
+}
+func TestShotRepository_PostgresContract(t *testing.T) {
+	contract.RunShotRepositoryContract(t, makeShotRepo)
+}
+func TestStaffRepository_PostgresContract(t *testing.T) {
+	contract.RunStaffRepositoryContract(t, makeStaffRepo)
+}
 func TestTeamRepository_PostgresContract(t *testing.T) {
 	contract.RunTeamRepositoryContract(t, makeTeamRepo)
 }
@@ -201,5 +461,8 @@ func TestGameRepository_PostgresContract(t *testing.T) {
 func TestStatsRepository_PostgresContract(t *testing.T) {
 	contract.RunStatsRepositoryContract(t, makeStatsRepo)
 }
+func TestEventRepository_PostgresContract(t *testing.T) {
+	contract.RunEventRepositoryContract(t, makeEventRepo)
+}
 func TestTxManager_PostgresContract(t *testing.T) { contract.RunTxManagerContract(t, makeTx) }
 func TestPinger_PostgresContract(t *testing.T)    { contract.RunPingerContract(t, makePinger) }
@@ -4,6 +4,8 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/maxviazov/basketball-stats-service/internal/repository"
 	"github.com/maxviazov/basketball-stats-service/internal/service"
 	"github.com/maxviazov/basketball-stats-service/pkg/response"
@@ -42,3 +44,22 @@ func TestMapError(t *testing.T) {
 		})
 	}
 }
+
+func TestMapError_AlreadyExistsNamesFields(t *testing.T) {
+	jersey := repository.MapPgError(&pgconn.PgError{Code: pgerrcode.UniqueViolation, ConstraintName: "ux_players_team_jersey_active"})
+	code, payload := response.MapError(jersey)
+	if code != 409 || payload.Error != "already_exists" {
+		t.Fatalf("unexpected mapping: (%d,%s)", code, payload.Error)
+	}
+	if len(payload.FieldErrors) != 1 || payload.FieldErrors[0].Field != "jersey_number" {
+		t.Fatalf("expected a jersey_number field error, got %+v", payload.FieldErrors)
+	}
+
+	other := repository.MapPgError(&pgconn.PgError{Code: pgerrcode.UniqueViolation, ConstraintName: "ux_teams_league_name_active"})
+	if other != repository.ErrAlreadyExists {
+		t.Fatalf("expected a bare ErrAlreadyExists for an unlisted index, got %v", other)
+	}
+	if _, payload := response.MapError(other); payload.FieldErrors != nil {
+		t.Fatalf("expected no field errors, got %+v", payload.FieldErrors)
+	}
+}
@@ -0,0 +1,105 @@
+package service_test
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/maxviazov/basketball-stats-service/internal/model"
+	"github.com/maxviazov/basketball-stats-service/internal/repository"
+	"github.com/maxviazov/basketball-stats-service/internal/service"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeAchievementRepo keeps the current single-game feats per player and game, and counts milestone syncs.
+type fakeAchievementRepo struct {
+	feats      map[[2]int64][]model.Achievement
+	syncs      int
+	synced     []int64 // players whose milestones were recomputed, in call order
+	lastFilter model.AchievementFilter
+}
+
+func newFakeAchievementRepo() *fakeAchievementRepo {
+	return &fakeAchievementRepo{feats: map[[2]int64][]model.Achievement{}}
+}
+func (f *fakeAchievementRepo) ReplaceGameFeats(_ context.Context, playerID, gameID int64, feats []model.Achievement) error {
+	if len(feats) == 0 {
+		delete(f.feats, [2]int64{playerID, gameID})
+		return nil
+	}
+	f.feats[[2]int64{playerID, gameID}] = feats
+	return nil
+}
+func (f *fakeAchievementRepo) SyncMilestones(_ context.Context, playerID int64, _ []model.CareerMilestone) error {
+	f.syncs++
+	f.synced = append(f.synced, playerID)
+	return nil
+}
+func (f *fakeAchievementRepo) ListByPlayer(context.Context, int64, repository.Page) (repository.PageResult[model.Achievement], error) {
+	return repository.PageResult[model.Achievement]{}, nil
+}
+func (f *fakeAchievementRepo) List(_ context.Context, fl model.AchievementFilter, _ repository.Page) (repository.PageResult[model.Achievement], error) {
+	f.lastFilter = fl
+	return repository.PageResult[model.Achievement]{}, nil
+}
+
+var _ repository.AchievementRepository = (*fakeAchievementRepo)(nil)
+
+func (f *fakeAchievementRepo) kinds(playerID, gameID int64) []string {
+	var out []string
+	for _, a := range f.feats[[2]int64{playerID, gameID}] {
+		out = append(out, a.Kind)
+	}
+	return out
+}
+
+func TestStatsService_UpsertStatLine_DetectsAchievements(t *testing.T) {
+	ctx := context.Background()
+	achievements := newFakeAchievementRepo()
+	svc := service.NewStatsService(&fakeStatsRepo{}, &fakePlayerLookup{ok: map[int64]bool{1: true}}, &fakeGameLookup{ok: map[int64]bool{2: true}}, newFakeRuleProfileRepo(), achievements, newFakeAvailabilityRepo(), newFakeShotRepo(), &fakeTxStats{}, zerolog.New(io.Discard))
+
+	// 42 points on 16/30 FG, 4/10 3PT, 6/8 FT with 21 rebounds and 10 assists.
+	line := model.PlayerStatLine{
+		PlayerID: 1, GameID: 2, Points: 42, Rebounds: 21, OffensiveRebounds: 5, DefensiveRebounds: 16, Assists: 10,
+		FieldGoalsMade: 16, FieldGoalsAttempted: 30, ThreePointersMade: 4, ThreePointersAttempted: 10,
+		FreeThrowsMade: 6, FreeThrowsAttempted: 8,
+	}
+	_, err := svc.UpsertStatLine(ctx, line)
+	require.NoError(t, err)
+	require.Equal(t, []string{"triple_double", "forty_points", "twenty_rebounds"}, achievements.kinds(1, 2))
+	require.Equal(t, 1, achievements.syncs)
+
+	// The corrected line loses the assist, the rebounds and two points: only a double-double is left.
+	line.Points, line.FreeThrowsMade = 40-2, 2
+	line.Rebounds, line.DefensiveRebounds = 19, 14
+	line.Assists = 9
+	_, err = svc.UpsertStatLine(ctx, line)
+	require.NoError(t, err)
+	require.Equal(t, []string{"double_double"}, achievements.kinds(1, 2))
+	require.Equal(t, 2, achievements.syncs)
+
+	// Nothing is detected for a line that fails validation.
+	_, err = svc.UpsertStatLine(ctx, model.PlayerStatLine{PlayerID: 1, GameID: 99})
+	require.True(t, serviceErrIsInvalid(err))
+	require.Equal(t, 2, achievements.syncs)
+}
+
+func TestAchievementService_List(t *testing.T) {
+	ctx := context.Background()
+	achievements := newFakeAchievementRepo()
+	svc := service.NewAchievementService(achievements, &fakePlayerLookup{ok: map[int64]bool{1: true}}, zerolog.New(io.Discard))
+
+	badSeason, badKind := "2025", "quadruple_double"
+	_, err := svc.ListAchievements(ctx, model.AchievementFilter{Season: &badSeason, Kind: &badKind}, repository.Page{})
+	require.True(t, serviceErrIsInvalid(err))
+	require.Len(t, service.FieldErrors(err), 2)
+
+	season, kind := "2025-26", "career_points"
+	_, err = svc.ListAchievements(ctx, model.AchievementFilter{Season: &season, Kind: &kind}, repository.Page{})
+	require.NoError(t, err)
+	require.Equal(t, "career_points", *achievements.lastFilter.Kind)
+
+	_, err = svc.ListPlayerAchievements(ctx, 7, repository.Page{})
+	require.ErrorIs(t, err, repository.ErrNotFound)
+}
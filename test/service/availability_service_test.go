@@ -0,0 +1,178 @@
+package service_test
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/maxviazov/basketball-stats-service/internal/model"
+	"github.com/maxviazov/basketball-stats-service/internal/repository"
+	"github.com/maxviazov/basketball-stats-service/internal/service"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+type availabilityKey struct{ gameID, playerID int64 }
+
+type fakeAvailabilityRepo struct {
+	records  map[availabilityKey]model.PlayerAvailability
+	nextID   int64
+	injuries map[int64]model.Injury
+}
+
+func newFakeAvailabilityRepo() *fakeAvailabilityRepo {
+	return &fakeAvailabilityRepo{records: map[availabilityKey]model.PlayerAvailability{}, nextID: 1, injuries: map[int64]model.Injury{}}
+}
+func (f *fakeAvailabilityRepo) SetAvailability(_ context.Context, a model.PlayerAvailability) (model.PlayerAvailability, error) {
+	f.records[availabilityKey{a.GameID, a.PlayerID}] = a
+	return a, nil
+}
+func (f *fakeAvailabilityRepo) GetAvailability(_ context.Context, gameID, playerID int64) (model.PlayerAvailability, error) {
+	a, ok := f.records[availabilityKey{gameID, playerID}]
+	if !ok {
+		return model.PlayerAvailability{}, repository.ErrNotFound
+	}
+	return a, nil
+}
+func (f *fakeAvailabilityRepo) ListAvailability(_ context.Context, gameID int64) ([]model.PlayerAvailability, error) {
+	var out []model.PlayerAvailability
+	for k, a := range f.records {
+		if k.gameID == gameID {
+			out = append(out, a)
+		}
+	}
+	return out, nil
+}
+func (f *fakeAvailabilityRepo) DeleteAvailability(_ context.Context, gameID, playerID int64) error {
+	k := availabilityKey{gameID, playerID}
+	if _, ok := f.records[k]; !ok {
+		return repository.ErrNotFound
+	}
+	delete(f.records, k)
+	return nil
+}
+func (f *fakeAvailabilityRepo) CreateInjury(_ context.Context, inj model.Injury) (model.Injury, error) {
+	inj.ID = f.nextID
+	f.nextID++
+	f.injuries[inj.ID] = inj
+	return inj, nil
+}
+func (f *fakeAvailabilityRepo) GetInjury(_ context.Context, playerID, injuryID int64) (model.Injury, error) {
+	inj, ok := f.injuries[injuryID]
+	if !ok || inj.PlayerID != playerID {
+		return model.Injury{}, repository.ErrNotFound
+	}
+	return inj, nil
+}
+func (f *fakeAvailabilityRepo) UpdateInjury(_ context.Context, inj model.Injury) (model.Injury, error) {
+	f.injuries[inj.ID] = inj
+	return inj, nil
+}
+func (f *fakeAvailabilityRepo) ListInjuries(_ context.Context, playerID int64) ([]model.Injury, error) {
+	var out []model.Injury
+	for _, inj := range f.injuries {
+		if inj.PlayerID == playerID {
+			out = append(out, inj)
+		}
+	}
+	return out, nil
+}
+
+var _ repository.AvailabilityRepository = (*fakeAvailabilityRepo)(nil)
+
+// linesStatsRepo serves fixed lines for ListByGame.
+type linesStatsRepo struct {
+	fakeStatsRepo
+	lines []model.PlayerStatLine
+}
+
+func (f *linesStatsRepo) ListByGame(context.Context, int64) ([]model.PlayerStatLine, error) {
+	return f.lines, nil
+}
+
+func TestAvailabilityService_SetAvailability(t *testing.T) {
+	ctx := context.Background()
+	players := &fakePlayerLookup{ok: map[int64]bool{2: true, 5: true, 6: true}, team: map[int64]int64{6: 0}}
+	games := &fakeGameLookup{ok: map[int64]bool{3: true}}
+	stats := &linesStatsRepo{lines: []model.PlayerStatLine{
+		{PlayerID: 2, GameID: 3, MinutesPlayed: 12},
+		{PlayerID: 5, GameID: 3}, // kept in the box score as a DNP
+	}}
+	availability := newFakeAvailabilityRepo()
+	svc := service.NewAvailabilityService(availability, players, games, stats, &fakeTxStats{}, zerolog.New(io.Discard))
+
+	_, err := svc.SetAvailability(ctx, 3, 5, "resting", nil)
+	require.Equal(t, []string{"status"}, fieldNames(err))
+
+	reason := "  "
+	a, err := svc.SetAvailability(ctx, 3, 5, "DNP-Coach", &reason)
+	require.NoError(t, err)
+	require.Equal(t, "dnp_coach", a.Status)
+	require.Nil(t, a.Reason, "a blank reason is dropped")
+
+	_, err = svc.SetAvailability(ctx, 3, 2, "injured", nil)
+	require.Equal(t, []string{"status"}, fieldNames(err), "player 2 has minutes in the game")
+	_, err = svc.SetAvailability(ctx, 3, 2, "active", nil)
+	require.NoError(t, err)
+
+	_, err = svc.SetAvailability(ctx, 3, 6, "inactive", nil)
+	require.Equal(t, []string{"player_id"}, fieldNames(err), "player 6 is on no roster")
+	_, err = svc.SetAvailability(ctx, 3, 9, "inactive", nil)
+	require.Equal(t, []string{"player_id"}, fieldNames(err))
+	_, err = svc.SetAvailability(ctx, 99, 5, "inactive", nil)
+	require.ErrorIs(t, err, repository.ErrNotFound)
+
+	require.NoError(t, svc.ClearAvailability(ctx, 3, 5))
+	require.ErrorIs(t, svc.ClearAvailability(ctx, 3, 5), repository.ErrNotFound)
+}
+
+func TestStatsService_RejectsLinesForPlayersWhoDidNotPlay(t *testing.T) {
+	ctx := context.Background()
+	players := &fakePlayerLookup{ok: map[int64]bool{2: true}}
+	games := &fakeGameLookup{ok: map[int64]bool{3: true}}
+	availability := newFakeAvailabilityRepo()
+	_, _ = availability.SetAvailability(ctx, model.PlayerAvailability{GameID: 3, PlayerID: 2, Status: "injured"})
+	svc := service.NewStatsService(&fakeStatsRepo{}, players, games, newFakeRuleProfileRepo(), newFakeAchievementRepo(), availability, newFakeShotRepo(), &fakeTxStats{}, zerolog.New(io.Discard))
+
+	_, err := svc.UpsertStatLine(ctx, model.PlayerStatLine{PlayerID: 2, GameID: 3, MinutesPlayed: 4})
+	require.Equal(t, []string{"player_id"}, fieldNames(err))
+	_, err = svc.UpsertStatLine(ctx, model.PlayerStatLine{PlayerID: 2, GameID: 3})
+	require.NoError(t, err, "an empty line does not contradict the record")
+}
+
+func TestAvailabilityService_Injuries(t *testing.T) {
+	ctx := context.Background()
+	players := &fakePlayerLookup{ok: map[int64]bool{2: true}}
+	svc := service.NewAvailabilityService(newFakeAvailabilityRepo(), players, &fakeGameLookup{}, &fakeStatsRepo{}, &fakeTxStats{}, zerolog.New(io.Discard))
+	start := time.Date(2025, 11, 5, 21, 0, 0, 0, time.UTC)
+	before := start.AddDate(0, 0, -1)
+
+	_, err := svc.RecordInjury(ctx, model.Injury{PlayerID: 2, Description: " ", StartDate: start, ExpectedReturnDate: &before})
+	require.ElementsMatch(t, []string{"description", "expected_return_date"}, fieldNames(err))
+	_, err = svc.RecordInjury(ctx, model.Injury{PlayerID: 9, Description: "Sprained ankle", StartDate: start})
+	require.ErrorIs(t, err, repository.ErrNotFound)
+
+	expected := start.AddDate(0, 0, 14)
+	inj, err := svc.RecordInjury(ctx, model.Injury{PlayerID: 2, Description: " Sprained ankle ", StartDate: start, ExpectedReturnDate: &expected})
+	require.NoError(t, err)
+	require.Equal(t, "Sprained ankle", inj.Description)
+	require.Equal(t, time.Date(2025, 11, 5, 0, 0, 0, 0, time.UTC), inj.StartDate)
+
+	_, err = svc.UpdateInjury(ctx, 2, inj.ID, model.InjuryPatch{ReturnedOn: &before})
+	require.Equal(t, []string{"returned_on"}, fieldNames(err))
+	back := start.AddDate(0, 0, 10)
+	inj, err = svc.UpdateInjury(ctx, 2, inj.ID, model.InjuryPatch{ReturnedOn: &back})
+	require.NoError(t, err)
+	require.Equal(t, "2025-11-15", inj.ReturnedOn.Format("2006-01-02"))
+	require.Equal(t, expected.Format("2006-01-02"), inj.ExpectedReturnDate.Format("2006-01-02"))
+
+	_, err = svc.UpdateInjury(ctx, 3, inj.ID, model.InjuryPatch{})
+	require.ErrorIs(t, err, repository.ErrNotFound, "another player's injury")
+}
+
+func TestPlayerStatLine_Played(t *testing.T) {
+	require.False(t, model.PlayerStatLine{}.Played())
+	require.True(t, model.PlayerStatLine{MinutesPlayed: 0.5}.Played())
+	require.True(t, model.PlayerStatLine{Fouls: 1}.Played(), "a foul in seconds of play still counts")
+}
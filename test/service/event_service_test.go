@@ -0,0 +1,342 @@
+package service_test
+
+import (
+	"context"
+	"io"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/maxviazov/basketball-stats-service/internal/model"
+	"github.com/maxviazov/basketball-stats-service/internal/repository"
+	"github.com/maxviazov/basketball-stats-service/internal/service"
+)
+
+// fakeEventRepo keeps events in memory and replays them in the same order as the postgres implementation.
+type fakeEventRepo struct {
+	nextID int64
+	events map[int64]model.GameEvent
+}
+
+func newFakeEventRepo() *fakeEventRepo {
+	return &fakeEventRepo{nextID: 1, events: map[int64]model.GameEvent{}}
+}
+func (f *fakeEventRepo) Create(_ context.Context, e model.GameEvent) (model.GameEvent, error) {
+	e.ID = f.nextID
+	f.nextID++
+	f.events[e.ID] = e
+	return e, nil
+}
+func (f *fakeEventRepo) GetByID(_ context.Context, id int64) (model.GameEvent, error) {
+	e, ok := f.events[id]
+	if !ok {
+		return model.GameEvent{}, repository.ErrNotFound
+	}
+	return e, nil
+}
+func (f *fakeEventRepo) Update(_ context.Context, e model.GameEvent) (model.GameEvent, error) {
+	if _, ok := f.events[e.ID]; !ok {
+		return model.GameEvent{}, repository.ErrNotFound
+	}
+	f.events[e.ID] = e
+	return e, nil
+}
+func (f *fakeEventRepo) Delete(_ context.Context, id int64) error {
+	if _, ok := f.events[id]; !ok {
+		return repository.ErrNotFound
+	}
+	delete(f.events, id)
+	return nil
+}
+func (f *fakeEventRepo) ListByGame(_ context.Context, gameID int64) ([]model.GameEvent, error) {
+	return f.filter(func(e model.GameEvent) bool { return e.GameID == gameID }), nil
+}
+func (f *fakeEventRepo) ListByPlayerGame(_ context.Context, playerID, gameID int64) ([]model.GameEvent, error) {
+	return f.filter(func(e model.GameEvent) bool { return e.GameID == gameID && e.PlayerID == playerID }), nil
+}
+func (f *fakeEventRepo) LockGame(context.Context, int64) error { return nil }
+func (f *fakeEventRepo) filter(keep func(model.GameEvent) bool) []model.GameEvent {
+	var out []model.GameEvent
+	for _, e := range f.events {
+		if keep(e) {
+			out = append(out, e)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Period != out[j].Period {
+			return out[i].Period < out[j].Period
+		}
+		if out[i].ClockSeconds != out[j].ClockSeconds {
+			return out[i].ClockSeconds > out[j].ClockSeconds
+		}
+		return out[i].ID < out[j].ID
+	})
+	return out
+}
+
+var _ repository.EventRepository = (*fakeEventRepo)(nil)
+
+// fakeLineStore is a StatsRepository that keeps the current line per (player, game).
+type fakeLineStore struct {
+	lines map[[2]int64]model.PlayerStatLine
+}
+
+func newFakeLineStore() *fakeLineStore {
+	return &fakeLineStore{lines: map[[2]int64]model.PlayerStatLine{}}
+}
+func (f *fakeLineStore) UpsertStatLine(_ context.Context, s model.PlayerStatLine) (model.PlayerStatLine, error) {
+	f.lines[[2]int64{s.PlayerID, s.GameID}] = s
+	return s, nil
+}
+func (f *fakeLineStore) ListByGame(_ context.Context, gameID int64) ([]model.PlayerStatLine, error) {
+	var out []model.PlayerStatLine
+	for k, l := range f.lines {
+		if k[1] == gameID {
+			out = append(out, l)
+		}
+	}
+	return out, nil
+}
+func (f *fakeLineStore) ListByPlayer(context.Context, int64, model.GameLogFilter, repository.Page) (repository.PageResult[model.GameLogEntry], error) {
+	return repository.PageResult[model.GameLogEntry]{}, nil
+}
+func (f *fakeLineStore) DeleteStatLine(_ context.Context, playerID, gameID int64) error {
+	delete(f.lines, [2]int64{playerID, gameID})
+	return nil
+}
+
+var _ repository.StatsRepository = (*fakeLineStore)(nil)
+
+// eventGames counts periods played from the fixture's events as well, like the postgres implementation.
+type eventGames struct {
+	*fakeGameRepo
+	events *fakeEventRepo
+}
+
+func (g eventGames) CountPeriodsPlayed(ctx context.Context, id int64) (int, error) {
+	played, err := g.fakeGameRepo.CountPeriodsPlayed(ctx, id)
+	for _, e := range g.events.events {
+		if e.GameID == id {
+			played = max(played, e.Period)
+		}
+	}
+	return played, err
+}
+
+type eventFixture struct {
+	svc          service.EventService
+	events       *fakeEventRepo
+	lines        *fakeLineStore
+	achievements *fakeAchievementRepo
+	players      *fakePlayerRepo
+	availability *fakeAvailabilityRepo
+	gameID       int64
+	scheduledID  int64
+	homeID       int64
+	awayID       int64
+	starID       int64
+	benchID      int64
+	rivalID      int64
+	stranger     int64
+}
+
+func newEventFixture(t *testing.T) eventFixture {
+	t.Helper()
+	ctx := context.Background()
+	games := newFakeGameRepo()
+	g, err := games.Create(ctx, model.Game{Season: "2025-26", Date: time.Now(), HomeTeamID: 1, AwayTeamID: 2, Status: "in_progress"})
+	require.NoError(t, err)
+	scheduled, err := games.Create(ctx, model.Game{Season: "2025-26", Date: time.Now(), HomeTeamID: 1, AwayTeamID: 2, Status: "scheduled"})
+	require.NoError(t, err)
+	players := newFakePlayerRepo()
+	star, _ := players.Create(ctx, model.Player{TeamID: 1, FirstName: "Star", LastName: "Home"})
+	bench, _ := players.Create(ctx, model.Player{TeamID: 1, FirstName: "Bench", LastName: "Home"})
+	rival, _ := players.Create(ctx, model.Player{TeamID: 2, FirstName: "Rival", LastName: "Away"})
+	stranger, _ := players.Create(ctx, model.Player{TeamID: 3, FirstName: "Not", LastName: "Playing"})
+	events := newFakeEventRepo()
+	lines := newFakeLineStore()
+	achievements := newFakeAchievementRepo()
+	availability := newFakeAvailabilityRepo()
+	svc := service.NewEventService(events, lines, achievements, players, eventGames{games, events}, newFakeRuleProfileRepo(), availability, newFakeShotRepo(), &fakeTx{}, zerolog.New(io.Discard))
+	return eventFixture{svc: svc, events: events, lines: lines, achievements: achievements, players: players, availability: availability, gameID: g.ID, scheduledID: scheduled.ID, homeID: 1, awayID: 2,
+		starID: star.ID, benchID: bench.ID, rivalID: rival.ID, stranger: stranger.ID}
+}
+
+func (f eventFixture) line(playerID int64) (model.PlayerStatLine, bool) {
+	l, ok := f.lines.lines[[2]int64{playerID, f.gameID}]
+	return l, ok
+}
+
+func TestEventService_RecordEvent_Validation(t *testing.T) {
+	f := newEventFixture(t)
+	ctx := context.Background()
+	base := model.GameEvent{GameID: f.gameID, Period: 1, ClockSeconds: 600, TeamID: f.homeID, PlayerID: f.starID, EventType: "two_pt_made"}
+
+	cases := []struct {
+		name   string
+		mutate func(e *model.GameEvent)
+		field  string
+	}{
+		{"unknown type", func(e *model.GameEvent) { e.EventType = "dunk_contest" }, "event_type"},
+		{"bad period", func(e *model.GameEvent) { e.Period = 0 }, "period"},
+		{"clock past period length", func(e *model.GameEvent) { e.ClockSeconds = 721 }, "clock_seconds"},
+		{"overtime clock", func(e *model.GameEvent) { e.Period = 5; e.ClockSeconds = 301 }, "clock_seconds"},
+		{"team not in game", func(e *model.GameEvent) { e.TeamID = 3; e.PlayerID = f.stranger }, "team_id"},
+		{"player on other team", func(e *model.GameEvent) { e.PlayerID = f.rivalID }, "player_id"},
+		{"player missing", func(e *model.GameEvent) { e.PlayerID = 999 }, "player_id"},
+		{"game not started", func(e *model.GameEvent) { e.GameID = f.scheduledID }, "game_id"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := base
+			tc.mutate(&e)
+			_, err := f.svc.RecordEvent(ctx, e)
+			require.Error(t, err)
+			require.True(t, serviceErrIsInvalid(err), "want invalid input, got %v", err)
+			found := false
+			for _, fe := range service.FieldErrors(err) {
+				if fe.Field == tc.field {
+					found = true
+				}
+			}
+			require.True(t, found, "missing field error %s in %+v", tc.field, service.FieldErrors(err))
+		})
+	}
+}
+
+func TestEventService_RecordEvent_RosterOnGameDate(t *testing.T) {
+	f := newEventFixture(t)
+	ctx := context.Background()
+	_, err := f.players.Transfer(ctx, f.starID, f.awayID, time.Now().AddDate(0, 0, 2))
+	require.NoError(t, err)
+
+	// Still on the home roster on the game date, although the away team holds the player now.
+	_, err = f.svc.RecordEvent(ctx, model.GameEvent{GameID: f.gameID, Period: 1, ClockSeconds: 600, TeamID: f.awayID, PlayerID: f.starID, EventType: "two_pt_made"})
+	require.Equal(t, []string{"player_id"}, fieldNames(err))
+	_, err = f.svc.RecordEvent(ctx, model.GameEvent{GameID: f.gameID, Period: 1, ClockSeconds: 600, TeamID: f.homeID, PlayerID: f.starID, EventType: "two_pt_made"})
+	require.NoError(t, err)
+}
+
+func TestEventService_DerivesBoxScore(t *testing.T) {
+	f := newEventFixture(t)
+	ctx := context.Background()
+	record := func(period, clock int, playerID, teamID int64, typ string) model.GameEvent {
+		t.Helper()
+		ev, err := f.svc.RecordEvent(ctx, model.GameEvent{GameID: f.gameID, Period: period, ClockSeconds: clock, TeamID: teamID, PlayerID: playerID, EventType: typ})
+		require.NoError(t, err)
+		return ev
+	}
+
+	record(1, 720, f.starID, f.homeID, "substitution_in")
+	record(1, 650, f.starID, f.homeID, "three_pt_made")
+	record(1, 600, f.starID, f.homeID, "two_pt_missed")
+	record(1, 590, f.starID, f.homeID, "offensive_rebound")
+	record(1, 588, f.starID, f.homeID, "two_pt_made")
+	record(1, 500, f.starID, f.homeID, "free_throw_made")
+	record(1, 500, f.starID, f.homeID, "free_throw_missed")
+	record(1, 420, f.starID, f.homeID, "substitution_out") // 5:00 on court
+	record(2, 720, f.starID, f.homeID, "substitution_in")  // plays all of Q2: 12:00
+	mistake := record(2, 300, f.starID, f.homeID, "foul")
+
+	line, ok := f.line(f.starID)
+	require.True(t, ok)
+	require.Equal(t, 6, line.Points) // 3 + 2 + 1
+	require.Equal(t, 2, line.FieldGoalsMade)
+	require.Equal(t, 3, line.FieldGoalsAttempted)
+	require.Equal(t, 1, line.ThreePointersMade)
+	require.Equal(t, 1, line.FreeThrowsMade)
+	require.Equal(t, 2, line.FreeThrowsAttempted)
+	require.Equal(t, 1, line.Rebounds)
+	require.Equal(t, 1, line.OffensiveRebounds)
+	require.Equal(t, 1, line.Fouls)
+	require.InDelta(t, 17.0, line.MinutesPlayed, 0.001)
+
+	// Correcting the foul onto another player moves it between lines.
+	moved := mistake
+	moved.PlayerID = f.benchID
+	_, err := f.svc.CorrectEvent(ctx, moved)
+	require.NoError(t, err)
+	line, _ = f.line(f.starID)
+	require.Equal(t, 0, line.Fouls)
+	bench, ok := f.line(f.benchID)
+	require.True(t, ok)
+	require.Equal(t, 1, bench.Fouls)
+
+	// Deleting the bench player's only event removes their derived line entirely.
+	require.NoError(t, f.svc.DeleteEvent(ctx, f.gameID, mistake.ID))
+	_, ok = f.line(f.benchID)
+	require.False(t, ok)
+}
+
+func TestEventService_RejectsImpossibleDerivedLine(t *testing.T) {
+	f := newEventFixture(t)
+	ctx := context.Background()
+	for i := 0; i < 6; i++ {
+		_, err := f.svc.RecordEvent(ctx, model.GameEvent{GameID: f.gameID, Period: 1, ClockSeconds: 700 - i, TeamID: f.homeID, PlayerID: f.starID, EventType: "foul"})
+		require.NoError(t, err)
+	}
+	_, err := f.svc.RecordEvent(ctx, model.GameEvent{GameID: f.gameID, Period: 1, ClockSeconds: 500, TeamID: f.homeID, PlayerID: f.starID, EventType: "foul"})
+	require.Error(t, err)
+	require.True(t, serviceErrIsInvalid(err))
+	require.Equal(t, "fouls", service.FieldErrors(err)[0].Field)
+}
+
+func TestEventService_OvertimeBeforeLineScore(t *testing.T) {
+	f := newEventFixture(t)
+	ctx := context.Background()
+	for period := 1; period <= 4; period++ {
+		_, err := f.svc.RecordEvent(ctx, model.GameEvent{GameID: f.gameID, Period: period, ClockSeconds: 720, TeamID: f.homeID, PlayerID: f.starID, EventType: "substitution_in"})
+		require.NoError(t, err)
+	}
+	line, _ := f.line(f.starID)
+	require.Equal(t, float32(48), line.MinutesPlayed)
+
+	// No period scores exist yet; the overtime is known from its own events, as in the stats trigger.
+	_, err := f.svc.RecordEvent(ctx, model.GameEvent{GameID: f.gameID, Period: 5, ClockSeconds: 300, TeamID: f.homeID, PlayerID: f.starID, EventType: "substitution_in"})
+	require.NoError(t, err)
+	line, _ = f.line(f.starID)
+	require.Equal(t, float32(53), line.MinutesPlayed)
+}
+
+func TestEventService_RejectsEventsForPlayersWhoDidNotPlay(t *testing.T) {
+	f := newEventFixture(t)
+	ctx := context.Background()
+	_, _ = f.availability.SetAvailability(ctx, model.PlayerAvailability{GameID: f.gameID, PlayerID: f.starID, Status: "injured"})
+
+	_, err := f.svc.RecordEvent(ctx, model.GameEvent{GameID: f.gameID, Period: 1, ClockSeconds: 600, TeamID: f.homeID, PlayerID: f.starID, EventType: "two_pt_made"})
+	require.Equal(t, []string{"player_id"}, fieldNames(err))
+	_, ok := f.line(f.starID)
+	require.False(t, ok)
+
+	_, err = f.svc.RecordEvent(ctx, model.GameEvent{GameID: f.gameID, Period: 1, ClockSeconds: 600, TeamID: f.homeID, PlayerID: f.benchID, EventType: "two_pt_made"})
+	require.NoError(t, err)
+}
+
+func TestEventService_DeleteEvent_WrongGame(t *testing.T) {
+	f := newEventFixture(t)
+	ctx := context.Background()
+	ev, err := f.svc.RecordEvent(ctx, model.GameEvent{GameID: f.gameID, Period: 1, ClockSeconds: 700, TeamID: f.homeID, PlayerID: f.starID, EventType: "steal"})
+	require.NoError(t, err)
+	err = f.svc.DeleteEvent(ctx, f.gameID+1, ev.ID)
+	require.ErrorIs(t, err, repository.ErrNotFound)
+}
+
+func TestEventService_RederivesAchievements(t *testing.T) {
+	f := newEventFixture(t)
+	ctx := context.Background()
+	var last model.GameEvent
+	for i := 0; i < 10; i++ {
+		_, err := f.svc.RecordEvent(ctx, model.GameEvent{GameID: f.gameID, Period: 1, ClockSeconds: 700 - i, TeamID: f.homeID, PlayerID: f.starID, EventType: "steal"})
+		require.NoError(t, err)
+		last, err = f.svc.RecordEvent(ctx, model.GameEvent{GameID: f.gameID, Period: 2, ClockSeconds: 700 - i, TeamID: f.homeID, PlayerID: f.starID, EventType: "block"})
+		require.NoError(t, err)
+	}
+	require.Equal(t, []string{"double_double"}, f.achievements.kinds(f.starID, f.gameID))
+
+	// Dropping the tenth block takes the double-double with it.
+	require.NoError(t, f.svc.DeleteEvent(ctx, f.gameID, last.ID))
+	require.Empty(t, f.achievements.kinds(f.starID, f.gameID))
+}
@@ -11,15 +11,25 @@ import (
 	"github.com/maxviazov/basketball-stats-service/internal/model"
 	"github.com/maxviazov/basketball-stats-service/internal/repository"
 	"github.com/maxviazov/basketball-stats-service/internal/service"
+	"github.com/stretchr/testify/require"
 )
 
 type fakeGameRepo struct {
-	nextID int64
-	games  map[int64]model.Game
+	nextID       int64
+	games        map[int64]model.Game
+	playerPoints [2]int                         // home, away sums returned by GetPlayerPointTotals
+	lines        map[int64][]model.BoxScoreLine // box score lines by game
+	deleted      map[int64]model.Game
+	transitions  []model.GameTransition
 }
 
-func newFakeGameRepo() *fakeGameRepo { return &fakeGameRepo{nextID: 1, games: map[int64]model.Game{}} }
+func newFakeGameRepo() *fakeGameRepo {
+	return &fakeGameRepo{nextID: 1, games: map[int64]model.Game{}, deleted: map[int64]model.Game{}}
+}
 func (f *fakeGameRepo) Create(_ context.Context, g model.Game) (model.Game, error) {
+	if g.RuleProfileID == 0 {
+		g.RuleProfileID = nbaRulesID
+	}
 	g.ID = f.nextID
 	f.nextID++
 	f.games[g.ID] = g
@@ -41,6 +51,97 @@ func (f *fakeGameRepo) List(_ context.Context, _ repository.Page) (repository.Pa
 	return res, nil
 }
 
+func (f *fakeGameRepo) UpdateScore(_ context.Context, id int64, s model.GameScore) (model.Game, error) {
+	g, ok := f.games[id]
+	if !ok {
+		return model.Game{}, repository.ErrNotFound
+	}
+	home, away := s.HomeScore, s.AwayScore
+	g.HomeScore, g.AwayScore, g.LineScore = &home, &away, s.Periods
+	f.games[id] = g
+	return g, nil
+}
+func (f *fakeGameRepo) ListPeriodScores(_ context.Context, id int64) ([]model.PeriodScore, error) {
+	return f.games[id].LineScore, nil
+}
+func (f *fakeGameRepo) CountPeriodsPlayed(_ context.Context, id int64) (int, error) {
+	return len(f.games[id].LineScore), nil
+}
+func (f *fakeGameRepo) GetPlayerPointTotals(_ context.Context, id int64) (int, int, error) {
+	if _, ok := f.games[id]; !ok {
+		return 0, 0, repository.ErrNotFound
+	}
+	return f.playerPoints[0], f.playerPoints[1], nil
+}
+
+func (f *fakeGameRepo) GetBoxScore(_ context.Context, id int64) (model.BoxScore, error) {
+	g, ok := f.games[id]
+	if !ok {
+		return model.BoxScore{}, repository.ErrNotFound
+	}
+	box := model.BoxScore{Game: g, Home: model.BoxScoreTeam{TeamID: g.HomeTeamID}, Away: model.BoxScoreTeam{TeamID: g.AwayTeamID}}
+	for _, l := range f.lines[id] {
+		if l.TeamID == g.HomeTeamID {
+			box.Home.Players = append(box.Home.Players, l)
+		} else {
+			box.Away.Players = append(box.Away.Players, l)
+		}
+	}
+	return box, nil
+}
+
+func (f *fakeGameRepo) Update(_ context.Context, g model.Game) (model.Game, error) {
+	stored, ok := f.games[g.ID]
+	if !ok {
+		return model.Game{}, repository.ErrNotFound
+	}
+	stored.Date, stored.Phase, stored.VenueID, stored.Attendance = g.Date, g.Phase, g.VenueID, g.Attendance
+	f.games[g.ID] = stored
+	return stored, nil
+}
+func (f *fakeGameRepo) Delete(_ context.Context, id int64) error {
+	g, ok := f.games[id]
+	if !ok {
+		return repository.ErrNotFound
+	}
+	delete(f.games, id)
+	f.deleted[id] = g
+	return nil
+}
+func (f *fakeGameRepo) Restore(_ context.Context, id int64) (model.Game, error) {
+	g, ok := f.deleted[id]
+	if !ok {
+		return model.Game{}, repository.ErrNotFound
+	}
+	delete(f.deleted, id)
+	f.games[id] = g
+	return g, nil
+}
+
+func (f *fakeGameRepo) Transition(_ context.Context, id int64, from, to string) (model.GameTransition, error) {
+	g, ok := f.games[id]
+	if !ok {
+		return model.GameTransition{}, repository.ErrNotFound
+	}
+	if g.Status != from {
+		return model.GameTransition{}, repository.ErrConflict
+	}
+	g.Status = to
+	f.games[id] = g
+	tr := model.GameTransition{ID: int64(len(f.transitions) + 1), GameID: id, FromStatus: from, ToStatus: to, TransitionedAt: time.Now()}
+	f.transitions = append(f.transitions, tr)
+	return tr, nil
+}
+func (f *fakeGameRepo) ListTransitions(_ context.Context, gameID int64) ([]model.GameTransition, error) {
+	var out []model.GameTransition
+	for _, tr := range f.transitions {
+		if tr.GameID == gameID {
+			out = append(out, tr)
+		}
+	}
+	return out, nil
+}
+
 var _ repository.GameRepository = (*fakeGameRepo)(nil)
 
 type fakeExistTeamRepo struct{ exist map[int64]bool }
@@ -57,13 +158,33 @@ func (f *fakeExistTeamRepo) GetByID(_ context.Context, id int64) (model.Team, er
 func (f *fakeExistTeamRepo) List(context.Context, repository.Page) (repository.PageResult[model.Team], error) {
 	return repository.PageResult[model.Team]{}, nil
 }
-func (f *fakeExistTeamRepo) GetTeamAggregatedStats(context.Context, int64, *string) (model.TeamAggregatedStats, error) {
+func (f *fakeExistTeamRepo) GetTeamAggregatedStats(context.Context, int64, *string, *string) (model.TeamAggregatedStats, error) {
 	return model.TeamAggregatedStats{}, nil // Dummy implementation
 }
 func (f *fakeExistTeamRepo) Exists(_ context.Context, id int64) (bool, error) {
 	return f.exist[id], nil
 }
 
+func (f *fakeExistTeamRepo) ListStandings(context.Context, string, *string) ([]model.TeamStanding, error) {
+	return nil, nil
+}
+
+func (f *fakeExistTeamRepo) GetTeamSplits(context.Context, int64, *string, *string, []string) ([]model.TeamSplit, error) {
+	return nil, nil
+}
+
+func (f *fakeExistTeamRepo) ListAttendance(context.Context, *string, *int64) ([]model.TeamAttendance, error) {
+	return nil, nil
+}
+
+func (f *fakeExistTeamRepo) Update(context.Context, model.Team) (model.Team, error) {
+	return model.Team{}, nil
+}
+func (f *fakeExistTeamRepo) Delete(context.Context, int64) error { return nil }
+func (f *fakeExistTeamRepo) Restore(context.Context, int64) (model.Team, error) {
+	return model.Team{}, nil
+}
+
 var _ repository.TeamRepository = (*fakeExistTeamRepo)(nil)
 
 type fakeTx struct{}
@@ -72,31 +193,41 @@ func (f *fakeTx) WithinTx(ctx context.Context, fn repository.TxFunc) error { ret
 
 var _ repository.TxManager = (*fakeTx)(nil)
 
+// gameDay falls inside the regular phase of season2025.
+var gameDay = time.Date(2025, 11, 5, 19, 30, 0, 0, time.UTC)
+
 func TestGameService_CreateGame_Validation(t *testing.T) {
 	logger := zerolog.New(io.Discard)
 	teamRepo := &fakeExistTeamRepo{exist: map[int64]bool{1: true, 2: true}}
 	gameRepo := newFakeGameRepo()
 	tx := &fakeTx{}
-	svc := service.NewGameService(gameRepo, teamRepo, tx, logger)
+	svc := service.NewGameService(gameRepo, teamRepo, newFakeSeasonRepo(season2025()), newFakeRuleProfileRepo(), newFakeVenueRepo(), newFakeLineStore(), newFakeAchievementRepo(), tx, logger)
 
 	cases := []struct {
 		name       string
 		season     string
+		phase      string
 		date       time.Time
 		home, away int64
 		status     string
 		wantErr    bool
 		field      string
 	}{
-		{"same teams", "2025-26", time.Now(), 1, 1, "scheduled", true, "teams"},
-		{"bad season", "2025", time.Now(), 1, 2, "scheduled", true, "season"},
-		{"bad status", "2025-26", time.Now(), 1, 2, "bad", true, "status"},
-		{"missing team", "2025-26", time.Now(), 1, 3, "scheduled", true, "away_team_id"},
-		{"ok", "2025-26", time.Now(), 1, 2, "scheduled", false, ""},
+		{"same teams", "2025-26", "", gameDay, 1, 1, "scheduled", true, "teams"},
+		{"bad season", "2025", "", gameDay, 1, 2, "scheduled", true, "season"},
+		{"bad status", "2025-26", "", gameDay, 1, 2, "bad", true, "status"},
+		{"bad phase", "2025-26", "finals", gameDay, 1, 2, "scheduled", true, "phase"},
+		{"missing team", "2025-26", "", gameDay, 1, 3, "scheduled", true, "away_team_id"},
+		{"unknown season", "2026-27", "", gameDay, 1, 2, "scheduled", true, "season"},
+		{"date outside season", "2025-26", "", time.Date(2026, 8, 1, 0, 0, 0, 0, time.UTC), 1, 2, "scheduled", true, "date"},
+		{"date outside phase", "2025-26", "playoffs", gameDay, 1, 2, "scheduled", true, "date"},
+		{"season without phase", "2025-26", "preseason", gameDay, 1, 2, "scheduled", true, "phase"},
+		{"ok", "2025-26", "", gameDay, 1, 2, "scheduled", false, ""},
+		{"ok playoffs", "2025-26", "Playoffs", time.Date(2026, 5, 2, 0, 0, 0, 0, time.UTC), 1, 2, "scheduled", false, ""},
 	}
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
-			_, err := svc.CreateGame(context.Background(), tc.season, tc.date, tc.home, tc.away, tc.status)
+			_, err := svc.CreateGame(context.Background(), model.Game{Season: tc.season, Phase: tc.phase, Date: tc.date, HomeTeamID: tc.home, AwayTeamID: tc.away, Status: tc.status})
 			if tc.wantErr && err == nil {
 				t.Fatalf("expected error")
 			}
@@ -123,3 +254,255 @@ func TestGameService_CreateGame_Validation(t *testing.T) {
 		})
 	}
 }
+
+func TestGameService_UpdateScore_Validation(t *testing.T) {
+	logger := zerolog.New(io.Discard)
+	teamRepo := &fakeExistTeamRepo{exist: map[int64]bool{1: true, 2: true}}
+	gameRepo := newFakeGameRepo()
+	svc := service.NewGameService(gameRepo, teamRepo, newFakeSeasonRepo(season2025()), newFakeRuleProfileRepo(), newFakeVenueRepo(), newFakeLineStore(), newFakeAchievementRepo(), &fakeTx{}, logger)
+	ctx := context.Background()
+
+	scheduled, err := svc.CreateGame(ctx, model.Game{Season: "2025-26", Date: gameDay, HomeTeamID: 1, AwayTeamID: 2, Status: "scheduled"})
+	if err != nil {
+		t.Fatalf("seed scheduled: %v", err)
+	}
+	finished, err := svc.CreateGame(ctx, model.Game{Season: "2025-26", Date: gameDay, HomeTeamID: 1, AwayTeamID: 2, Status: "finished"})
+	if err != nil {
+		t.Fatalf("seed finished: %v", err)
+	}
+	quarters := func(home, away [4]int) []model.PeriodScore {
+		out := make([]model.PeriodScore, 0, 4)
+		for i := 0; i < 4; i++ {
+			out = append(out, model.PeriodScore{Period: i + 1, HomePoints: home[i], AwayPoints: away[i]})
+		}
+		return out
+	}
+
+	cases := []struct {
+		name    string
+		gameID  int64
+		score   model.GameScore
+		wantErr bool
+		field   string
+	}{
+		{"negative score", finished.ID, model.GameScore{HomeScore: -1, AwayScore: 90}, true, "home_score"},
+		{"period gap", finished.ID, model.GameScore{HomeScore: 10, AwayScore: 8, Periods: []model.PeriodScore{{Period: 1, HomePoints: 5, AwayPoints: 4}, {Period: 3, HomePoints: 5, AwayPoints: 4}}}, true, "periods"},
+		{"periods do not add up", finished.ID, model.GameScore{HomeScore: 100, AwayScore: 90, Periods: quarters([4]int{25, 25, 25, 24}, [4]int{20, 20, 25, 25})}, true, "home_score"},
+		{"tie in finished game", finished.ID, model.GameScore{HomeScore: 90, AwayScore: 90}, true, "away_score"},
+		{"scheduled game", scheduled.ID, model.GameScore{HomeScore: 90, AwayScore: 80}, true, "status"},
+		{"ok with overtime", finished.ID, model.GameScore{HomeScore: 110, AwayScore: 105, Periods: append(quarters([4]int{25, 25, 25, 25}, [4]int{20, 30, 25, 25}), model.PeriodScore{Period: 5, HomePoints: 10, AwayPoints: 5})}, false, ""},
+		{"ok without line score", finished.ID, model.GameScore{HomeScore: 99, AwayScore: 98}, false, ""},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := svc.UpdateScore(ctx, tc.gameID, tc.score)
+			if tc.wantErr && err == nil {
+				t.Fatalf("expected error")
+			}
+			if !tc.wantErr && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tc.wantErr {
+				if !serviceErrIsInvalid(err) {
+					t.Fatalf("expected invalid input, got %v", err)
+				}
+				found := false
+				for _, fe := range service.FieldErrors(err) {
+					if fe.Field == tc.field {
+						found = true
+						break
+					}
+				}
+				if !found {
+					t.Fatalf("expected field %s, got %+v", tc.field, service.FieldErrors(err))
+				}
+			}
+		})
+	}
+}
+
+func TestGameService_ReconcileScore(t *testing.T) {
+	logger := zerolog.New(io.Discard)
+	teamRepo := &fakeExistTeamRepo{exist: map[int64]bool{1: true, 2: true}}
+	gameRepo := newFakeGameRepo()
+	svc := service.NewGameService(gameRepo, teamRepo, newFakeSeasonRepo(season2025()), newFakeRuleProfileRepo(), newFakeVenueRepo(), newFakeLineStore(), newFakeAchievementRepo(), &fakeTx{}, logger)
+	ctx := context.Background()
+
+	g, err := svc.CreateGame(ctx, model.Game{Season: "2025-26", Date: gameDay, HomeTeamID: 1, AwayTeamID: 2, Status: "finished"})
+	if err != nil {
+		t.Fatalf("seed: %v", err)
+	}
+
+	// No official score yet: never consistent, even with no player points either.
+	rec, err := svc.ReconcileScore(ctx, g.ID)
+	if err != nil {
+		t.Fatalf("reconcile: %v", err)
+	}
+	if rec.Consistent {
+		t.Fatalf("expected inconsistent without an official score")
+	}
+
+	if _, err := svc.UpdateScore(ctx, g.ID, model.GameScore{HomeScore: 101, AwayScore: 99}); err != nil {
+		t.Fatalf("update score: %v", err)
+	}
+	gameRepo.playerPoints = [2]int{101, 97}
+	rec, err = svc.ReconcileScore(ctx, g.ID)
+	if err != nil {
+		t.Fatalf("reconcile: %v", err)
+	}
+	if rec.Consistent || rec.AwayPlayerPoints != 97 {
+		t.Fatalf("expected mismatch on away side, got %+v", rec)
+	}
+
+	gameRepo.playerPoints = [2]int{101, 99}
+	rec, err = svc.ReconcileScore(ctx, g.ID)
+	if err != nil {
+		t.Fatalf("reconcile: %v", err)
+	}
+	if !rec.Consistent {
+		t.Fatalf("expected consistent, got %+v", rec)
+	}
+}
+
+func TestGameService_GetBoxScore(t *testing.T) {
+	logger := zerolog.New(io.Discard)
+	teamRepo := &fakeExistTeamRepo{exist: map[int64]bool{1: true, 2: true}}
+	gameRepo := newFakeGameRepo()
+	svc := service.NewGameService(gameRepo, teamRepo, newFakeSeasonRepo(season2025()), newFakeRuleProfileRepo(), newFakeVenueRepo(), newFakeLineStore(), newFakeAchievementRepo(), &fakeTx{}, logger)
+	ctx := context.Background()
+
+	if _, err := svc.GetBoxScore(ctx, 0); !serviceErrIsInvalid(err) {
+		t.Fatalf("expected invalid input for id 0, got %v", err)
+	}
+	if _, err := svc.GetBoxScore(ctx, 42); err != repository.ErrNotFound {
+		t.Fatalf("expected not found, got %v", err)
+	}
+
+	g, err := svc.CreateGame(ctx, model.Game{Season: "2025-26", Date: gameDay, HomeTeamID: 1, AwayTeamID: 2, Status: "finished"})
+	if err != nil {
+		t.Fatalf("seed: %v", err)
+	}
+	gameRepo.lines = map[int64][]model.BoxScoreLine{g.ID: {
+		{TeamID: 1, PlayerStatLine: model.PlayerStatLine{PlayerID: 10, Points: 20, FieldGoalsMade: 8, FieldGoalsAttempted: 15, ThreePointersMade: 2, ThreePointersAttempted: 6, FreeThrowsMade: 2, FreeThrowsAttempted: 2, MinutesPlayed: 34}},
+		{TeamID: 1, PlayerStatLine: model.PlayerStatLine{PlayerID: 11, Points: 7, FieldGoalsMade: 3, FieldGoalsAttempted: 9, ThreePointersMade: 1, ThreePointersAttempted: 3, FreeThrowsMade: 0, FreeThrowsAttempted: 1, MinutesPlayed: 22}},
+	}}
+
+	box, err := svc.GetBoxScore(ctx, g.ID)
+	if err != nil {
+		t.Fatalf("box score: %v", err)
+	}
+	home := box.Home.Totals
+	if home.Games != 1 || home.Points != 27 || home.FieldGoalsMade != 11 || home.FieldGoalsAttempted != 24 || home.Minutes != 56 {
+		t.Fatalf("unexpected home totals %+v", home)
+	}
+	if box.Home.FieldGoalPct != 0.458 || box.Home.ThreePointPct != 0.333 || box.Home.FreeThrowPct != 0.667 {
+		t.Fatalf("unexpected home percentages %+v", box.Home)
+	}
+	// A side without lines has zero totals, not a division by zero.
+	if box.Away.Totals.Games != 0 || box.Away.FieldGoalPct != 0 {
+		t.Fatalf("unexpected away side %+v", box.Away)
+	}
+}
+
+func TestGameService_UpdateDeleteRestore(t *testing.T) {
+	teamRepo := &fakeExistTeamRepo{exist: map[int64]bool{1: true, 2: true}}
+	gameRepo := newFakeGameRepo()
+	lines := newFakeLineStore()
+	achievements := newFakeAchievementRepo()
+	svc := service.NewGameService(gameRepo, teamRepo, newFakeSeasonRepo(season2025()), newFakeRuleProfileRepo(), newFakeVenueRepo(), lines, achievements, &fakeTx{}, zerolog.New(io.Discard))
+	ctx := context.Background()
+	g, err := svc.CreateGame(ctx, model.Game{Season: "2025-26", Date: gameDay, HomeTeamID: 1, AwayTeamID: 2, Status: "scheduled"})
+	require.NoError(t, err)
+
+	bad := "finals"
+	_, err = svc.UpdateGame(ctx, g.ID, model.GamePatch{Phase: &bad})
+	require.Equal(t, "phase", service.FieldErrors(err)[0].Field)
+
+	playoffs := "playoffs"
+	_, err = svc.UpdateGame(ctx, g.ID, model.GamePatch{Phase: &playoffs})
+	require.True(t, serviceErrIsInvalid(err))
+	require.Equal(t, "date", service.FieldErrors(err)[0].Field, "the stored date is outside the playoffs")
+
+	moved := time.Date(2026, 4, 20, 19, 0, 0, 0, time.UTC)
+	updated, err := svc.UpdateGame(ctx, g.ID, model.GamePatch{Date: &moved, Phase: &playoffs})
+	require.NoError(t, err)
+	require.Equal(t, moved, updated.Date)
+	require.Equal(t, "playoffs", updated.Phase)
+	require.Equal(t, "scheduled", updated.Status)
+
+	// Career milestones of everyone with a line in the game are recomputed without it, and again on restore.
+	_, _ = lines.UpsertStatLine(ctx, model.PlayerStatLine{PlayerID: 7, GameID: g.ID, Points: 30})
+	_, _ = lines.UpsertStatLine(ctx, model.PlayerStatLine{PlayerID: 8, GameID: g.ID, Points: 12})
+	require.NoError(t, svc.DeleteGame(ctx, g.ID))
+	require.ElementsMatch(t, []int64{7, 8}, achievements.synced)
+	_, err = svc.GetGame(ctx, g.ID)
+	require.ErrorIs(t, err, repository.ErrNotFound)
+	require.ErrorIs(t, svc.DeleteGame(ctx, g.ID), repository.ErrNotFound)
+	_, err = svc.UpdateGame(ctx, g.ID, model.GamePatch{Phase: &playoffs})
+	require.ErrorIs(t, err, repository.ErrNotFound)
+
+	achievements.synced = nil
+	restored, err := svc.RestoreGame(ctx, g.ID)
+	require.NoError(t, err)
+	require.Equal(t, "playoffs", restored.Phase)
+	require.ElementsMatch(t, []int64{7, 8}, achievements.synced)
+	_, err = svc.RestoreGame(ctx, g.ID)
+	require.ErrorIs(t, err, repository.ErrNotFound)
+}
+
+func TestGameService_TransitionGame(t *testing.T) {
+	teamRepo := &fakeExistTeamRepo{exist: map[int64]bool{1: true, 2: true}}
+	gameRepo := newFakeGameRepo()
+	svc := service.NewGameService(gameRepo, teamRepo, newFakeSeasonRepo(season2025()), newFakeRuleProfileRepo(), newFakeVenueRepo(), newFakeLineStore(), newFakeAchievementRepo(), &fakeTx{}, zerolog.New(io.Discard))
+	ctx := context.Background()
+	g, err := svc.CreateGame(ctx, model.Game{Season: "2025-26", Date: gameDay, HomeTeamID: 1, AwayTeamID: 2, Status: "scheduled"})
+	require.NoError(t, err)
+
+	_, err = svc.TransitionGame(ctx, g.ID, "abandoned")
+	require.Equal(t, "status", service.FieldErrors(err)[0].Field)
+	_, err = svc.TransitionGame(ctx, g.ID, "finished")
+	require.True(t, serviceErrIsInvalid(err), "a scheduled game cannot skip to finished")
+	_, err = svc.TransitionGame(ctx, 99, "in_progress")
+	require.ErrorIs(t, err, repository.ErrNotFound)
+
+	tr, err := svc.TransitionGame(ctx, g.ID, " In_Progress ")
+	require.NoError(t, err)
+	require.Equal(t, "scheduled", tr.FromStatus)
+	require.Equal(t, "in_progress", tr.ToStatus)
+	_, err = svc.TransitionGame(ctx, g.ID, "postponed")
+	require.True(t, serviceErrIsInvalid(err), "only scheduled games can be postponed")
+
+	// A tied official score cannot be finished.
+	_, err = svc.UpdateScore(ctx, g.ID, model.GameScore{HomeScore: 90, AwayScore: 90})
+	require.NoError(t, err)
+	_, err = svc.TransitionGame(ctx, g.ID, "finished")
+	require.True(t, serviceErrIsInvalid(err))
+	_, err = svc.UpdateScore(ctx, g.ID, model.GameScore{HomeScore: 95, AwayScore: 90})
+	require.NoError(t, err)
+	_, err = svc.TransitionGame(ctx, g.ID, "finished")
+	require.NoError(t, err)
+	_, err = svc.TransitionGame(ctx, g.ID, "in_progress")
+	require.True(t, serviceErrIsInvalid(err), "finished is final")
+
+	history, err := svc.ListGameTransitions(ctx, g.ID)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	require.Equal(t, "finished", history[1].ToStatus)
+
+	other, err := svc.CreateGame(ctx, model.Game{Season: "2025-26", Date: gameDay, HomeTeamID: 2, AwayTeamID: 1, Status: "scheduled"})
+	require.NoError(t, err)
+	_, err = svc.TransitionGame(ctx, other.ID, "cancelled")
+	require.NoError(t, err)
+	_, err = svc.UpdateScore(ctx, other.ID, model.GameScore{HomeScore: 1, AwayScore: 0})
+	require.True(t, serviceErrIsInvalid(err), "a cancelled game takes no score")
+
+	// A game imported as finished walks the lifecycle, so its history is the same as a game played live.
+	imported, err := svc.CreateGame(ctx, model.Game{Season: "2025-26", Date: gameDay, HomeTeamID: 1, AwayTeamID: 2, Status: "finished"})
+	require.NoError(t, err)
+	require.Equal(t, "finished", imported.Status)
+	history, err = svc.ListGameTransitions(ctx, imported.ID)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	require.Equal(t, []string{"scheduled", "in_progress"}, []string{history[0].FromStatus, history[1].FromStatus})
+	require.Equal(t, "finished", history[1].ToStatus)
+}
@@ -0,0 +1,77 @@
+package service_test
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/maxviazov/basketball-stats-service/internal/model"
+	"github.com/maxviazov/basketball-stats-service/internal/repository"
+	"github.com/maxviazov/basketball-stats-service/internal/service"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeLeadersRepo struct {
+	lastQuery model.LeaderQuery
+	entries   []model.LeaderEntry
+}
+
+func (f *fakeLeadersRepo) ListLeaders(_ context.Context, q model.LeaderQuery) ([]model.LeaderEntry, error) {
+	f.lastQuery = q
+	return f.entries, nil
+}
+
+var _ repository.LeadersRepository = (*fakeLeadersRepo)(nil)
+
+func TestLeadersService_GetLeaders(t *testing.T) {
+	ctx := context.Background()
+	repo := &fakeLeadersRepo{}
+	svc := service.NewLeadersService(repo, 15, zerolog.New(io.Discard))
+
+	t.Run("validation", func(t *testing.T) {
+		badSeason, badPhase := "2024", "finals"
+		cases := []struct {
+			name          string
+			stat, per     string
+			season, phase *string
+			limit         int
+			field         string
+		}{
+			{"missing stat", "", "", nil, nil, 0, "stat"},
+			{"unknown stat", "dunks", "", nil, nil, 0, "stat"},
+			{"sql in stat", "points; DROP TABLE players", "", nil, nil, 0, "stat"},
+			{"bad per", "points", "minute", nil, nil, 0, "per"},
+			{"bad season", "points", "", &badSeason, nil, 0, "season"},
+			{"bad phase", "points", "", nil, &badPhase, 0, "phase"},
+			{"limit too high", "points", "", nil, nil, 101, "limit"},
+		}
+		for _, tc := range cases {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := svc.GetLeaders(ctx, tc.stat, tc.per, tc.season, tc.phase, tc.limit)
+				require.True(t, serviceErrIsInvalid(err), "expected invalid input, got %v", err)
+				require.Equal(t, tc.field, service.FieldErrors(err)[0].Field)
+			})
+		}
+	})
+
+	t.Run("defaults_and_threshold", func(t *testing.T) {
+		repo.entries = []model.LeaderEntry{{Rank: 1, PlayerID: 7, Value: 31.2}, {Rank: 1, PlayerID: 9, Value: 31.2}}
+		board, err := svc.GetLeaders(ctx, " Points ", "", nil, nil, 0)
+		require.NoError(t, err)
+		require.Equal(t, "points", board.Stat)
+		require.Equal(t, "game", board.Per)
+		require.Equal(t, 15, board.MinGames)
+		require.Len(t, board.Leaders, 2)
+		require.Equal(t, model.LeaderQuery{Stat: "points", PerGame: true, MinGames: 15, Limit: 10}, repo.lastQuery)
+	})
+
+	t.Run("totals", func(t *testing.T) {
+		season, phase := "2024-25", "Playoffs"
+		_, err := svc.GetLeaders(ctx, "assists", "total", &season, &phase, 5)
+		require.NoError(t, err)
+		require.False(t, repo.lastQuery.PerGame)
+		require.Equal(t, 5, repo.lastQuery.Limit)
+		require.Equal(t, "playoffs", *repo.lastQuery.Phase)
+	})
+}
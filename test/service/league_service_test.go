@@ -0,0 +1,70 @@
+package service_test
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/maxviazov/basketball-stats-service/internal/model"
+	"github.com/maxviazov/basketball-stats-service/internal/repository"
+	"github.com/maxviazov/basketball-stats-service/internal/service"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeLeagueRepo struct {
+	items []model.League
+}
+
+func (f *fakeLeagueRepo) Create(_ context.Context, l model.League) (model.League, error) {
+	for _, it := range f.items {
+		if it.Name == l.Name {
+			return model.League{}, repository.ErrAlreadyExists
+		}
+	}
+	l.ID = int64(len(f.items) + 1)
+	f.items = append(f.items, l)
+	return l, nil
+}
+func (f *fakeLeagueRepo) GetByID(_ context.Context, id int64) (model.League, error) {
+	if id < 1 || id > int64(len(f.items)) {
+		return model.League{}, repository.ErrNotFound
+	}
+	return f.items[id-1], nil
+}
+func (f *fakeLeagueRepo) List(context.Context, repository.Page) (repository.PageResult[model.League], error) {
+	return repository.PageResult[model.League]{Items: f.items, Total: len(f.items)}, nil
+}
+
+var _ repository.LeagueRepository = (*fakeLeagueRepo)(nil)
+
+func TestLeagueService(t *testing.T) {
+	repo := &fakeLeagueRepo{items: []model.League{{ID: 1, Name: "default"}}}
+	svc := service.NewLeagueService(repo, zerolog.New(io.Discard))
+	ctx := context.Background()
+
+	_, err := svc.CreateLeague(ctx, "  ")
+	require.True(t, serviceErrIsInvalid(err))
+	require.Equal(t, "name", service.FieldErrors(err)[0].Field)
+
+	l, err := svc.CreateLeague(ctx, "  EuroLeague ")
+	require.NoError(t, err)
+	require.Equal(t, "EuroLeague", l.Name)
+	_, err = svc.CreateLeague(ctx, "EuroLeague")
+	require.ErrorIs(t, err, repository.ErrAlreadyExists)
+
+	_, err = svc.GetLeague(ctx, 0)
+	require.True(t, serviceErrIsInvalid(err))
+	_, err = svc.GetLeague(ctx, 9)
+	require.ErrorIs(t, err, repository.ErrNotFound)
+
+	list, err := svc.ListLeagues(ctx, repository.Page{})
+	require.NoError(t, err)
+	require.Equal(t, 2, list.Total)
+}
+
+func TestWithLeague(t *testing.T) {
+	ctx := context.Background()
+	require.Equal(t, repository.DefaultLeagueID, repository.LeagueID(ctx))
+	require.Equal(t, int64(3), repository.LeagueID(repository.WithLeague(ctx, 3)))
+}
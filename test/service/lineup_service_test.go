@@ -0,0 +1,174 @@
+package service_test
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/maxviazov/basketball-stats-service/internal/model"
+	"github.com/maxviazov/basketball-stats-service/internal/repository"
+	"github.com/maxviazov/basketball-stats-service/internal/service"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeLineupRepo struct {
+	nextID   int64
+	starters []model.GameStarter
+	stints   []model.LineupStint
+	lineups  []model.LineupStats
+}
+
+func (f *fakeLineupRepo) ReplaceStarters(_ context.Context, gameID, teamID int64, playerIDs []int64) ([]model.GameStarter, error) {
+	kept := f.starters[:0]
+	for _, s := range f.starters {
+		if s.GameID != gameID || s.TeamID != teamID {
+			kept = append(kept, s)
+		}
+	}
+	var out []model.GameStarter
+	for _, id := range playerIDs {
+		out = append(out, model.GameStarter{GameID: gameID, TeamID: teamID, PlayerID: id})
+	}
+	f.starters = append(kept, out...)
+	return out, nil
+}
+func (f *fakeLineupRepo) ListStarters(context.Context, int64) ([]model.GameStarter, error) {
+	return f.starters, nil
+}
+func (f *fakeLineupRepo) CreateStint(_ context.Context, st model.LineupStint) (model.LineupStint, error) {
+	f.nextID++
+	st.ID = f.nextID
+	f.stints = append(f.stints, st)
+	return st, nil
+}
+func (f *fakeLineupRepo) ListStints(_ context.Context, gameID int64) ([]model.LineupStint, error) {
+	var out []model.LineupStint
+	for _, st := range f.stints {
+		if st.GameID == gameID {
+			out = append(out, st)
+		}
+	}
+	return out, nil
+}
+func (f *fakeLineupRepo) DeleteStint(_ context.Context, gameID, stintID int64) error {
+	for i, st := range f.stints {
+		if st.GameID == gameID && st.ID == stintID {
+			f.stints = append(f.stints[:i], f.stints[i+1:]...)
+			return nil
+		}
+	}
+	return repository.ErrNotFound
+}
+func (f *fakeLineupRepo) LockGame(context.Context, int64) error { return nil }
+func (f *fakeLineupRepo) ListTeamLineups(context.Context, int64, string) ([]model.LineupStats, error) {
+	return f.lineups, nil
+}
+
+var _ repository.LineupRepository = (*fakeLineupRepo)(nil)
+
+// Players 1-7 play for the home team 1 and players 8-12 for the away team 2.
+func newLineupService(lineups *fakeLineupRepo) service.LineupService {
+	players := &fakePlayerLookup{ok: map[int64]bool{}, team: map[int64]int64{}}
+	for id := int64(1); id <= 12; id++ {
+		players.ok[id] = true
+		if id >= 8 {
+			players.team[id] = 2
+		}
+	}
+	games := &fakeGameLookup{ok: map[int64]bool{3: true, 4: true}, status: map[int64]string{4: "scheduled"}}
+	return service.NewLineupService(lineups, newFakeLookupTeamRepo(1), players, games, newFakeRuleProfileRepo(), &fakeTxStats{}, zerolog.New(io.Discard))
+}
+
+func TestLineupService_SetStarters(t *testing.T) {
+	ctx := context.Background()
+	svc := newLineupService(&fakeLineupRepo{})
+
+	_, err := svc.SetStarters(ctx, 4, 1, []int64{1, 2, 3, 4})
+	require.Equal(t, []string{"player_ids"}, fieldNames(err))
+	_, err = svc.SetStarters(ctx, 4, 1, []int64{1, 2, 3, 4, 4})
+	require.Equal(t, []string{"player_ids"}, fieldNames(err))
+	_, err = svc.SetStarters(ctx, 4, 5, []int64{1, 2, 3, 4, 5})
+	require.Equal(t, []string{"team_id"}, fieldNames(err))
+	_, err = svc.SetStarters(ctx, 4, 1, []int64{1, 2, 3, 4, 8})
+	require.Equal(t, []string{"player_ids"}, fieldNames(err), "player 8 plays for the away team")
+	_, err = svc.SetStarters(ctx, 99, 1, []int64{1, 2, 3, 4, 5})
+	require.ErrorIs(t, err, repository.ErrNotFound)
+
+	starters, err := svc.SetStarters(ctx, 4, 1, []int64{5, 3, 1, 2, 4})
+	require.NoError(t, err, "starters can be named before tip-off")
+	require.Len(t, starters, 5)
+	require.Equal(t, int64(1), starters[0].PlayerID)
+
+	_, err = svc.SetStarters(ctx, 4, 1, []int64{1, 2, 3, 4, 6})
+	require.NoError(t, err)
+	all, err := svc.ListStarters(ctx, 4)
+	require.NoError(t, err)
+	require.Len(t, all, 5, "setting starters again replaces them")
+}
+
+func TestLineupService_RecordStint(t *testing.T) {
+	ctx := context.Background()
+	svc := newLineupService(&fakeLineupRepo{})
+	home := []int64{1, 2, 3, 4, 5}
+	stint := func(gameID, teamID int64, period, start, end int, ids []int64) model.LineupStint {
+		return model.LineupStint{GameID: gameID, TeamID: teamID, Period: period, StartClock: start, EndClock: end, PlayerIDs: ids}
+	}
+
+	cases := []struct {
+		name  string
+		st    model.LineupStint
+		field string
+	}{
+		{"six players", stint(3, 1, 1, 720, 400, []int64{1, 2, 3, 4, 5, 6}), "player_ids"},
+		{"clock runs backwards", stint(3, 1, 1, 300, 400, home), "start_clock"},
+		{"negative end", stint(3, 1, 1, 300, -1, home), "end_clock"},
+		{"game not started", stint(4, 1, 1, 720, 400, home), "game_id"},
+		{"longer than the period", stint(3, 1, 1, 721, 400, home), "start_clock"},
+		{"mixed teams", stint(3, 1, 1, 720, 400, []int64{1, 2, 3, 4, 8}), "player_ids"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := svc.RecordStint(ctx, tc.st)
+			require.Equal(t, []string{tc.field}, fieldNames(err))
+		})
+	}
+
+	first, err := svc.RecordStint(ctx, stint(3, 1, 1, 720, 400, []int64{5, 4, 3, 2, 1}))
+	require.NoError(t, err)
+	require.Equal(t, home, first.PlayerIDs, "player ids are stored sorted")
+
+	_, err = svc.RecordStint(ctx, stint(3, 1, 1, 500, 300, []int64{1, 2, 3, 4, 6}))
+	require.Equal(t, []string{"start_clock"}, fieldNames(err), "one unit per team on court")
+	_, err = svc.RecordStint(ctx, stint(3, 1, 1, 400, 0, []int64{1, 2, 3, 4, 6}))
+	require.NoError(t, err, "a stint may start when the last one ended")
+	_, err = svc.RecordStint(ctx, stint(3, 2, 1, 720, 0, []int64{8, 9, 10, 11, 12}))
+	require.NoError(t, err)
+	_, err = svc.RecordStint(ctx, stint(3, 1, 5, 300, 0, home))
+	require.NoError(t, err, "overtime periods take the overtime length")
+
+	require.NoError(t, svc.DeleteStint(ctx, 3, first.ID))
+	require.ErrorIs(t, svc.DeleteStint(ctx, 3, first.ID), repository.ErrNotFound)
+}
+
+func TestLineupService_ListTeamLineups(t *testing.T) {
+	ctx := context.Background()
+	lineups := &fakeLineupRepo{lineups: []model.LineupStats{{
+		PlayerIDs: []int64{1, 2, 3, 4, 5}, Games: 2, Minutes: 24.25,
+		PointsFor: 55, PointsAgainst: 48, OffensivePossessions: 50, DefensivePossessions: 48,
+	}}}
+	svc := newLineupService(lineups)
+
+	_, err := svc.ListTeamLineups(ctx, 1, "")
+	require.Equal(t, []string{"season"}, fieldNames(err))
+	_, err = svc.ListTeamLineups(ctx, 9, "2025-26")
+	require.ErrorIs(t, err, repository.ErrNotFound)
+
+	out, err := svc.ListTeamLineups(ctx, 1, "2025-26")
+	require.NoError(t, err)
+	require.Len(t, out, 1)
+	require.Equal(t, 110.0, out[0].OffensiveRating)
+	require.Equal(t, 100.0, out[0].DefensiveRating)
+	require.Equal(t, 10.0, out[0].NetRating)
+	require.Equal(t, 24.3, out[0].Minutes)
+}
@@ -0,0 +1,169 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/maxviazov/basketball-stats-service/internal/model"
+	"github.com/maxviazov/basketball-stats-service/internal/repository"
+	"github.com/maxviazov/basketball-stats-service/internal/service"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeMetricsRepo struct {
+	totals []model.PlayerSeasonTotals
+	lines  map[int64][]model.PlayerGameLine
+}
+
+func (f *fakeMetricsRepo) ListSeasonTotals(context.Context, string) ([]model.PlayerSeasonTotals, error) {
+	return f.totals, nil
+}
+func (f *fakeMetricsRepo) ListPlayerSeasonLines(_ context.Context, playerID int64, _ string) ([]model.PlayerGameLine, error) {
+	return f.lines[playerID], nil
+}
+
+var _ repository.MetricsRepository = (*fakeMetricsRepo)(nil)
+
+// sampleTotals is a plausible season of one player; points = 2*FGM + 3PM + FTM.
+func sampleTotals(games int) model.StatTotals {
+	return model.StatTotals{
+		Games: games, Minutes: float64(30 * games),
+		Points: 20 * games, FieldGoalsMade: 7 * games, FieldGoalsAttempted: 15 * games,
+		ThreePointersMade: 2 * games, ThreePointersAttempted: 5 * games,
+		FreeThrowsMade: 4 * games, FreeThrowsAttempted: 5 * games,
+		OffensiveRebounds: 1 * games, DefensiveRebounds: 5 * games, Rebounds: 6 * games,
+		Assists: 4 * games, Steals: 1 * games, Blocks: 1 * games, Turnovers: 2 * games, Fouls: 3 * games,
+	}
+}
+
+// possessionsPerGame is FGA + 0.44*FTA - ORB + TOV of one game of sampleTotals.
+const possessionsPerGame = 15 + 0.44*5 - 1 + 2
+
+func newMetricsFixture(repo *fakeMetricsRepo, playerIDs ...int64) service.MetricsService {
+	players := newFakePlayerRepo()
+	for _, id := range playerIDs {
+		players.players[id] = model.Player{ID: id}
+	}
+	return service.NewMetricsService(repo, players, zerolog.New(io.Discard))
+}
+
+func TestMetricsService_Validation(t *testing.T) {
+	svc := newMetricsFixture(&fakeMetricsRepo{}, 1)
+	ctx := context.Background()
+
+	_, err := svc.GetPlayerAdvancedStats(ctx, 1, "")
+	require.True(t, serviceErrIsInvalid(err), "season is required")
+	require.Equal(t, "season", service.FieldErrors(err)[0].Field)
+
+	_, err = svc.GetPlayerAdvancedStats(ctx, 1, "2024")
+	require.True(t, serviceErrIsInvalid(err))
+
+	_, err = svc.GetPlayerAdvancedStats(ctx, 0, "2024-25")
+	require.True(t, serviceErrIsInvalid(err))
+
+	_, err = svc.GetPlayerAdvancedStats(ctx, 99, "2024-25")
+	require.True(t, errors.Is(err, repository.ErrNotFound))
+}
+
+func TestMetricsService_GameScoreAndRates(t *testing.T) {
+	line := model.PlayerStatLine{
+		PlayerID: 1, GameID: 10, Points: 30, FieldGoalsMade: 11, FieldGoalsAttempted: 20,
+		ThreePointersMade: 2, ThreePointersAttempted: 6, FreeThrowsMade: 6, FreeThrowsAttempted: 8,
+		OffensiveRebounds: 2, DefensiveRebounds: 6, Rebounds: 8, Assists: 5, Steals: 2, Blocks: 1,
+		Fouls: 3, Turnovers: 4, MinutesPlayed: 36,
+	}
+	tot := model.StatTotals{
+		Games: 1, Minutes: 36, Points: 30, FieldGoalsMade: 11, FieldGoalsAttempted: 20,
+		ThreePointersMade: 2, ThreePointersAttempted: 6, FreeThrowsMade: 6, FreeThrowsAttempted: 8,
+		OffensiveRebounds: 2, DefensiveRebounds: 6, Rebounds: 8, Assists: 5, Steals: 2, Blocks: 1,
+		Fouls: 3, Turnovers: 4,
+	}
+	repo := &fakeMetricsRepo{
+		// A one-player team: the player used every team play while on court, i.e. 20% of possessions with five on the floor.
+		totals: []model.PlayerSeasonTotals{{PlayerID: 1, TeamID: 1, Player: tot, Team: tot, TeamGameMinutes: 48}},
+		lines:  map[int64][]model.PlayerGameLine{1: {{PlayerStatLine: line}}},
+	}
+	svc := newMetricsFixture(repo, 1)
+
+	got, err := svc.GetPlayerAdvancedStats(context.Background(), 1, "2024-25")
+	require.NoError(t, err)
+	require.Len(t, got.GameScores, 1)
+	require.InDelta(t, 23.8, got.GameScores[0].GameScore, 1e-9)
+	require.InDelta(t, 23.8, got.AvgGameScore, 1e-9)
+	require.InDelta(t, 1.25, got.AssistToTurnover, 1e-9)
+	require.InDelta(t, 20.0, got.UsageRate, 1e-9)
+	require.InDelta(t, 30.0, got.Per36.Points, 1e-9)
+	require.InDelta(t, 8.0, got.Per36.Rebounds, 1e-9)
+	require.Equal(t, 1, got.GamesPlayed)
+}
+
+func TestMetricsService_PERIsScaledToLeagueAverage(t *testing.T) {
+	// Identical players on identical teams are, by definition, exactly league average.
+	repo := &fakeMetricsRepo{totals: []model.PlayerSeasonTotals{
+		{PlayerID: 1, TeamID: 1, Player: sampleTotals(10), Team: sampleTotals(10), TeamGameMinutes: 480},
+		{PlayerID: 2, TeamID: 2, Player: sampleTotals(10), Team: sampleTotals(10), TeamGameMinutes: 480},
+	}}
+	svc := newMetricsFixture(repo, 1, 2, 3)
+
+	got, err := svc.GetPlayerAdvancedStats(context.Background(), 1, "2024-25")
+	require.NoError(t, err)
+	require.InDelta(t, 15.0, got.PER, 1e-9)
+	require.Greater(t, got.League.Pace, 0.0)
+	require.Greater(t, got.League.ValueOfPossession, 0.0)
+
+	// A better player on the same team rates above average.
+	better := sampleTotals(10)
+	better.Points += 20
+	better.FieldGoalsMade += 10
+	better.FieldGoalsAttempted += 10
+	team := sampleTotals(10)
+	team.Add(better)
+	team.Games = 10
+	repo.totals = []model.PlayerSeasonTotals{
+		{PlayerID: 1, TeamID: 1, Player: sampleTotals(10), Team: team, TeamGameMinutes: 480},
+		{PlayerID: 3, TeamID: 1, Player: better, Team: team, TeamGameMinutes: 480},
+	}
+	avg, err := svc.GetPlayerAdvancedStats(context.Background(), 1, "2024-25")
+	require.NoError(t, err)
+	star, err := svc.GetPlayerAdvancedStats(context.Background(), 3, "2024-25")
+	require.NoError(t, err)
+	require.Greater(t, star.PER, 15.0)
+	require.Less(t, avg.PER, 15.0)
+}
+
+func TestMetricsService_PaceFollowsRuleProfile(t *testing.T) {
+	// The same ten games with five players on 30 minutes each: 150 team minutes, i.e. 30-minute games.
+	team := sampleTotals(10)
+	team.Minutes = 1500
+	repo := &fakeMetricsRepo{totals: []model.PlayerSeasonTotals{
+		{PlayerID: 1, TeamID: 1, Player: sampleTotals(10), Team: team, TeamGameMinutes: 480},
+	}}
+	svc := newMetricsFixture(repo, 1)
+
+	nba, err := svc.GetPlayerAdvancedStats(context.Background(), 1, "2024-25")
+	require.NoError(t, err)
+	require.InDelta(t, 48*possessionsPerGame/30, nba.League.Pace, 0.01)
+
+	repo.totals[0].TeamGameMinutes = 400 // FIBA: four 10-minute quarters
+	fiba, err := svc.GetPlayerAdvancedStats(context.Background(), 1, "2024-25")
+	require.NoError(t, err)
+	require.InDelta(t, 40*possessionsPerGame/30, fiba.League.Pace, 0.01)
+	require.InDelta(t, nba.PER, fiba.PER, 1e-9)
+}
+
+func TestMetricsService_PlayerWithoutLines(t *testing.T) {
+	repo := &fakeMetricsRepo{totals: []model.PlayerSeasonTotals{
+		{PlayerID: 1, TeamID: 1, Player: sampleTotals(5), Team: sampleTotals(5), TeamGameMinutes: 240},
+	}}
+	svc := newMetricsFixture(repo, 1, 2)
+
+	got, err := svc.GetPlayerAdvancedStats(context.Background(), 2, "2024-25")
+	require.NoError(t, err)
+	require.Zero(t, got.GamesPlayed)
+	require.Zero(t, got.PER)
+	require.NotNil(t, got.GameScores)
+	require.Empty(t, got.GameScores)
+}
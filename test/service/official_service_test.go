@@ -0,0 +1,181 @@
+package service_test
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/maxviazov/basketball-stats-service/internal/model"
+	"github.com/maxviazov/basketball-stats-service/internal/repository"
+	"github.com/maxviazov/basketball-stats-service/internal/service"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeOfficialRepo struct {
+	nextID    int64
+	officials map[int64]model.Official
+	games     *fakeGameRepo // assignments take their day from the game, as the real table does
+	crews     map[int64][]model.GameOfficial
+}
+
+func newFakeOfficialRepo(games *fakeGameRepo) *fakeOfficialRepo {
+	return &fakeOfficialRepo{nextID: 1, officials: map[int64]model.Official{}, games: games, crews: map[int64][]model.GameOfficial{}}
+}
+func (f *fakeOfficialRepo) Create(_ context.Context, o model.Official) (model.Official, error) {
+	o.ID = f.nextID
+	f.nextID++
+	f.officials[o.ID] = o
+	return o, nil
+}
+func (f *fakeOfficialRepo) GetByID(_ context.Context, id int64) (model.Official, error) {
+	o, ok := f.officials[id]
+	if !ok {
+		return model.Official{}, repository.ErrNotFound
+	}
+	return o, nil
+}
+func (f *fakeOfficialRepo) List(context.Context, repository.Page) (repository.PageResult[model.Official], error) {
+	var res repository.PageResult[model.Official]
+	for _, o := range f.officials {
+		res.Items = append(res.Items, o)
+	}
+	res.Total = len(res.Items)
+	return res, nil
+}
+func (f *fakeOfficialRepo) Assign(_ context.Context, gameID, officialID int64, role string) (model.GameOfficial, error) {
+	crew := f.crews[gameID]
+	for i, c := range crew {
+		if c.OfficialID == officialID {
+			crew[i].Role = role
+			return crew[i], nil
+		}
+	}
+	o := f.officials[officialID]
+	out := model.GameOfficial{GameID: gameID, OfficialID: officialID, Role: role, FirstName: o.FirstName, LastName: o.LastName}
+	f.crews[gameID] = append(crew, out)
+	return out, nil
+}
+func (f *fakeOfficialRepo) Unassign(_ context.Context, gameID, officialID int64) error {
+	crew := f.crews[gameID]
+	for i, c := range crew {
+		if c.OfficialID == officialID {
+			f.crews[gameID] = append(crew[:i], crew[i+1:]...)
+			return nil
+		}
+	}
+	return repository.ErrNotFound
+}
+func (f *fakeOfficialRepo) ListByGame(_ context.Context, gameID int64) ([]model.GameOfficial, error) {
+	return f.crews[gameID], nil
+}
+func (f *fakeOfficialRepo) GamesOn(_ context.Context, officialID int64, day time.Time) ([]int64, error) {
+	var ids []int64
+	for gameID, crew := range f.crews {
+		g, ok := f.games.games[gameID]
+		if !ok || g.Status == "postponed" || g.Status == "cancelled" || !g.Date.UTC().Truncate(24*time.Hour).Equal(day) {
+			continue
+		}
+		for _, c := range crew {
+			if c.OfficialID == officialID {
+				ids = append(ids, gameID)
+			}
+		}
+	}
+	return ids, nil
+}
+func (f *fakeOfficialRepo) ListGames(context.Context, int64, repository.Page) (repository.PageResult[model.OfficialGame], error) {
+	return repository.PageResult[model.OfficialGame]{}, nil
+}
+func (f *fakeOfficialRepo) GetSummary(_ context.Context, officialID int64, season *string) (model.OfficialSummary, error) {
+	return model.OfficialSummary{OfficialID: officialID, Season: season}, nil
+}
+
+var _ repository.OfficialRepository = (*fakeOfficialRepo)(nil)
+
+func TestOfficialService_AssignOfficial(t *testing.T) {
+	ctx := context.Background()
+	games := newFakeGameRepo()
+	early, _ := games.Create(ctx, model.Game{Season: "2025-26", Date: gameDay, HomeTeamID: 1, AwayTeamID: 2, Status: "scheduled"})
+	late, _ := games.Create(ctx, model.Game{Season: "2025-26", Date: gameDay.Add(2 * time.Hour), HomeTeamID: 3, AwayTeamID: 4})
+	nextDay, _ := games.Create(ctx, model.Game{Season: "2025-26", Date: gameDay.Add(24 * time.Hour), HomeTeamID: 1, AwayTeamID: 3})
+	officials := newFakeOfficialRepo(games)
+	svc := service.NewOfficialService(officials, games, &fakeTx{}, zerolog.New(io.Discard))
+
+	_, err := svc.CreateOfficial(ctx, " ", "")
+	require.ElementsMatch(t, []string{"first_name", "last_name"}, fieldNames(err))
+	scott, err := svc.CreateOfficial(ctx, " Scott ", "Foster")
+	require.NoError(t, err)
+	require.Equal(t, "Scott", scott.FirstName)
+	tony, _ := svc.CreateOfficial(ctx, "Tony", "Brothers")
+
+	t.Run("invalid_role", func(t *testing.T) {
+		_, err := svc.AssignOfficial(ctx, early.ID, scott.ID, "linesman")
+		require.Equal(t, []string{"role"}, fieldNames(err))
+	})
+
+	t.Run("role_is_normalized", func(t *testing.T) {
+		a, err := svc.AssignOfficial(ctx, early.ID, scott.ID, "Crew Chief")
+		require.NoError(t, err)
+		require.Equal(t, "crew_chief", a.Role)
+		require.Equal(t, "Foster", a.LastName)
+	})
+
+	t.Run("one_crew_chief_per_game", func(t *testing.T) {
+		_, err := svc.AssignOfficial(ctx, early.ID, tony.ID, "crew-chief")
+		require.Equal(t, []string{"role"}, fieldNames(err))
+		_, err = svc.AssignOfficial(ctx, early.ID, tony.ID, "referee")
+		require.NoError(t, err)
+	})
+
+	t.Run("reassigning_the_same_game_changes_the_role", func(t *testing.T) {
+		a, err := svc.AssignOfficial(ctx, early.ID, scott.ID, "umpire")
+		require.NoError(t, err)
+		require.Equal(t, "umpire", a.Role)
+		crew, err := svc.ListGameOfficials(ctx, early.ID)
+		require.NoError(t, err)
+		require.Len(t, crew, 2)
+	})
+
+	t.Run("no_double_booking_on_the_same_day", func(t *testing.T) {
+		_, err := svc.AssignOfficial(ctx, late.ID, scott.ID, "referee")
+		require.Equal(t, []string{"official_id"}, fieldNames(err))
+		_, err = svc.AssignOfficial(ctx, nextDay.ID, scott.ID, "referee")
+		require.NoError(t, err)
+	})
+
+	t.Run("unknown_game_and_official", func(t *testing.T) {
+		_, err := svc.AssignOfficial(ctx, 99, scott.ID, "referee")
+		require.ErrorIs(t, err, repository.ErrNotFound)
+		_, err = svc.AssignOfficial(ctx, late.ID, 99, "referee")
+		require.Equal(t, []string{"official_id"}, fieldNames(err))
+	})
+
+	t.Run("unassign_frees_the_day", func(t *testing.T) {
+		require.NoError(t, svc.UnassignOfficial(ctx, early.ID, scott.ID))
+		require.ErrorIs(t, svc.UnassignOfficial(ctx, early.ID, scott.ID), repository.ErrNotFound)
+		_, err := svc.AssignOfficial(ctx, late.ID, scott.ID, "referee")
+		require.NoError(t, err)
+	})
+
+	t.Run("postponed_and_deleted_games_free_the_day", func(t *testing.T) {
+		evening, _ := games.Create(ctx, model.Game{Season: "2025-26", Date: gameDay.Add(4 * time.Hour), HomeTeamID: 5, AwayTeamID: 6})
+		_, err := svc.AssignOfficial(ctx, evening.ID, tony.ID, "referee")
+		require.Equal(t, []string{"official_id"}, fieldNames(err))
+		_, err = games.Transition(ctx, early.ID, "scheduled", "postponed")
+		require.NoError(t, err)
+		_, err = svc.AssignOfficial(ctx, evening.ID, tony.ID, "referee")
+		require.NoError(t, err)
+
+		_, err = svc.AssignOfficial(ctx, evening.ID, scott.ID, "umpire")
+		require.Equal(t, []string{"official_id"}, fieldNames(err))
+		require.NoError(t, games.Delete(ctx, late.ID))
+		_, err = svc.AssignOfficial(ctx, evening.ID, scott.ID, "umpire")
+		require.NoError(t, err)
+	})
+
+	season := "2025"
+	_, err = svc.GetOfficialSummary(ctx, scott.ID, &season)
+	require.Equal(t, []string{"season"}, fieldNames(err))
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"io"
 	"testing"
+	"time"
 
 	"github.com/maxviazov/basketball-stats-service/internal/model"
 	"github.com/maxviazov/basketball-stats-service/internal/repository"
@@ -16,17 +17,21 @@ import (
 type fakePlayerRepo struct {
 	nextID      int64
 	players     map[int64]model.Player
+	memberships map[int64][]model.Membership
 	statsResult model.PlayerAggregatedStats
 	statsErr    error
+	lastDims    []string // dimensions passed to GetPlayerSplits
+	deleted     map[int64]model.Player
 }
 
 func newFakePlayerRepo() *fakePlayerRepo {
-	return &fakePlayerRepo{nextID: 1, players: map[int64]model.Player{}}
+	return &fakePlayerRepo{nextID: 1, players: map[int64]model.Player{}, memberships: map[int64][]model.Membership{}, deleted: map[int64]model.Player{}}
 }
 func (f *fakePlayerRepo) Create(_ context.Context, p model.Player) (model.Player, error) {
 	p.ID = f.nextID
 	f.nextID++
 	f.players[p.ID] = p
+	f.memberships[p.ID] = []model.Membership{{PlayerID: p.ID, TeamID: p.TeamID}}
 	return p, nil
 }
 func (f *fakePlayerRepo) GetByID(_ context.Context, id int64) (model.Player, error) {
@@ -36,7 +41,7 @@ func (f *fakePlayerRepo) GetByID(_ context.Context, id int64) (model.Player, err
 	}
 	return p, nil
 }
-func (f *fakePlayerRepo) ListByTeam(_ context.Context, teamID int64, _ repository.Page) (repository.PageResult[model.Player], error) {
+func (f *fakePlayerRepo) ListByTeam(_ context.Context, teamID int64, _ *time.Time, _ repository.Page) (repository.PageResult[model.Player], error) {
 	var res repository.PageResult[model.Player]
 	for _, p := range f.players {
 		if p.TeamID == teamID {
@@ -47,7 +52,7 @@ func (f *fakePlayerRepo) ListByTeam(_ context.Context, teamID int64, _ repositor
 	return res, nil
 }
 
-func (f *fakePlayerRepo) GetPlayerAggregatedStats(_ context.Context, playerID int64, _ *string) (model.PlayerAggregatedStats, error) {
+func (f *fakePlayerRepo) GetPlayerAggregatedStats(_ context.Context, playerID int64, _, _ *string) (model.PlayerAggregatedStats, error) {
 	if f.statsErr != nil {
 		return model.PlayerAggregatedStats{}, f.statsErr
 	}
@@ -56,11 +61,71 @@ func (f *fakePlayerRepo) GetPlayerAggregatedStats(_ context.Context, playerID in
 	}
 	return f.statsResult, nil
 }
+func (f *fakePlayerRepo) GetPlayerSplits(_ context.Context, _ int64, _, _ *string, dims []string) ([]model.PlayerSplit, error) {
+	f.lastDims = dims
+	out := make([]model.PlayerSplit, 0, len(dims))
+	for _, d := range dims {
+		out = append(out, model.PlayerSplit{Dimension: d, Key: "k", PlayerAggregatedStats: f.statsResult})
+	}
+	return out, f.statsErr
+}
 func (f *fakePlayerRepo) Exists(_ context.Context, id int64) (bool, error) {
 	_, ok := f.players[id]
 	return ok, nil
 }
 
+func (f *fakePlayerRepo) ListMemberships(_ context.Context, playerID int64) ([]model.Membership, error) {
+	return f.memberships[playerID], nil
+}
+func (f *fakePlayerRepo) TeamOn(_ context.Context, playerID int64, on time.Time) (int64, error) {
+	for _, m := range f.memberships[playerID] {
+		if (m.StartDate == nil || !m.StartDate.After(on)) && (m.EndDate == nil || on.Before(*m.EndDate)) {
+			return m.TeamID, nil
+		}
+	}
+	return 0, repository.ErrNotFound
+}
+func (f *fakePlayerRepo) Transfer(_ context.Context, playerID, teamID int64, on time.Time) (model.Membership, error) {
+	history := f.memberships[playerID]
+	for i := range history {
+		if history[i].EndDate == nil {
+			history[i].EndDate = &on
+		}
+	}
+	m := model.Membership{PlayerID: playerID, TeamID: teamID, StartDate: &on}
+	f.memberships[playerID] = append(history, m)
+	p := f.players[playerID]
+	p.TeamID = teamID
+	f.players[playerID] = p
+	return m, nil
+}
+
+func (f *fakePlayerRepo) Update(_ context.Context, p model.Player) (model.Player, error) {
+	if _, ok := f.players[p.ID]; !ok {
+		return model.Player{}, repository.ErrNotFound
+	}
+	f.players[p.ID] = p
+	return p, nil
+}
+func (f *fakePlayerRepo) Delete(_ context.Context, id int64) error {
+	p, ok := f.players[id]
+	if !ok {
+		return repository.ErrNotFound
+	}
+	delete(f.players, id)
+	f.deleted[id] = p
+	return nil
+}
+func (f *fakePlayerRepo) Restore(_ context.Context, id int64) (model.Player, error) {
+	p, ok := f.deleted[id]
+	if !ok {
+		return model.Player{}, repository.ErrNotFound
+	}
+	delete(f.deleted, id)
+	f.players[id] = p
+	return p, nil
+}
+
 var _ repository.PlayerRepository = (*fakePlayerRepo)(nil)
 
 type fakeLookupTeamRepo struct {
@@ -91,7 +156,7 @@ func TestPlayerService_CreatePlayer_Validation(t *testing.T) {
 	logger := zerolog.New(io.Discard)
 	teamRepo := newFakeLookupTeamRepo(10)
 	playerRepo := newFakePlayerRepo()
-	svc := service.NewPlayerService(playerRepo, teamRepo, logger)
+	svc := service.NewPlayerService(playerRepo, teamRepo, &fakeTx{}, logger)
 	cases := []struct {
 		name        string
 		teamID      int64
@@ -107,7 +172,7 @@ func TestPlayerService_CreatePlayer_Validation(t *testing.T) {
 	}
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
-			_, err := svc.CreatePlayer(context.Background(), tc.teamID, tc.fn, tc.ln, tc.pos)
+			_, err := svc.CreatePlayer(context.Background(), tc.teamID, tc.fn, tc.ln, tc.pos, model.PlayerBio{})
 			if tc.wantErr && err == nil {
 				t.Fatalf("expected error")
 			}
@@ -137,7 +202,7 @@ func TestPlayerService_GetPlayerAggregatedStats(t *testing.T) {
 	logger := zerolog.New(io.Discard)
 	playerRepo := newFakePlayerRepo()
 	teamRepo := newFakeLookupTeamRepo()
-	svc := service.NewPlayerService(playerRepo, teamRepo, logger)
+	svc := service.NewPlayerService(playerRepo, teamRepo, &fakeTx{}, logger)
 
 	// Seed a player for valid ID checks
 	_, err := playerRepo.Create(context.Background(), model.Player{ID: 1, TeamID: 1, FirstName: "Test"})
@@ -148,13 +213,13 @@ func TestPlayerService_GetPlayerAggregatedStats(t *testing.T) {
 		playerRepo.statsResult = expected
 		playerRepo.statsErr = nil
 
-		stats, err := svc.GetPlayerAggregatedStats(context.Background(), 1, nil)
+		stats, err := svc.GetPlayerAggregatedStats(context.Background(), 1, nil, nil)
 		require.NoError(t, err)
 		require.Equal(t, expected, stats)
 	})
 
 	t.Run("Invalid Player ID", func(t *testing.T) {
-		_, err := svc.GetPlayerAggregatedStats(context.Background(), 0, nil)
+		_, err := svc.GetPlayerAggregatedStats(context.Background(), 0, nil, nil)
 		require.Error(t, err)
 		require.True(t, serviceErrIsInvalid(err), "expected invalid input error")
 		fields := service.FieldErrors(err)
@@ -164,7 +229,7 @@ func TestPlayerService_GetPlayerAggregatedStats(t *testing.T) {
 
 	t.Run("Invalid Season Format", func(t *testing.T) {
 		invalidSeason := "2023/24"
-		_, err := svc.GetPlayerAggregatedStats(context.Background(), 1, &invalidSeason)
+		_, err := svc.GetPlayerAggregatedStats(context.Background(), 1, &invalidSeason, nil)
 		require.Error(t, err)
 		require.True(t, serviceErrIsInvalid(err), "expected invalid input error")
 		fields := service.FieldErrors(err)
@@ -174,9 +239,182 @@ func TestPlayerService_GetPlayerAggregatedStats(t *testing.T) {
 
 	t.Run("Repository Error", func(t *testing.T) {
 		playerRepo.statsErr = errors.New("db is down")
-		_, err := svc.GetPlayerAggregatedStats(context.Background(), 1, nil)
+		_, err := svc.GetPlayerAggregatedStats(context.Background(), 1, nil, nil)
 		require.Error(t, err)
 		require.False(t, serviceErrIsInvalid(err))
 		require.Equal(t, "db is down", err.Error())
 	})
 }
+
+func TestPlayerService_GetPlayerSplits(t *testing.T) {
+	logger := zerolog.New(io.Discard)
+	playerRepo := newFakePlayerRepo()
+	svc := service.NewPlayerService(playerRepo, newFakeLookupTeamRepo(), &fakeTx{}, logger)
+	ctx := context.Background()
+	_, err := playerRepo.Create(ctx, model.Player{TeamID: 1, FirstName: "Test"})
+	require.NoError(t, err)
+
+	playerRepo.statsResult = model.PlayerAggregatedStats{GamesPlayed: 3}
+	res, err := svc.GetPlayerSplits(ctx, 1, nil, nil, []string{"month", "result"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"result", "month"}, playerRepo.lastDims)
+	require.Len(t, res.Splits, 2)
+	require.Equal(t, 3, res.Splits[0].GamesPlayed)
+
+	phase := "finals"
+	_, err = svc.GetPlayerSplits(ctx, 0, nil, &phase, []string{"weekday"})
+	require.True(t, serviceErrIsInvalid(err))
+	require.Len(t, service.FieldErrors(err), 3) // id, phase and by
+
+	_, err = svc.GetPlayerSplits(ctx, 42, nil, nil, nil)
+	require.ErrorIs(t, err, repository.ErrNotFound)
+}
+
+func TestPlayerService_TransferPlayer(t *testing.T) {
+	ctx := context.Background()
+	playerRepo := newFakePlayerRepo()
+	svc := service.NewPlayerService(playerRepo, newFakeLookupTeamRepo(1, 2, 3), &fakeTx{}, zerolog.New(io.Discard))
+	p, err := playerRepo.Create(ctx, model.Player{TeamID: 1, FirstName: "Moving", LastName: "Guard", Position: "PG"})
+	require.NoError(t, err)
+	dec := time.Date(2024, 12, 15, 18, 30, 0, 0, time.UTC)
+
+	t.Run("validation", func(t *testing.T) {
+		_, err := svc.TransferPlayer(ctx, p.ID, 0, time.Time{})
+		require.True(t, serviceErrIsInvalid(err))
+		require.Len(t, service.FieldErrors(err), 2)
+
+		_, err = svc.TransferPlayer(ctx, p.ID, 99, dec)
+		require.True(t, serviceErrIsInvalid(err), "unknown team")
+
+		_, err = svc.TransferPlayer(ctx, p.ID, 1, dec)
+		require.True(t, serviceErrIsInvalid(err), "already on team 1")
+
+		_, err = svc.TransferPlayer(ctx, 777, 2, dec)
+		require.True(t, errors.Is(err, repository.ErrNotFound))
+	})
+
+	t.Run("appends_history", func(t *testing.T) {
+		m, err := svc.TransferPlayer(ctx, p.ID, 2, dec)
+		require.NoError(t, err)
+		require.Equal(t, int64(2), m.TeamID)
+		require.Equal(t, time.Date(2024, 12, 15, 0, 0, 0, 0, time.UTC), *m.StartDate, "time of day is dropped")
+
+		got, err := svc.GetPlayer(ctx, p.ID)
+		require.NoError(t, err)
+		require.Equal(t, int64(2), got.TeamID)
+
+		history, err := svc.ListMemberships(ctx, p.ID)
+		require.NoError(t, err)
+		require.Len(t, history, 2)
+		require.NotNil(t, history[0].EndDate)
+		require.Nil(t, history[1].EndDate)
+	})
+
+	t.Run("date_must_follow_current_membership", func(t *testing.T) {
+		_, err := svc.TransferPlayer(ctx, p.ID, 3, dec)
+		require.True(t, serviceErrIsInvalid(err))
+		require.Equal(t, "date", service.FieldErrors(err)[0].Field)
+
+		_, err = svc.TransferPlayer(ctx, p.ID, 3, dec.AddDate(0, 0, 1))
+		require.NoError(t, err)
+	})
+}
+
+func TestPlayerService_UpdateDeleteRestore(t *testing.T) {
+	playerRepo := newFakePlayerRepo()
+	svc := service.NewPlayerService(playerRepo, newFakeLookupTeamRepo(10), &fakeTx{}, zerolog.New(io.Discard))
+	ctx := context.Background()
+	p, err := svc.CreatePlayer(ctx, 10, "John", "Doe", "PG", model.PlayerBio{})
+	require.NoError(t, err)
+
+	bad, empty := "XX", ""
+	_, err = svc.UpdatePlayer(ctx, p.ID, model.PlayerPatch{LastName: &empty, Position: &bad})
+	require.True(t, serviceErrIsInvalid(err))
+	require.Len(t, service.FieldErrors(err), 2)
+
+	pos := "sg"
+	got, err := svc.UpdatePlayer(ctx, p.ID, model.PlayerPatch{Position: &pos})
+	require.NoError(t, err)
+	require.Equal(t, "SG", got.Position)
+	require.Equal(t, "Doe", got.LastName, "fields left out of the patch are kept")
+	require.Equal(t, int64(10), got.TeamID)
+
+	require.NoError(t, svc.DeletePlayer(ctx, p.ID))
+	_, err = svc.GetPlayer(ctx, p.ID)
+	require.ErrorIs(t, err, repository.ErrNotFound)
+	_, err = svc.UpdatePlayer(ctx, p.ID, model.PlayerPatch{Position: &pos})
+	require.ErrorIs(t, err, repository.ErrNotFound)
+
+	restored, err := svc.RestorePlayer(ctx, p.ID)
+	require.NoError(t, err)
+	require.Equal(t, "SG", restored.Position)
+}
+
+func TestPlayerService_Bio(t *testing.T) {
+	playerRepo := newFakePlayerRepo()
+	svc := service.NewPlayerService(playerRepo, newFakeLookupTeamRepo(10), &fakeTx{}, zerolog.New(io.Discard))
+	ctx := context.Background()
+	str := func(v string) *string { return &v }
+	num := func(v int) *int { return &v }
+	future := time.Now().AddDate(0, 0, 1)
+
+	_, err := svc.CreatePlayer(ctx, 10, "John", "Doe", "PG", model.PlayerBio{
+		JerseyNumber:       str("123"),
+		HeightCm:           num(300),
+		WeightKg:           num(20),
+		BirthDate:          &future,
+		Nationality:        str("USA"),
+		Handedness:         str("both"),
+		SecondaryPositions: []string{"pg"},
+	})
+	require.ElementsMatch(t, []string{"jersey_number", "height_cm", "weight_kg", "birth_date", "nationality", "handedness", "secondary_positions"}, fieldNames(err))
+	_, err = svc.CreatePlayer(ctx, 10, "John", "Doe", "PG", model.PlayerBio{SecondaryPositions: []string{"SG", "sg"}})
+	require.Equal(t, []string{"secondary_positions"}, fieldNames(err))
+
+	born := time.Date(1998, 2, 14, 18, 30, 0, 0, time.UTC)
+	p, err := svc.CreatePlayer(ctx, 10, "John", "Doe", "PG", model.PlayerBio{
+		JerseyNumber:       str(" 00 "),
+		HeightCm:           num(191),
+		BirthDate:          &born,
+		Nationality:        str("si"),
+		Handedness:         str(" Left"),
+		SecondaryPositions: []string{" sg"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "00", *p.JerseyNumber, "00 is kept apart from 0")
+	require.Equal(t, time.Date(1998, 2, 14, 0, 0, 0, 0, time.UTC), *p.BirthDate)
+	require.Equal(t, "SI", *p.Nationality)
+	require.Equal(t, "left", *p.Handedness)
+	require.Equal(t, []string{"SG"}, p.SecondaryPositions)
+	require.Nil(t, p.WeightKg)
+
+	sg := "sg"
+	_, err = svc.UpdatePlayer(ctx, p.ID, model.PlayerPatch{Position: &sg})
+	require.Equal(t, []string{"secondary_positions"}, fieldNames(err), "the new primary position is still listed as secondary")
+
+	none := []string{}
+	got, err := svc.UpdatePlayer(ctx, p.ID, model.PlayerPatch{JerseyNumber: str(""), HeightCm: num(0), BirthDate: &time.Time{}, SecondaryPositions: &none})
+	require.NoError(t, err)
+	require.Nil(t, got.JerseyNumber)
+	require.Nil(t, got.HeightCm)
+	require.Nil(t, got.BirthDate)
+	require.Empty(t, got.SecondaryPositions)
+	require.Equal(t, "SI", *got.Nationality, "fields left out of the patch are kept")
+}
+
+func TestAgeOn(t *testing.T) {
+	born := time.Date(2000, 2, 29, 0, 0, 0, 0, time.UTC)
+	cases := []struct {
+		day  time.Time
+		want int
+	}{
+		{time.Date(2025, 2, 28, 0, 0, 0, 0, time.UTC), 24},
+		{time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC), 25},
+		{time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC), 28},
+		{time.Date(2000, 2, 29, 0, 0, 0, 0, time.UTC), 0},
+	}
+	for _, tc := range cases {
+		require.Equal(t, tc.want, *model.AgeOn(&born, tc.day), tc.day.Format(time.DateOnly))
+	}
+	require.Nil(t, model.PlayerBio{}.AgeOn(time.Now()))
+}
@@ -0,0 +1,157 @@
+package service_test
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/maxviazov/basketball-stats-service/internal/model"
+	"github.com/maxviazov/basketball-stats-service/internal/repository"
+	"github.com/maxviazov/basketball-stats-service/internal/service"
+)
+
+// Ids of the profiles newFakeRuleProfileRepo is seeded with, in migration order.
+const (
+	nbaRulesID  int64 = 1
+	fibaRulesID int64 = 2
+)
+
+type fakeRuleProfileRepo struct {
+	nextID   int64
+	profiles map[int64]model.RuleProfile
+}
+
+func newFakeRuleProfileRepo() *fakeRuleProfileRepo {
+	f := &fakeRuleProfileRepo{nextID: 1, profiles: map[int64]model.RuleProfile{}}
+	_, _ = f.Create(context.Background(), model.RuleProfile{Name: "nba", PeriodMinutes: 12, Periods: 4, OvertimeMinutes: 5, FoulLimit: 6, RosterSize: 15})
+	_, _ = f.Create(context.Background(), model.RuleProfile{Name: "fiba", PeriodMinutes: 10, Periods: 4, OvertimeMinutes: 5, FoulLimit: 5, RosterSize: 12})
+	return f
+}
+func (f *fakeRuleProfileRepo) Create(_ context.Context, p model.RuleProfile) (model.RuleProfile, error) {
+	for _, existing := range f.profiles {
+		if existing.Name == p.Name {
+			return model.RuleProfile{}, repository.ErrAlreadyExists
+		}
+	}
+	p.ID = f.nextID
+	f.nextID++
+	f.profiles[p.ID] = p
+	return p, nil
+}
+func (f *fakeRuleProfileRepo) GetByID(_ context.Context, id int64) (model.RuleProfile, error) {
+	p, ok := f.profiles[id]
+	if !ok {
+		return model.RuleProfile{}, repository.ErrNotFound
+	}
+	return p, nil
+}
+func (f *fakeRuleProfileRepo) List(context.Context, repository.Page) (repository.PageResult[model.RuleProfile], error) {
+	var res repository.PageResult[model.RuleProfile]
+	for id := int64(1); id < f.nextID; id++ {
+		res.Items = append(res.Items, f.profiles[id])
+	}
+	res.Total = len(res.Items)
+	return res, nil
+}
+
+var _ repository.RuleProfileRepository = (*fakeRuleProfileRepo)(nil)
+
+func TestRuleProfileService_Create(t *testing.T) {
+	svc := service.NewRuleProfileService(newFakeRuleProfileRepo(), zerolog.New(io.Discard))
+	ctx := context.Background()
+
+	_, err := svc.CreateRuleProfile(ctx, model.RuleProfile{Name: " ", PeriodMinutes: 0, Periods: 9, OvertimeMinutes: 15, FoulLimit: 0, RosterSize: 3})
+	require.True(t, serviceErrIsInvalid(err))
+	require.Len(t, service.FieldErrors(err), 6)
+
+	_, err = svc.CreateRuleProfile(ctx, model.RuleProfile{Name: "NBA", PeriodMinutes: 12, Periods: 4, OvertimeMinutes: 5, FoulLimit: 6, RosterSize: 15})
+	require.ErrorIs(t, err, repository.ErrAlreadyExists, "names are case-insensitive")
+
+	out, err := svc.CreateRuleProfile(ctx, model.RuleProfile{Name: " Youth ", PeriodMinutes: 8, Periods: 4, OvertimeMinutes: 4, FoulLimit: 5, RosterSize: 12})
+	require.NoError(t, err)
+	require.Equal(t, "youth", out.Name)
+	require.Equal(t, 32.0, out.MaxMinutes(0))
+	require.Equal(t, 40.0, out.MaxMinutes(2))
+
+	list, err := svc.ListRuleProfiles(ctx, repository.Page{})
+	require.NoError(t, err)
+	require.Equal(t, 3, list.Total)
+}
+
+func TestStatsService_UpsertStatLine_RuleProfiles(t *testing.T) {
+	ctx := context.Background()
+	games := newFakeGameRepo()
+	fiba, err := games.Create(ctx, model.Game{Season: "2025-26", Date: gameDay, HomeTeamID: 1, AwayTeamID: 2, Status: "in_progress", RuleProfileID: fibaRulesID})
+	require.NoError(t, err)
+	nba, err := games.Create(ctx, model.Game{Season: "2025-26", Date: gameDay, HomeTeamID: 1, AwayTeamID: 2, Status: "in_progress"})
+	require.NoError(t, err)
+	players := newFakePlayerRepo()
+	p, err := players.Create(ctx, model.Player{TeamID: 1, FirstName: "Luka", LastName: "Doncic", Position: "PG"})
+	require.NoError(t, err)
+	rules := newFakeRuleProfileRepo()
+	svc := service.NewStatsService(&fakeStatsRepo{}, players, games, rules, newFakeAchievementRepo(), newFakeAvailabilityRepo(), newFakeShotRepo(), &fakeTxStats{}, zerolog.New(io.Discard))
+
+	fieldOf := func(err error) string {
+		t.Helper()
+		require.True(t, serviceErrIsInvalid(err), "want invalid input, got %v", err)
+		return service.FieldErrors(err)[0].Field
+	}
+
+	// FIBA: five fouls and 40 minutes.
+	_, err = svc.UpsertStatLine(ctx, model.PlayerStatLine{PlayerID: p.ID, GameID: fiba.ID, Fouls: 6})
+	require.Equal(t, "fouls", fieldOf(err))
+	_, err = svc.UpsertStatLine(ctx, model.PlayerStatLine{PlayerID: p.ID, GameID: fiba.ID, Fouls: 5, MinutesPlayed: 40})
+	require.NoError(t, err)
+	_, err = svc.UpsertStatLine(ctx, model.PlayerStatLine{PlayerID: p.ID, GameID: fiba.ID, MinutesPlayed: 44.5})
+	require.Equal(t, "minutes_played", fieldOf(err))
+
+	// A recorded overtime extends the limit by one overtime period.
+	g := games.games[fiba.ID]
+	g.LineScore = []model.PeriodScore{{Period: 1}, {Period: 2}, {Period: 3}, {Period: 4}, {Period: 5}}
+	games.games[fiba.ID] = g
+	_, err = svc.UpsertStatLine(ctx, model.PlayerStatLine{PlayerID: p.ID, GameID: fiba.ID, MinutesPlayed: 44.5})
+	require.NoError(t, err)
+
+	// NBA: six fouls and 48 minutes are fine.
+	_, err = svc.UpsertStatLine(ctx, model.PlayerStatLine{PlayerID: p.ID, GameID: nba.ID, Fouls: 6, MinutesPlayed: 48})
+	require.NoError(t, err)
+
+	// Roster size: a full side takes no new players, but existing ones can still be corrected.
+	tiny, err := rules.Create(ctx, model.RuleProfile{Name: "3x3", PeriodMinutes: 10, Periods: 1, OvertimeMinutes: 2, FoulLimit: 6, RosterSize: 5})
+	require.NoError(t, err)
+	small, err := games.Create(ctx, model.Game{Season: "2025-26", Date: gameDay, HomeTeamID: 1, AwayTeamID: 2, Status: "in_progress", RuleProfileID: tiny.ID})
+	require.NoError(t, err)
+	games.lines = map[int64][]model.BoxScoreLine{small.ID: {}}
+	for i := int64(100); i < 105; i++ {
+		games.lines[small.ID] = append(games.lines[small.ID], model.BoxScoreLine{PlayerStatLine: model.PlayerStatLine{PlayerID: i}, TeamID: 1})
+	}
+	_, err = svc.UpsertStatLine(ctx, model.PlayerStatLine{PlayerID: p.ID, GameID: small.ID})
+	require.Equal(t, "player_id", fieldOf(err))
+	games.lines[small.ID][0].PlayerID = p.ID
+	_, err = svc.UpsertStatLine(ctx, model.PlayerStatLine{PlayerID: p.ID, GameID: small.ID, MinutesPlayed: 10})
+	require.NoError(t, err)
+}
+
+func TestGameService_CreateGame_RuleProfile(t *testing.T) {
+	teamRepo := &fakeExistTeamRepo{exist: map[int64]bool{1: true, 2: true}}
+	svc := service.NewGameService(newFakeGameRepo(), teamRepo, newFakeSeasonRepo(season2025()), newFakeRuleProfileRepo(), newFakeVenueRepo(), newFakeLineStore(), newFakeAchievementRepo(), &fakeTx{}, zerolog.New(io.Discard))
+	ctx := context.Background()
+
+	_, err := svc.CreateGame(ctx, model.Game{Season: "2025-26", Date: gameDay, HomeTeamID: 1, AwayTeamID: 2, Status: "scheduled", RuleProfileID: 99})
+	require.True(t, serviceErrIsInvalid(err))
+	require.Equal(t, "rule_profile_id", service.FieldErrors(err)[0].Field)
+
+	g, err := svc.CreateGame(ctx, model.Game{Season: "2025-26", Date: gameDay, HomeTeamID: 1, AwayTeamID: 2, Status: "in_progress", RuleProfileID: fibaRulesID})
+	require.NoError(t, err)
+	require.Equal(t, fibaRulesID, g.RuleProfileID)
+
+	// FIBA still plays four quarters, so a finished game needs all of them in its line score.
+	finished, err := svc.CreateGame(ctx, model.Game{Season: "2025-26", Date: gameDay.Add(time.Hour), HomeTeamID: 1, AwayTeamID: 2, Status: "finished", RuleProfileID: fibaRulesID})
+	require.NoError(t, err)
+	_, err = svc.UpdateScore(ctx, finished.ID, model.GameScore{HomeScore: 2, AwayScore: 1, Periods: []model.PeriodScore{{Period: 1, HomePoints: 2, AwayPoints: 1}}})
+	require.Equal(t, "periods", service.FieldErrors(err)[0].Field)
+}
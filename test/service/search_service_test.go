@@ -0,0 +1,44 @@
+package service_test
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/maxviazov/basketball-stats-service/internal/model"
+	"github.com/maxviazov/basketball-stats-service/internal/repository"
+	"github.com/maxviazov/basketball-stats-service/internal/service"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeSearchRepo struct {
+	lastQ    string
+	lastKind *string
+	lastPage repository.Page
+}
+
+func (f *fakeSearchRepo) Search(_ context.Context, q string, kind *string, p repository.Page) (repository.PageResult[model.SearchResult], error) {
+	f.lastQ, f.lastKind, f.lastPage = q, kind, p
+	return repository.PageResult[model.SearchResult]{}, nil
+}
+
+var _ repository.SearchRepository = (*fakeSearchRepo)(nil)
+
+func TestSearchService_Search(t *testing.T) {
+	ctx := context.Background()
+	repo := &fakeSearchRepo{}
+	svc := service.NewSearchService(repo, zerolog.New(io.Discard))
+
+	bad := "coach"
+	_, err := svc.Search(ctx, " a ", &bad, repository.Page{})
+	require.True(t, serviceErrIsInvalid(err))
+	require.Len(t, service.FieldErrors(err), 2)
+
+	kind := " Player "
+	_, err = svc.Search(ctx, "  luka   dončić ", &kind, repository.Page{Limit: 500})
+	require.NoError(t, err)
+	require.Equal(t, "luka dončić", repo.lastQ, "whitespace is collapsed, accents are left to the database")
+	require.Equal(t, "player", *repo.lastKind)
+	require.Equal(t, 100, repo.lastPage.Limit)
+}
@@ -0,0 +1,134 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/maxviazov/basketball-stats-service/internal/model"
+	"github.com/maxviazov/basketball-stats-service/internal/repository"
+	"github.com/maxviazov/basketball-stats-service/internal/service"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeSeasonRepo struct {
+	nextID  int64
+	seasons map[int64]model.Season
+}
+
+func newFakeSeasonRepo(seed ...model.Season) *fakeSeasonRepo {
+	f := &fakeSeasonRepo{nextID: 1, seasons: map[int64]model.Season{}}
+	for _, s := range seed {
+		_, _ = f.Create(context.Background(), s)
+	}
+	return f
+}
+func (f *fakeSeasonRepo) Create(_ context.Context, s model.Season) (model.Season, error) {
+	for _, existing := range f.seasons {
+		if existing.Name == s.Name {
+			return model.Season{}, repository.ErrAlreadyExists
+		}
+	}
+	s.ID = f.nextID
+	f.nextID++
+	f.seasons[s.ID] = s
+	return s, nil
+}
+func (f *fakeSeasonRepo) GetByID(_ context.Context, id int64) (model.Season, error) {
+	s, ok := f.seasons[id]
+	if !ok {
+		return model.Season{}, repository.ErrNotFound
+	}
+	return s, nil
+}
+func (f *fakeSeasonRepo) GetByName(_ context.Context, name string) (model.Season, error) {
+	for _, s := range f.seasons {
+		if s.Name == name {
+			return s, nil
+		}
+	}
+	return model.Season{}, repository.ErrNotFound
+}
+func (f *fakeSeasonRepo) List(_ context.Context, _ repository.Page) (repository.PageResult[model.Season], error) {
+	var res repository.PageResult[model.Season]
+	for _, s := range f.seasons {
+		res.Items = append(res.Items, s)
+	}
+	res.Total = len(res.Items)
+	return res, nil
+}
+
+var _ repository.SeasonRepository = (*fakeSeasonRepo)(nil)
+
+func day(y int, m time.Month, d int) time.Time { return time.Date(y, m, d, 0, 0, 0, 0, time.UTC) }
+
+// season2025 is a 2025-26 season with a regular phase and playoffs, and no preseason.
+func season2025() model.Season {
+	return model.Season{
+		Name:      "2025-26",
+		StartDate: day(2025, time.October, 1),
+		EndDate:   day(2026, time.June, 30),
+		Phases: []model.SeasonPhase{
+			{Phase: "regular", StartDate: day(2025, time.October, 21), EndDate: day(2026, time.April, 12)},
+			{Phase: "playoffs", StartDate: day(2026, time.April, 18), EndDate: day(2026, time.June, 30)},
+		},
+	}
+}
+
+func TestSeasonService_CreateSeason(t *testing.T) {
+	ctx := context.Background()
+	svc := service.NewSeasonService(newFakeSeasonRepo(), &fakeTx{}, zerolog.New(io.Discard))
+
+	t.Run("validation", func(t *testing.T) {
+		cases := []struct {
+			name  string
+			in    func(s *model.Season)
+			field string
+		}{
+			{"bad name", func(s *model.Season) { s.Name = "2025/26" }, "name"},
+			{"missing dates", func(s *model.Season) { s.EndDate = time.Time{} }, "dates"},
+			{"ends before start", func(s *model.Season) { s.EndDate = day(2025, time.September, 1) }, "end_date"},
+			{"start year mismatch", func(s *model.Season) { s.StartDate = day(2026, time.January, 1) }, "start_date"},
+			{"unknown phase", func(s *model.Season) { s.Phases[0].Phase = "finals" }, "phases"},
+			{"duplicate phase", func(s *model.Season) { s.Phases[1].Phase = "regular" }, "phases"},
+			{"phase outside season", func(s *model.Season) { s.Phases[1].EndDate = day(2026, time.July, 15) }, "phases"},
+			{"overlapping phases", func(s *model.Season) { s.Phases[1].StartDate = day(2026, time.April, 1) }, "phases"},
+		}
+		for _, tc := range cases {
+			t.Run(tc.name, func(t *testing.T) {
+				in := season2025()
+				tc.in(&in)
+				_, err := svc.CreateSeason(ctx, in)
+				require.True(t, serviceErrIsInvalid(err), "expected invalid input, got %v", err)
+				require.Equal(t, tc.field, service.FieldErrors(err)[0].Field)
+			})
+		}
+	})
+
+	t.Run("defaults_to_regular_phase", func(t *testing.T) {
+		s, err := svc.CreateSeason(ctx, model.Season{Name: "2024-25", StartDate: day(2024, time.October, 22), EndDate: day(2025, time.April, 13)})
+		require.NoError(t, err)
+		require.Len(t, s.Phases, 1)
+		require.Equal(t, "regular", s.Phases[0].Phase)
+		require.Equal(t, s.StartDate, s.Phases[0].StartDate)
+		require.Equal(t, s.EndDate, s.Phases[0].EndDate)
+	})
+
+	t.Run("ok_and_duplicate", func(t *testing.T) {
+		in := season2025()
+		in.Phases[1].Phase = "Playoffs"
+		s, err := svc.CreateSeason(ctx, in)
+		require.NoError(t, err)
+		require.Equal(t, "playoffs", s.Phases[1].Phase)
+
+		got, err := svc.GetSeason(ctx, s.ID)
+		require.NoError(t, err)
+		require.Equal(t, "2025-26", got.Name)
+
+		_, err = svc.CreateSeason(ctx, season2025())
+		require.True(t, errors.Is(err, repository.ErrAlreadyExists))
+	})
+}
@@ -0,0 +1,192 @@
+package service_test
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/maxviazov/basketball-stats-service/internal/model"
+	"github.com/maxviazov/basketball-stats-service/internal/repository"
+	"github.com/maxviazov/basketball-stats-service/internal/service"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeShotRepo struct {
+	nextID int64
+	shots  []model.Shot
+	chart  []model.ShotZoneStats
+	season *string
+}
+
+func newFakeShotRepo() *fakeShotRepo { return &fakeShotRepo{} }
+
+func (f *fakeShotRepo) Create(_ context.Context, s model.Shot) (model.Shot, error) {
+	f.nextID++
+	s.ID = f.nextID
+	f.shots = append(f.shots, s)
+	return s, nil
+}
+func (f *fakeShotRepo) ListByGame(_ context.Context, gameID int64) ([]model.Shot, error) {
+	var out []model.Shot
+	for _, s := range f.shots {
+		if s.GameID == gameID {
+			out = append(out, s)
+		}
+	}
+	return out, nil
+}
+func (f *fakeShotRepo) Delete(_ context.Context, gameID, shotID int64) error {
+	for i, s := range f.shots {
+		if s.GameID == gameID && s.ID == shotID {
+			f.shots = append(f.shots[:i], f.shots[i+1:]...)
+			return nil
+		}
+	}
+	return repository.ErrNotFound
+}
+func (f *fakeShotRepo) CountByPlayerGame(_ context.Context, playerID, gameID int64) (model.ShotCounts, error) {
+	var c model.ShotCounts
+	for _, s := range f.shots {
+		if s.PlayerID != playerID || s.GameID != gameID {
+			continue
+		}
+		c.Attempts++
+		three := s.ShotType == "three_pt"
+		if three {
+			c.ThreeAttempts++
+		}
+		if s.Made {
+			c.Makes++
+			if three {
+				c.ThreeMakes++
+			}
+		}
+	}
+	return c, nil
+}
+func (f *fakeShotRepo) LockGame(context.Context, int64) error { return nil }
+func (f *fakeShotRepo) GetShotChart(_ context.Context, _ int64, season *string) ([]model.ShotZoneStats, error) {
+	f.season = season
+	return f.chart, nil
+}
+
+var _ repository.ShotRepository = (*fakeShotRepo)(nil)
+
+// Player 1 has a line of 2/3 FG and 0/1 3PT in game 3; player 2 is on the roster without a line.
+func newShotService(shots *fakeShotRepo) service.ShotService {
+	players := &fakePlayerLookup{ok: map[int64]bool{1: true, 2: true, 3: true}, team: map[int64]int64{3: 0}}
+	games := &fakeGameLookup{ok: map[int64]bool{3: true, 4: true}, status: map[int64]string{4: "scheduled"}}
+	stats := &linesStatsRepo{lines: []model.PlayerStatLine{{
+		PlayerID: 1, GameID: 3, FieldGoalsMade: 2, FieldGoalsAttempted: 3, ThreePointersAttempted: 1,
+	}}}
+	return service.NewShotService(shots, stats, players, games, newFakeRuleProfileRepo(), &fakeTxStats{}, zerolog.New(io.Discard))
+}
+
+func TestShotService_RecordShot(t *testing.T) {
+	ctx := context.Background()
+	shots := newFakeShotRepo()
+	svc := newShotService(shots)
+	shot := func(gameID, playerID int64, x, y float64, shotType string, made bool) model.Shot {
+		return model.Shot{GameID: gameID, PlayerID: playerID, Period: 1, ClockSeconds: 600, X: x, Y: y, ShotType: shotType, Made: made}
+	}
+
+	cases := []struct {
+		name  string
+		shot  model.Shot
+		field string
+	}{
+		{"free throw", shot(3, 1, 0, 13.75, "free_throw", true), "shot_type"},
+		{"out of bounds", shot(3, 1, 26, 10, "two_pt", true), "x"},
+		{"behind the baseline", shot(3, 1, 0, -6, "two_pt", true), "y"},
+		{"three inside the arc", shot(3, 1, 0, 21, "three_pt", true), "shot_type"},
+		{"three inside the corner line", shot(3, 1, -21, 1, "three_pt", true), "shot_type"},
+		{"two beyond the arc", shot(3, 1, 0, 25, "two_pt", true), "shot_type"},
+		{"two from the corner", shot(3, 1, 23, 2, "two_pt", true), "shot_type"},
+		{"game not started", shot(4, 1, 0, 0, "two_pt", true), "game_id"},
+		{"not on a roster", shot(3, 3, 0, 0, "two_pt", true), "player_id"},
+		{"no stat line", shot(3, 2, 0, 0, "two_pt", true), "player_id"},
+		{"more threes than the line", shot(3, 1, 0, 25, "three_pt", true), "player_id"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := svc.RecordShot(ctx, tc.shot)
+			require.Equal(t, []string{tc.field}, fieldNames(err))
+		})
+	}
+	long := shot(3, 1, 0, 0, "two_pt", true)
+	long.ClockSeconds = 721
+	_, err := svc.RecordShot(ctx, long)
+	require.Equal(t, []string{"clock_seconds"}, fieldNames(err))
+
+	layup, err := svc.RecordShot(ctx, shot(3, 1, 1, 2, " TWO_PT ", true))
+	require.NoError(t, err)
+	require.Equal(t, "two_pt", layup.ShotType)
+	require.Equal(t, "restricted_area", layup.Zone)
+	corner, err := svc.RecordShot(ctx, shot(3, 1, -22, 1, "three_pt", false))
+	require.NoError(t, err)
+	require.Equal(t, "corner_3", corner.Zone)
+	_, err = svc.RecordShot(ctx, shot(3, 1, 0, 25, "three_pt", false))
+	require.Equal(t, []string{"player_id"}, fieldNames(err), "the line's only three is charted")
+	floater, err := svc.RecordShot(ctx, shot(3, 1, -6, 9, "two_pt", true))
+	require.NoError(t, err)
+	require.Equal(t, "paint", floater.Zone)
+	_, err = svc.RecordShot(ctx, shot(3, 1, 15, 10, "two_pt", true))
+	require.Equal(t, []string{"player_id"}, fieldNames(err), "all three attempts are charted")
+
+	all, err := svc.ListGameShots(ctx, 3)
+	require.NoError(t, err)
+	require.Len(t, all, 3)
+	_, err = svc.ListGameShots(ctx, 99)
+	require.ErrorIs(t, err, repository.ErrNotFound)
+
+	require.NoError(t, svc.DeleteShot(ctx, 3, floater.ID))
+	require.ErrorIs(t, svc.DeleteShot(ctx, 3, floater.ID), repository.ErrNotFound)
+	jumper, err := svc.RecordShot(ctx, shot(3, 1, 15, 10, "two_pt", true))
+	require.NoError(t, err, "deleting a shot frees its place in the line")
+	require.Equal(t, "mid_range", jumper.Zone)
+}
+
+func TestShotService_GetShotChart(t *testing.T) {
+	ctx := context.Background()
+	shots := &fakeShotRepo{chart: []model.ShotZoneStats{
+		{Zone: "corner_3", Attempts: 6, Makes: 2},
+		{Zone: "restricted_area", Attempts: 8, Makes: 7},
+	}}
+	svc := newShotService(shots)
+
+	bad := "2025"
+	_, err := svc.GetShotChart(ctx, 1, &bad)
+	require.Equal(t, []string{"season"}, fieldNames(err))
+	_, err = svc.GetShotChart(ctx, 9, nil)
+	require.ErrorIs(t, err, repository.ErrNotFound)
+
+	season := " 2025-26 "
+	chart, err := svc.GetShotChart(ctx, 1, &season)
+	require.NoError(t, err)
+	require.Equal(t, "2025-26", *shots.season)
+	require.Equal(t, []model.ShotZoneStats{
+		{Zone: "restricted_area", Attempts: 8, Makes: 7, Pct: 0.875},
+		{Zone: "paint"},
+		{Zone: "mid_range"},
+		{Zone: "corner_3", Attempts: 6, Makes: 2, Pct: 0.333},
+		{Zone: "above_the_break_3"},
+	}, chart.Zones)
+}
+
+func TestStatsService_UpsertStatLine_CoversChartedShots(t *testing.T) {
+	ctx := context.Background()
+	shots := &fakeShotRepo{shots: []model.Shot{
+		{GameID: 2, PlayerID: 1, ShotType: "three_pt", Made: true},
+		{GameID: 2, PlayerID: 1, ShotType: "two_pt"},
+	}}
+	svc := service.NewStatsService(&fakeStatsRepo{}, &fakePlayerLookup{ok: map[int64]bool{1: true}}, &fakeGameLookup{ok: map[int64]bool{2: true}}, newFakeRuleProfileRepo(), newFakeAchievementRepo(), newFakeAvailabilityRepo(), shots, &fakeTxStats{}, zerolog.New(io.Discard))
+
+	line := model.PlayerStatLine{PlayerID: 1, GameID: 2, Points: 3, FieldGoalsMade: 1, FieldGoalsAttempted: 1, ThreePointersMade: 1, ThreePointersAttempted: 1, MinutesPlayed: 10}
+	_, err := svc.UpsertStatLine(ctx, line)
+	require.Equal(t, []string{"field_goals_attempted"}, fieldNames(err))
+
+	line.FieldGoalsAttempted = 2
+	_, err = svc.UpsertStatLine(ctx, line)
+	require.NoError(t, err)
+}
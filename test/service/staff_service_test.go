@@ -0,0 +1,197 @@
+package service_test
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/maxviazov/basketball-stats-service/internal/model"
+	"github.com/maxviazov/basketball-stats-service/internal/repository"
+	"github.com/maxviazov/basketball-stats-service/internal/service"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeStaffRepo struct {
+	nextID  int64
+	staff   map[int64]model.Staff
+	tenures []model.StaffTenure
+	seasons []model.CoachSeasonRecord
+	phase   *string
+}
+
+func newFakeStaffRepo() *fakeStaffRepo {
+	return &fakeStaffRepo{nextID: 1, staff: map[int64]model.Staff{}}
+}
+func (f *fakeStaffRepo) Create(_ context.Context, s model.Staff) (model.Staff, error) {
+	s.ID = f.nextID
+	f.nextID++
+	f.staff[s.ID] = s
+	return s, nil
+}
+func (f *fakeStaffRepo) GetByID(_ context.Context, id int64) (model.Staff, error) {
+	s, ok := f.staff[id]
+	if !ok {
+		return model.Staff{}, repository.ErrNotFound
+	}
+	return s, nil
+}
+func (f *fakeStaffRepo) List(context.Context, repository.Page) (repository.PageResult[model.Staff], error) {
+	var res repository.PageResult[model.Staff]
+	for _, s := range f.staff {
+		res.Items = append(res.Items, s)
+	}
+	res.Total = len(res.Items)
+	return res, nil
+}
+func (f *fakeStaffRepo) Lock(_ context.Context, staffID, _ int64) error {
+	if _, ok := f.staff[staffID]; !ok {
+		return repository.ErrNotFound
+	}
+	return nil
+}
+func (f *fakeStaffRepo) CreateTenure(_ context.Context, t model.StaffTenure) (model.StaffTenure, error) {
+	t.ID = f.nextID
+	f.nextID++
+	f.tenures = append(f.tenures, t)
+	return t, nil
+}
+func (f *fakeStaffRepo) EndTenure(_ context.Context, staffID, tenureID int64, end time.Time) (model.StaffTenure, error) {
+	for i, t := range f.tenures {
+		if t.ID == tenureID && t.StaffID == staffID && t.EndDate == nil {
+			f.tenures[i].EndDate = &end
+			return f.tenures[i], nil
+		}
+	}
+	return model.StaffTenure{}, repository.ErrNotFound
+}
+func (f *fakeStaffRepo) ListTenures(_ context.Context, staffID int64) ([]model.StaffTenure, error) {
+	var out []model.StaffTenure
+	for _, t := range f.tenures {
+		if t.StaffID == staffID {
+			out = append(out, t)
+		}
+	}
+	return out, nil
+}
+func (f *fakeStaffRepo) ListTeamTenures(_ context.Context, teamID int64) ([]model.StaffTenure, error) {
+	var out []model.StaffTenure
+	for _, t := range f.tenures {
+		if t.TeamID == teamID {
+			out = append(out, t)
+		}
+	}
+	return out, nil
+}
+func (f *fakeStaffRepo) ListByTeam(ctx context.Context, teamID int64, _ *time.Time) ([]model.StaffTenure, error) {
+	return f.ListTeamTenures(ctx, teamID)
+}
+func (f *fakeStaffRepo) ListCoachSeasons(_ context.Context, _ int64, phase *string) ([]model.CoachSeasonRecord, error) {
+	f.phase = phase
+	return f.seasons, nil
+}
+
+var _ repository.StaffRepository = (*fakeStaffRepo)(nil)
+
+func newStaffService(staff *fakeStaffRepo) service.StaffService {
+	teams := newFakeTeamRepo()
+	teams.items[1] = model.Team{ID: 1, Name: "Celtics"}
+	teams.items[2] = model.Team{ID: 2, Name: "Lakers"}
+	return service.NewStaffService(staff, teams, &fakeTxStats{}, zerolog.New(io.Discard))
+}
+
+func TestStaffService_AddTenure(t *testing.T) {
+	ctx := context.Background()
+	staff := newFakeStaffRepo()
+	svc := newStaffService(staff)
+	coach, err := svc.CreateStaff(ctx, " Joe ", "Mazzulla")
+	require.NoError(t, err)
+	require.Equal(t, "Joe", coach.FirstName)
+	rival, _ := svc.CreateStaff(ctx, "JJ", "Redick")
+	_, err = svc.CreateStaff(ctx, "", "Nobody")
+	require.Equal(t, []string{"first_name"}, fieldNames(err))
+
+	day := func(y int, m time.Month, d int) time.Time { return time.Date(y, m, d, 15, 0, 0, 0, time.UTC) }
+	end := day(2023, time.July, 1)
+	tenure := func(staffID, teamID int64, role string, start time.Time, end *time.Time) model.StaffTenure {
+		return model.StaffTenure{StaffID: staffID, TeamID: teamID, Role: role, StartDate: start, EndDate: end}
+	}
+
+	cases := []struct {
+		name   string
+		tenure model.StaffTenure
+		fields []string
+	}{
+		{"unknown role", tenure(coach.ID, 1, "scout", day(2022, time.July, 1), nil), []string{"role"}},
+		{"no start", tenure(coach.ID, 1, "head_coach", time.Time{}, nil), []string{"start_date"}},
+		{"end before start", tenure(coach.ID, 1, "head_coach", day(2023, time.July, 1), &end), []string{"end_date"}},
+		{"unknown team", tenure(coach.ID, 9, "head_coach", day(2022, time.July, 1), nil), []string{"team_id"}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := svc.AddTenure(ctx, tc.tenure)
+			require.Equal(t, tc.fields, fieldNames(err))
+		})
+	}
+	_, err = svc.AddTenure(ctx, tenure(99, 1, "trainer", day(2022, time.July, 1), nil))
+	require.ErrorIs(t, err, repository.ErrNotFound)
+
+	assistant, err := svc.AddTenure(ctx, tenure(coach.ID, 1, " Assistant Coach ", day(2019, time.July, 1), &end))
+	require.NoError(t, err)
+	require.Equal(t, "assistant_coach", assistant.Role)
+	require.Equal(t, time.Date(2019, time.July, 1, 0, 0, 0, 0, time.UTC), assistant.StartDate)
+
+	_, err = svc.AddTenure(ctx, tenure(coach.ID, 2, "head_coach", day(2023, time.June, 1), nil))
+	require.Equal(t, []string{"start_date"}, fieldNames(err), "one tenure at a time")
+	head, err := svc.AddTenure(ctx, tenure(coach.ID, 1, "head_coach", end, nil))
+	require.NoError(t, err, "a tenure may start the day the previous one ends")
+
+	_, err = svc.AddTenure(ctx, tenure(rival.ID, 1, "head_coach", day(2024, time.July, 1), nil))
+	require.Equal(t, []string{"role"}, fieldNames(err), "one head coach per team")
+	_, err = svc.AddTenure(ctx, tenure(rival.ID, 1, "assistant_coach", day(2024, time.July, 1), nil))
+	require.NoError(t, err)
+
+	_, err = svc.EndTenure(ctx, coach.ID, assistant.ID, day(2024, time.July, 1))
+	require.Equal(t, []string{"end_date"}, fieldNames(err), "already ended")
+	_, err = svc.EndTenure(ctx, coach.ID, head.ID, end)
+	require.Equal(t, []string{"end_date"}, fieldNames(err))
+	_, err = svc.EndTenure(ctx, rival.ID, head.ID, day(2025, time.July, 1))
+	require.ErrorIs(t, err, repository.ErrNotFound)
+	ended, err := svc.EndTenure(ctx, coach.ID, head.ID, day(2025, time.July, 1))
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2025, time.July, 1, 0, 0, 0, 0, time.UTC), *ended.EndDate)
+
+	_, err = svc.AddTenure(ctx, tenure(rival.ID, 1, "head_coach", day(2025, time.July, 1), nil))
+	require.Equal(t, []string{"start_date"}, fieldNames(err), "still an assistant there")
+}
+
+func TestStaffService_GetCoachRecord(t *testing.T) {
+	ctx := context.Background()
+	staff := newFakeStaffRepo()
+	svc := newStaffService(staff)
+	coach, _ := svc.CreateStaff(ctx, "Erik", "Spoelstra")
+
+	bad := "summer"
+	_, err := svc.GetCoachRecord(ctx, coach.ID, &bad)
+	require.Equal(t, []string{"phase"}, fieldNames(err))
+	_, err = svc.GetCoachRecord(ctx, 99, nil)
+	require.ErrorIs(t, err, repository.ErrNotFound)
+
+	empty, err := svc.GetCoachRecord(ctx, coach.ID, nil)
+	require.NoError(t, err)
+	require.Equal(t, model.CoachRecord{StaffID: coach.ID, Seasons: []model.CoachSeasonRecord{}}, empty)
+
+	staff.seasons = []model.CoachSeasonRecord{{Season: "2023-24", Wins: 46, Losses: 36}, {Season: "2024-25", Wins: 2, Losses: 1}}
+	phase := " Regular "
+	rec, err := svc.GetCoachRecord(ctx, coach.ID, &phase)
+	require.NoError(t, err)
+	require.Equal(t, "regular", *staff.phase)
+	require.Equal(t, 48, rec.Wins)
+	require.Equal(t, 37, rec.Losses)
+	require.Equal(t, 0.565, rec.WinPct)
+	require.Equal(t, []model.CoachSeasonRecord{
+		{Season: "2023-24", Wins: 46, Losses: 36, WinPct: 0.561},
+		{Season: "2024-25", Wins: 2, Losses: 1, WinPct: 0.667},
+	}, rec.Seasons)
+}
@@ -0,0 +1,68 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/maxviazov/basketball-stats-service/internal/model"
+	"github.com/maxviazov/basketball-stats-service/internal/repository"
+	"github.com/maxviazov/basketball-stats-service/internal/service"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func standing(id int64, wins, losses, diff int, h2h map[int64]model.WinLoss) model.TeamStanding {
+	return model.TeamStanding{TeamID: id, Wins: wins, Losses: losses, PointDiff: diff, HeadToHead: h2h}
+}
+
+func TestStandingsService_GetStandings(t *testing.T) {
+	ctx := context.Background()
+	teams := newFakeTeamRepo()
+	svc := service.NewStandingsService(teams, newFakeSeasonRepo(season2025()), zerolog.New(io.Discard))
+
+	t.Run("validation", func(t *testing.T) {
+		_, err := svc.GetStandings(ctx, "", nil)
+		require.True(t, serviceErrIsInvalid(err))
+		bad := "finals"
+		_, err = svc.GetStandings(ctx, "2025-26", &bad)
+		require.True(t, serviceErrIsInvalid(err))
+		require.Equal(t, "phase", service.FieldErrors(err)[0].Field)
+	})
+
+	t.Run("unknown_season", func(t *testing.T) {
+		_, err := svc.GetStandings(ctx, "2030-31", nil)
+		require.True(t, errors.Is(err, repository.ErrNotFound))
+	})
+
+	t.Run("ranking_and_tie_breakers", func(t *testing.T) {
+		teams.standings = []model.TeamStanding{
+			// 1 and 2 are level at 6-2; 2 won the season series.
+			standing(1, 6, 2, 40, map[int64]model.WinLoss{2: {Wins: 1, Losses: 2}}),
+			standing(2, 6, 2, 10, map[int64]model.WinLoss{1: {Wins: 2, Losses: 1}}),
+			// 3 and 4 are level at 4-4 with a split series; 4 has the better point differential.
+			standing(3, 4, 4, -5, map[int64]model.WinLoss{4: {Wins: 1, Losses: 1}}),
+			standing(4, 4, 4, 12, map[int64]model.WinLoss{3: {Wins: 1, Losses: 1}}),
+			standing(5, 0, 0, 0, nil),
+			standing(6, 1, 7, -57, nil),
+		}
+		got, err := svc.GetStandings(ctx, "2025-26", nil)
+		require.NoError(t, err)
+		require.Equal(t, "regular", got.Phase)
+
+		order := make([]int64, 0, len(got.Teams))
+		for _, row := range got.Teams {
+			order = append(order, row.TeamID)
+		}
+		require.Equal(t, []int64{2, 1, 4, 3, 6, 5}, order)
+
+		require.Equal(t, 1, got.Teams[0].Rank)
+		require.Equal(t, 0.75, got.Teams[0].WinPct)
+		require.Equal(t, 0.0, got.Teams[1].GamesBehind)
+		require.Equal(t, 2.0, got.Teams[2].GamesBehind)
+		require.Equal(t, 0.125, got.Teams[4].WinPct)
+		require.Equal(t, 5.0, got.Teams[4].GamesBehind)
+		require.Equal(t, 2.0, got.Teams[5].GamesBehind, "a team without games is behind by half the leader's margin")
+	})
+}
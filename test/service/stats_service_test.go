@@ -2,8 +2,10 @@ package service_test
 
 import (
 	"context"
+	"errors"
 	"io"
 	"testing"
+	"time"
 
 	"github.com/rs/zerolog"
 
@@ -12,7 +14,10 @@ import (
 	"github.com/maxviazov/basketball-stats-service/internal/service"
 )
 
-type fakeStatsRepo struct{}
+type fakeStatsRepo struct {
+	lastFilter model.GameLogFilter
+	lastPage   repository.Page
+}
 
 func (f *fakeStatsRepo) UpsertStatLine(_ context.Context, s model.PlayerStatLine) (model.PlayerStatLine, error) {
 	s.ID = 1
@@ -22,9 +27,20 @@ func (f *fakeStatsRepo) ListByGame(_ context.Context, gameID int64) ([]model.Pla
 	return []model.PlayerStatLine{}, nil
 }
 
+func (f *fakeStatsRepo) ListByPlayer(_ context.Context, _ int64, fl model.GameLogFilter, p repository.Page) (repository.PageResult[model.GameLogEntry], error) {
+	f.lastFilter, f.lastPage = fl, p
+	return repository.PageResult[model.GameLogEntry]{}, nil
+}
+
+func (f *fakeStatsRepo) DeleteStatLine(context.Context, int64, int64) error { return nil }
+
 var _ repository.StatsRepository = (*fakeStatsRepo)(nil)
 
-type fakePlayerLookup struct{ ok map[int64]bool }
+// fakePlayerLookup knows the players in ok; they play for team 1 unless team says otherwise, and 0 means no roster.
+type fakePlayerLookup struct {
+	ok   map[int64]bool
+	team map[int64]int64
+}
 
 func (f *fakePlayerLookup) Create(context.Context, model.Player) (model.Player, error) {
 	return model.Player{}, nil
@@ -35,33 +51,103 @@ func (f *fakePlayerLookup) GetByID(_ context.Context, id int64) (model.Player, e
 	}
 	return model.Player{}, repository.ErrNotFound
 }
-func (f *fakePlayerLookup) ListByTeam(context.Context, int64, repository.Page) (repository.PageResult[model.Player], error) {
+func (f *fakePlayerLookup) ListByTeam(context.Context, int64, *time.Time, repository.Page) (repository.PageResult[model.Player], error) {
 	return repository.PageResult[model.Player]{}, nil
 }
-func (f *fakePlayerLookup) GetPlayerAggregatedStats(context.Context, int64, *string) (model.PlayerAggregatedStats, error) {
+func (f *fakePlayerLookup) GetPlayerAggregatedStats(context.Context, int64, *string, *string) (model.PlayerAggregatedStats, error) {
 	return model.PlayerAggregatedStats{}, nil // Dummy implementation
 }
+func (f *fakePlayerLookup) GetPlayerSplits(context.Context, int64, *string, *string, []string) ([]model.PlayerSplit, error) {
+	return nil, nil
+}
+func (f *fakePlayerLookup) ListMemberships(context.Context, int64) ([]model.Membership, error) {
+	return nil, nil
+}
+func (f *fakePlayerLookup) TeamOn(_ context.Context, id int64, _ time.Time) (int64, error) {
+	if !f.ok[id] {
+		return 0, repository.ErrNotFound
+	}
+	teamID, ok := f.team[id]
+	if !ok {
+		teamID = 1
+	}
+	if teamID == 0 {
+		return 0, repository.ErrNotFound
+	}
+	return teamID, nil
+}
+func (f *fakePlayerLookup) Transfer(context.Context, int64, int64, time.Time) (model.Membership, error) {
+	return model.Membership{}, nil
+}
 func (f *fakePlayerLookup) Exists(_ context.Context, id int64) (bool, error) {
 	return f.ok[id], nil
 }
 
+func (f *fakePlayerLookup) Update(context.Context, model.Player) (model.Player, error) {
+	return model.Player{}, nil
+}
+func (f *fakePlayerLookup) Delete(context.Context, int64) error { return nil }
+func (f *fakePlayerLookup) Restore(context.Context, int64) (model.Player, error) {
+	return model.Player{}, nil
+}
+
 var _ repository.PlayerRepository = (*fakePlayerLookup)(nil)
 
-type fakeGameLookup struct{ ok map[int64]bool }
+// fakeGameLookup knows the games in ok, all between teams 1 and 2; they are in progress unless status says otherwise.
+type fakeGameLookup struct {
+	ok     map[int64]bool
+	status map[int64]string
+}
 
 func (f *fakeGameLookup) Create(context.Context, model.Game) (model.Game, error) {
 	return model.Game{}, nil
 }
 func (f *fakeGameLookup) GetByID(_ context.Context, id int64) (model.Game, error) {
-	if f.ok[id] {
-		return model.Game{ID: id}, nil
+	if !f.ok[id] {
+		return model.Game{}, repository.ErrNotFound
+	}
+	status, ok := f.status[id]
+	if !ok {
+		status = "in_progress"
 	}
-	return model.Game{}, repository.ErrNotFound
+	return model.Game{ID: id, HomeTeamID: 1, AwayTeamID: 2, Status: status, RuleProfileID: nbaRulesID}, nil
 }
 func (f *fakeGameLookup) List(context.Context, repository.Page) (repository.PageResult[model.Game], error) {
 	return repository.PageResult[model.Game]{}, nil
 }
 
+func (f *fakeGameLookup) UpdateScore(context.Context, int64, model.GameScore) (model.Game, error) {
+	return model.Game{}, nil
+}
+func (f *fakeGameLookup) ListPeriodScores(context.Context, int64) ([]model.PeriodScore, error) {
+	return nil, nil
+}
+func (f *fakeGameLookup) CountPeriodsPlayed(context.Context, int64) (int, error) {
+	return 0, nil
+}
+func (f *fakeGameLookup) GetPlayerPointTotals(context.Context, int64) (int, int, error) {
+	return 0, 0, nil
+}
+
+func (f *fakeGameLookup) GetBoxScore(context.Context, int64) (model.BoxScore, error) {
+	return model.BoxScore{}, nil
+}
+
+func (f *fakeGameLookup) Update(context.Context, model.Game) (model.Game, error) {
+	return model.Game{}, nil
+}
+func (f *fakeGameLookup) Delete(context.Context, int64) error { return nil }
+func (f *fakeGameLookup) Restore(context.Context, int64) (model.Game, error) {
+	return model.Game{}, nil
+}
+
+func (f *fakeGameLookup) Transition(context.Context, int64, string, string) (model.GameTransition, error) {
+	return model.GameTransition{}, nil
+}
+func (f *fakeGameLookup) ListTransitions(context.Context, int64) ([]model.GameTransition, error) {
+	return nil, nil
+}
+
 var _ repository.GameRepository = (*fakeGameLookup)(nil)
 
 type fakeTxStats struct{}
@@ -73,10 +159,10 @@ var _ repository.TxManager = (*fakeTxStats)(nil)
 func TestStatsService_UpsertStatLine_Validation(t *testing.T) {
 	logger := zerolog.New(io.Discard)
 	statsRepo := &fakeStatsRepo{}
-	players := &fakePlayerLookup{ok: map[int64]bool{2: true}}
-	games := &fakeGameLookup{ok: map[int64]bool{3: true}}
+	players := &fakePlayerLookup{ok: map[int64]bool{2: true, 5: true, 6: true}, team: map[int64]int64{5: 3, 6: 0}}
+	games := &fakeGameLookup{ok: map[int64]bool{3: true, 4: true}, status: map[int64]string{4: "scheduled"}}
 	tx := &fakeTxStats{}
-	svc := service.NewStatsService(statsRepo, players, games, tx, logger)
+	svc := service.NewStatsService(statsRepo, players, games, newFakeRuleProfileRepo(), newFakeAchievementRepo(), newFakeAvailabilityRepo(), newFakeShotRepo(), tx, logger)
 
 	cases := []struct {
 		name    string
@@ -88,9 +174,17 @@ func TestStatsService_UpsertStatLine_Validation(t *testing.T) {
 		{"negative stat", model.PlayerStatLine{PlayerID: 2, GameID: 3, Points: -1}, true, "points"},
 		{"player missing", model.PlayerStatLine{PlayerID: 9, GameID: 3}, true, "player_id"},
 		{"game missing", model.PlayerStatLine{PlayerID: 2, GameID: 99}, true, "game_id"},
+		{"game not started", model.PlayerStatLine{PlayerID: 2, GameID: 4}, true, "game_id"},
+		{"player on another team", model.PlayerStatLine{PlayerID: 5, GameID: 3}, true, "player_id"},
+		{"player on no roster", model.PlayerStatLine{PlayerID: 6, GameID: 3}, true, "player_id"},
 		{"fouls over max", model.PlayerStatLine{PlayerID: 2, GameID: 3, Fouls: 7}, true, "fouls"},
 		{"minutes too high", model.PlayerStatLine{PlayerID: 2, GameID: 3, MinutesPlayed: 49.0}, true, "minutes_played"},
-		{"ok", model.PlayerStatLine{PlayerID: 2, GameID: 3, Points: 10}, false, ""},
+		{"fg made over attempted", model.PlayerStatLine{PlayerID: 2, GameID: 3, Points: 8, FieldGoalsMade: 4, FieldGoalsAttempted: 3}, true, "field_goals_made"},
+		{"threes over field goals", model.PlayerStatLine{PlayerID: 2, GameID: 3, Points: 6, FieldGoalsMade: 1, FieldGoalsAttempted: 5, ThreePointersMade: 2, ThreePointersAttempted: 4}, true, "three_pointers_made"},
+		{"ft made over attempted", model.PlayerStatLine{PlayerID: 2, GameID: 3, Points: 3, FreeThrowsMade: 3, FreeThrowsAttempted: 2}, true, "free_throws_made"},
+		{"points do not match shots", model.PlayerStatLine{PlayerID: 2, GameID: 3, Points: 10}, true, "points"},
+		{"rebound split mismatch", model.PlayerStatLine{PlayerID: 2, GameID: 3, Rebounds: 7, OffensiveRebounds: 2, DefensiveRebounds: 4}, true, "rebounds"},
+		{"ok", model.PlayerStatLine{PlayerID: 2, GameID: 3, Points: 10, FieldGoalsMade: 4, FieldGoalsAttempted: 9, ThreePointersMade: 1, ThreePointersAttempted: 3, FreeThrowsMade: 1, FreeThrowsAttempted: 2, Rebounds: 5, OffensiveRebounds: 1, DefensiveRebounds: 4}, false, ""},
 	}
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -121,3 +215,33 @@ func TestStatsService_UpsertStatLine_Validation(t *testing.T) {
 		})
 	}
 }
+
+func TestStatsService_ListPlayerGameLog(t *testing.T) {
+	ctx := context.Background()
+	statsRepo := &fakeStatsRepo{}
+	svc := service.NewStatsService(statsRepo, &fakePlayerLookup{ok: map[int64]bool{1: true}}, &fakeGameLookup{ok: map[int64]bool{}}, newFakeRuleProfileRepo(), newFakeAchievementRepo(), newFakeAvailabilityRepo(), newFakeShotRepo(), &fakeTxStats{}, zerolog.New(io.Discard))
+
+	badSeason := "2024"
+	from := time.Date(2025, 1, 10, 15, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	zero := int64(0)
+	_, err := svc.ListPlayerGameLog(ctx, 1, model.GameLogFilter{Season: &badSeason, From: &from, To: &to, OpponentID: &zero, Last: -1}, repository.Page{})
+	if !serviceErrIsInvalid(err) || len(service.FieldErrors(err)) != 4 {
+		t.Fatalf("expected 4 field errors, got %v", service.FieldErrors(err))
+	}
+
+	if _, err := svc.ListPlayerGameLog(ctx, 2, model.GameLogFilter{}, repository.Page{}); !errors.Is(err, repository.ErrNotFound) {
+		t.Fatalf("expected not found, got %v", err)
+	}
+
+	to = time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+	if _, err := svc.ListPlayerGameLog(ctx, 1, model.GameLogFilter{From: &from, To: &to, Last: 5}, repository.Page{Limit: 1000}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := *statsRepo.lastFilter.From; !got.Equal(time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("from should be truncated to the day, got %v", got)
+	}
+	if statsRepo.lastFilter.Last != 5 || statsRepo.lastPage.Limit > 100 {
+		t.Fatalf("unexpected filter/page passed through: %+v %+v", statsRepo.lastFilter, statsRepo.lastPage)
+	}
+}
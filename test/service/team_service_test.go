@@ -22,10 +22,13 @@ type fakeTeamRepo struct {
 	// For stats testing
 	statsResult model.TeamAggregatedStats
 	statsErr    error
+	standings   []model.TeamStanding
+	lastDims    []string // dimensions passed to GetTeamSplits
+	deleted     map[int64]model.Team
 }
 
 func newFakeTeamRepo() *fakeTeamRepo {
-	return &fakeTeamRepo{nextID: 1, items: map[int64]model.Team{}}
+	return &fakeTeamRepo{nextID: 1, items: map[int64]model.Team{}, deleted: map[int64]model.Team{}}
 }
 
 func (f *fakeTeamRepo) Create(_ context.Context, t model.Team) (model.Team, error) {
@@ -54,7 +57,7 @@ func (f *fakeTeamRepo) List(_ context.Context, p repository.Page) (repository.Pa
 	return res, nil
 }
 
-func (f *fakeTeamRepo) GetTeamAggregatedStats(_ context.Context, teamID int64, _ *string) (model.TeamAggregatedStats, error) {
+func (f *fakeTeamRepo) GetTeamAggregatedStats(_ context.Context, teamID int64, _, _ *string) (model.TeamAggregatedStats, error) {
 	if f.statsErr != nil {
 		return model.TeamAggregatedStats{}, f.statsErr
 	}
@@ -68,11 +71,63 @@ func (f *fakeTeamRepo) Exists(_ context.Context, id int64) (bool, error) {
 	return ok, nil
 }
 
+func (f *fakeTeamRepo) GetTeamSplits(_ context.Context, _ int64, _, _ *string, dims []string) ([]model.TeamSplit, error) {
+	f.lastDims = dims
+	out := make([]model.TeamSplit, 0, len(dims))
+	for _, d := range dims {
+		out = append(out, model.TeamSplit{Dimension: d, Key: "k", TeamAggregatedStats: f.statsResult})
+	}
+	return out, f.statsErr
+}
+
+func (f *fakeTeamRepo) ListAttendance(context.Context, *string, *int64) ([]model.TeamAttendance, error) {
+	return []model.TeamAttendance{}, f.statsErr
+}
+
+func (f *fakeTeamRepo) ListStandings(_ context.Context, _ string, _ *string) ([]model.TeamStanding, error) {
+	out := make([]model.TeamStanding, len(f.standings))
+	copy(out, f.standings)
+	return out, f.statsErr
+}
+
+func (f *fakeTeamRepo) Update(_ context.Context, t model.Team) (model.Team, error) {
+	if _, ok := f.items[t.ID]; !ok {
+		return model.Team{}, repository.ErrNotFound
+	}
+	for id, other := range f.items {
+		if id != t.ID && other.Name == t.Name {
+			return model.Team{}, repository.ErrAlreadyExists
+		}
+	}
+	f.items[t.ID] = t
+	return t, nil
+}
+
+func (f *fakeTeamRepo) Delete(_ context.Context, id int64) error {
+	t, ok := f.items[id]
+	if !ok {
+		return repository.ErrNotFound
+	}
+	delete(f.items, id)
+	f.deleted[id] = t
+	return nil
+}
+
+func (f *fakeTeamRepo) Restore(_ context.Context, id int64) (model.Team, error) {
+	t, ok := f.deleted[id]
+	if !ok {
+		return model.Team{}, repository.ErrNotFound
+	}
+	delete(f.deleted, id)
+	f.items[id] = t
+	return t, nil
+}
+
 var _ repository.TeamRepository = (*fakeTeamRepo)(nil)
 
 func TestTeamService_CreateTeam_Validation(t *testing.T) {
 	logger := zerolog.New(io.Discard)
-	svc := service.NewTeamService(newFakeTeamRepo(), logger)
+	svc := service.NewTeamService(newFakeTeamRepo(), newFakeVenueRepo(), logger)
 
 	cases := []struct {
 		name      string
@@ -89,7 +144,7 @@ func TestTeamService_CreateTeam_Validation(t *testing.T) {
 
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
-			_, err := svc.CreateTeam(context.Background(), tc.input)
+			_, err := svc.CreateTeam(context.Background(), model.Team{Name: tc.input})
 			if tc.wantErr && err == nil {
 				t.Fatalf("expected error")
 			}
@@ -120,8 +175,8 @@ func TestTeamService_CreateTeam_DuplicatePropagates(t *testing.T) {
 	logger := zerolog.New(io.Discard)
 	repo := newFakeTeamRepo()
 	repo.createErr = repository.ErrAlreadyExists
-	svc := service.NewTeamService(repo, logger)
-	_, err := svc.CreateTeam(context.Background(), "Lakers")
+	svc := service.NewTeamService(repo, newFakeVenueRepo(), logger)
+	_, err := svc.CreateTeam(context.Background(), model.Team{Name: "Lakers"})
 	if err == nil || err != repository.ErrAlreadyExists {
 		t.Fatalf("expected ErrAlreadyExists, got %v", err)
 	}
@@ -129,7 +184,7 @@ func TestTeamService_CreateTeam_DuplicatePropagates(t *testing.T) {
 
 func TestTeamService_GetTeam_InvalidID(t *testing.T) {
 	logger := zerolog.New(io.Discard)
-	svc := service.NewTeamService(newFakeTeamRepo(), logger)
+	svc := service.NewTeamService(newFakeTeamRepo(), newFakeVenueRepo(), logger)
 	_, err := svc.GetTeam(context.Background(), 0)
 	if err == nil || !serviceErrIsInvalid(err) {
 		t.Fatalf("expected invalid input error, got %v", err)
@@ -142,7 +197,7 @@ func TestTeamService_ListTeams_PaginationNormalization(t *testing.T) {
 	// seed a couple of items so result isn't empty
 	_, _ = repo.Create(context.Background(), model.Team{Name: "A"})
 	_, _ = repo.Create(context.Background(), model.Team{Name: "B"})
-	svc := service.NewTeamService(repo, logger)
+	svc := service.NewTeamService(repo, newFakeVenueRepo(), logger)
 	_, err := svc.ListTeams(context.Background(), repository.Page{Limit: -5, Offset: -10})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
@@ -158,7 +213,7 @@ func TestTeamService_ListTeams_PaginationNormalization(t *testing.T) {
 func TestTeamService_GetTeamAggregatedStats(t *testing.T) {
 	logger := zerolog.New(io.Discard)
 	repo := newFakeTeamRepo()
-	svc := service.NewTeamService(repo, logger)
+	svc := service.NewTeamService(repo, newFakeVenueRepo(), logger)
 
 	// Seed a team for valid ID checks
 	_, err := repo.Create(context.Background(), model.Team{Name: "Lakers", ID: 1})
@@ -169,13 +224,13 @@ func TestTeamService_GetTeamAggregatedStats(t *testing.T) {
 		repo.statsResult = expected
 		repo.statsErr = nil
 
-		stats, err := svc.GetTeamAggregatedStats(context.Background(), 1, nil)
+		stats, err := svc.GetTeamAggregatedStats(context.Background(), 1, nil, nil)
 		require.NoError(t, err)
 		require.Equal(t, expected, stats)
 	})
 
 	t.Run("Invalid Team ID", func(t *testing.T) {
-		_, err := svc.GetTeamAggregatedStats(context.Background(), 0, nil)
+		_, err := svc.GetTeamAggregatedStats(context.Background(), 0, nil, nil)
 		require.Error(t, err)
 		require.True(t, serviceErrIsInvalid(err), "expected invalid input error")
 		fields := service.FieldErrors(err)
@@ -185,7 +240,7 @@ func TestTeamService_GetTeamAggregatedStats(t *testing.T) {
 
 	t.Run("Invalid Season Format", func(t *testing.T) {
 		invalidSeason := "2023-2024" // wrong format
-		_, err := svc.GetTeamAggregatedStats(context.Background(), 1, &invalidSeason)
+		_, err := svc.GetTeamAggregatedStats(context.Background(), 1, &invalidSeason, nil)
 		require.Error(t, err)
 		require.True(t, serviceErrIsInvalid(err), "expected invalid input error")
 		fields := service.FieldErrors(err)
@@ -195,13 +250,96 @@ func TestTeamService_GetTeamAggregatedStats(t *testing.T) {
 
 	t.Run("Repository Error", func(t *testing.T) {
 		repo.statsErr = errors.New("something went wrong")
-		_, err := svc.GetTeamAggregatedStats(context.Background(), 1, nil)
+		_, err := svc.GetTeamAggregatedStats(context.Background(), 1, nil, nil)
 		require.Error(t, err)
 		require.False(t, serviceErrIsInvalid(err)) // Should be a direct repo error
 		require.Equal(t, "something went wrong", err.Error())
 	})
 }
 
+func TestTeamService_GetTeamSplits(t *testing.T) {
+	logger := zerolog.New(io.Discard)
+	repo := newFakeTeamRepo()
+	svc := service.NewTeamService(repo, newFakeVenueRepo(), logger)
+	ctx := context.Background()
+	_, err := repo.Create(ctx, model.Team{Name: "Lakers"})
+	require.NoError(t, err)
+
+	t.Run("All Dimensions By Default", func(t *testing.T) {
+		repo.statsErr = nil
+		res, err := svc.GetTeamSplits(ctx, 1, nil, nil, nil)
+		require.NoError(t, err)
+		require.Equal(t, []string{"location", "result", "month", "opponent", "rest"}, repo.lastDims)
+		require.Len(t, res.Splits, 5)
+	})
+
+	t.Run("Selected Dimensions In Canonical Order", func(t *testing.T) {
+		season := "2025-26"
+		res, err := svc.GetTeamSplits(ctx, 1, &season, nil, []string{"rest", " Location ", "rest"})
+		require.NoError(t, err)
+		require.Equal(t, []string{"location", "rest"}, repo.lastDims)
+		require.Equal(t, "2025-26", *res.Season)
+	})
+
+	t.Run("Unknown Dimension", func(t *testing.T) {
+		_, err := svc.GetTeamSplits(ctx, 1, nil, nil, []string{"weekday"})
+		require.True(t, serviceErrIsInvalid(err))
+		require.Equal(t, "by", service.FieldErrors(err)[0].Field)
+	})
+
+	t.Run("Unknown Team", func(t *testing.T) {
+		_, err := svc.GetTeamSplits(ctx, 99, nil, nil, nil)
+		require.ErrorIs(t, err, repository.ErrNotFound)
+	})
+}
+
 func serviceErrIsInvalid(err error) bool {
 	return err != nil && (err.Error() == service.ErrInvalidInput.Error())
 }
+
+func TestTeamService_UpdateDeleteRestore(t *testing.T) {
+	repo := newFakeTeamRepo()
+	svc := service.NewTeamService(repo, newFakeVenueRepo(), zerolog.New(io.Discard))
+	ctx := context.Background()
+	lakers, err := svc.CreateTeam(ctx, model.Team{Name: "Lakers"})
+	require.NoError(t, err)
+	_, err = svc.CreateTeam(ctx, model.Team{Name: "Clippers"})
+	require.NoError(t, err)
+
+	t.Run("Validation", func(t *testing.T) {
+		blank := "  "
+		_, err := svc.UpdateTeam(ctx, lakers.ID, model.TeamPatch{Name: &blank})
+		require.True(t, serviceErrIsInvalid(err))
+		require.Equal(t, "name", service.FieldErrors(err)[0].Field)
+	})
+
+	t.Run("Empty Patch Is A No-op", func(t *testing.T) {
+		got, err := svc.UpdateTeam(ctx, lakers.ID, model.TeamPatch{})
+		require.NoError(t, err)
+		require.Equal(t, "Lakers", got.Name)
+	})
+
+	t.Run("Rename", func(t *testing.T) {
+		name := " Los Angeles Lakers "
+		got, err := svc.UpdateTeam(ctx, lakers.ID, model.TeamPatch{Name: &name})
+		require.NoError(t, err)
+		require.Equal(t, "Los Angeles Lakers", got.Name)
+
+		taken := "Clippers"
+		_, err = svc.UpdateTeam(ctx, lakers.ID, model.TeamPatch{Name: &taken})
+		require.ErrorIs(t, err, repository.ErrAlreadyExists)
+	})
+
+	t.Run("Delete And Restore", func(t *testing.T) {
+		require.NoError(t, svc.DeleteTeam(ctx, lakers.ID))
+		_, err := svc.GetTeam(ctx, lakers.ID)
+		require.ErrorIs(t, err, repository.ErrNotFound)
+		require.ErrorIs(t, svc.DeleteTeam(ctx, lakers.ID), repository.ErrNotFound)
+
+		restored, err := svc.RestoreTeam(ctx, lakers.ID)
+		require.NoError(t, err)
+		require.Equal(t, "Los Angeles Lakers", restored.Name)
+		_, err = svc.RestoreTeam(ctx, lakers.ID)
+		require.ErrorIs(t, err, repository.ErrNotFound, "restoring an active team")
+	})
+}
@@ -0,0 +1,173 @@
+package service_test
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/maxviazov/basketball-stats-service/internal/model"
+	"github.com/maxviazov/basketball-stats-service/internal/repository"
+	"github.com/maxviazov/basketball-stats-service/internal/service"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeVenueRepo struct {
+	nextID int64
+	venues map[int64]model.Venue
+}
+
+func newFakeVenueRepo() *fakeVenueRepo {
+	return &fakeVenueRepo{nextID: 1, venues: map[int64]model.Venue{}}
+}
+func (f *fakeVenueRepo) Create(_ context.Context, v model.Venue) (model.Venue, error) {
+	for _, existing := range f.venues {
+		if existing.Name == v.Name && existing.City == v.City {
+			return model.Venue{}, repository.ErrAlreadyExists
+		}
+	}
+	v.ID = f.nextID
+	f.nextID++
+	f.venues[v.ID] = v
+	return v, nil
+}
+func (f *fakeVenueRepo) GetByID(_ context.Context, id int64) (model.Venue, error) {
+	v, ok := f.venues[id]
+	if !ok {
+		return model.Venue{}, repository.ErrNotFound
+	}
+	return v, nil
+}
+func (f *fakeVenueRepo) List(context.Context, repository.Page) (repository.PageResult[model.Venue], error) {
+	var res repository.PageResult[model.Venue]
+	for _, v := range f.venues {
+		res.Items = append(res.Items, v)
+	}
+	res.Total = len(res.Items)
+	return res, nil
+}
+func (f *fakeVenueRepo) Update(_ context.Context, v model.Venue) (model.Venue, error) {
+	if _, ok := f.venues[v.ID]; !ok {
+		return model.Venue{}, repository.ErrNotFound
+	}
+	f.venues[v.ID] = v
+	return v, nil
+}
+func (f *fakeVenueRepo) Delete(_ context.Context, id int64) error {
+	if _, ok := f.venues[id]; !ok {
+		return repository.ErrNotFound
+	}
+	delete(f.venues, id)
+	return nil
+}
+
+var _ repository.VenueRepository = (*fakeVenueRepo)(nil)
+
+func fieldNames(err error) []string {
+	var out []string
+	for _, fe := range service.FieldErrors(err) {
+		out = append(out, fe.Field)
+	}
+	return out
+}
+
+func TestVenueService_CreateVenue(t *testing.T) {
+	svc := service.NewVenueService(newFakeVenueRepo(), zerolog.New(io.Discard))
+	ctx := context.Background()
+	lat, lon := 40.7505, -73.9934
+
+	_, err := svc.CreateVenue(ctx, model.Venue{Name: "X", City: "", Capacity: 0, Timezone: "Mars/Olympus", Latitude: &lat})
+	require.True(t, serviceErrIsInvalid(err))
+	require.ElementsMatch(t, []string{"name", "city", "capacity", "timezone", "coordinates"}, fieldNames(err))
+
+	_, err = svc.CreateVenue(ctx, model.Venue{Name: "Arena", City: "Town", Capacity: 100, Timezone: "Local"})
+	require.Equal(t, []string{"timezone"}, fieldNames(err), "Local depends on the host")
+
+	v, err := svc.CreateVenue(ctx, model.Venue{Name: " Madison Square Garden ", City: "New York", Capacity: 19812, Timezone: "America/New_York", Latitude: &lat, Longitude: &lon})
+	require.NoError(t, err)
+	require.Equal(t, "Madison Square Garden", v.Name)
+
+	_, err = svc.CreateVenue(ctx, model.Venue{Name: "Madison Square Garden", City: "New York", Capacity: 19812, Timezone: "America/New_York"})
+	require.ErrorIs(t, err, repository.ErrAlreadyExists)
+
+	capacity := -1
+	_, err = svc.UpdateVenue(ctx, v.ID, model.VenuePatch{Capacity: &capacity})
+	require.Equal(t, []string{"capacity"}, fieldNames(err))
+	tz := "Europe/Madrid"
+	v, err = svc.UpdateVenue(ctx, v.ID, model.VenuePatch{Timezone: &tz})
+	require.NoError(t, err)
+	require.Equal(t, "Europe/Madrid", v.Timezone)
+	require.Equal(t, 19812, v.Capacity)
+}
+
+func TestGameService_VenueAndAttendance(t *testing.T) {
+	ctx := context.Background()
+	venues := newFakeVenueRepo()
+	arena, _ := venues.Create(ctx, model.Venue{Name: "Arena", City: "Town", Capacity: 18000, Timezone: "America/Chicago"})
+	gym, _ := venues.Create(ctx, model.Venue{Name: "Gym", City: "Town", Capacity: 2000, Timezone: "America/Chicago"})
+	teams := newFakeTeamRepo()
+	teams.items[1] = model.Team{ID: 1, Name: "Home", HomeVenueID: &arena.ID}
+	teams.items[2] = model.Team{ID: 2, Name: "Away"}
+	games := newFakeGameRepo()
+	svc := service.NewGameService(games, teams, newFakeSeasonRepo(season2025()), newFakeRuleProfileRepo(), venues, newFakeLineStore(), newFakeAchievementRepo(), &fakeTx{}, zerolog.New(io.Discard))
+	attendance := func(n int) *int { return &n }
+
+	t.Run("defaults_to_home_venue", func(t *testing.T) {
+		g, err := svc.CreateGame(ctx, model.Game{Season: "2025-26", Date: gameDay, HomeTeamID: 1, AwayTeamID: 2, Status: "scheduled", Attendance: attendance(17500)})
+		require.NoError(t, err)
+		require.Equal(t, arena.ID, *g.VenueID)
+		require.Equal(t, 17500, *g.Attendance)
+	})
+
+	t.Run("attendance_over_capacity", func(t *testing.T) {
+		_, err := svc.CreateGame(ctx, model.Game{Season: "2025-26", Date: gameDay, HomeTeamID: 1, AwayTeamID: 2, Status: "scheduled", VenueID: &gym.ID, Attendance: attendance(2001)})
+		require.Equal(t, []string{"attendance"}, fieldNames(err))
+	})
+
+	t.Run("attendance_without_venue", func(t *testing.T) {
+		_, err := svc.CreateGame(ctx, model.Game{Season: "2025-26", Date: gameDay, HomeTeamID: 2, AwayTeamID: 1, Status: "scheduled", Attendance: attendance(100)})
+		require.Equal(t, []string{"attendance"}, fieldNames(err))
+	})
+
+	t.Run("unknown_venue", func(t *testing.T) {
+		missing := int64(99)
+		_, err := svc.CreateGame(ctx, model.Game{Season: "2025-26", Date: gameDay, HomeTeamID: 1, AwayTeamID: 2, Status: "scheduled", VenueID: &missing})
+		require.Equal(t, []string{"venue_id"}, fieldNames(err))
+	})
+
+	t.Run("patch_checks_the_merged_game", func(t *testing.T) {
+		g, err := svc.CreateGame(ctx, model.Game{Season: "2025-26", Date: gameDay, HomeTeamID: 1, AwayTeamID: 2, Status: "scheduled", Attendance: attendance(5000)})
+		require.NoError(t, err)
+		// Moving a 5000 crowd into a 2000 seat gym is rejected even though the patch carries no attendance.
+		_, err = svc.UpdateGame(ctx, g.ID, model.GamePatch{VenueID: &gym.ID})
+		require.Equal(t, []string{"attendance"}, fieldNames(err))
+		out, err := svc.UpdateGame(ctx, g.ID, model.GamePatch{VenueID: &gym.ID, Attendance: attendance(1999)})
+		require.NoError(t, err)
+		require.Equal(t, gym.ID, *out.VenueID)
+	})
+}
+
+func TestTeamService_HomeVenue(t *testing.T) {
+	ctx := context.Background()
+	venues := newFakeVenueRepo()
+	arena, _ := venues.Create(ctx, model.Venue{Name: "Arena", City: "Town", Capacity: 18000, Timezone: "UTC"})
+	svc := service.NewTeamService(newFakeTeamRepo(), venues, zerolog.New(io.Discard))
+
+	missing := int64(42)
+	_, err := svc.CreateTeam(ctx, model.Team{Name: "Lakers", HomeVenueID: &missing})
+	require.Equal(t, []string{"home_venue_id"}, fieldNames(err))
+
+	team, err := svc.CreateTeam(ctx, model.Team{Name: "Lakers"})
+	require.NoError(t, err)
+	require.Nil(t, team.HomeVenueID)
+	team, err = svc.UpdateTeam(ctx, team.ID, model.TeamPatch{HomeVenueID: &arena.ID})
+	require.NoError(t, err)
+	require.Equal(t, arena.ID, *team.HomeVenueID)
+	require.Equal(t, "Lakers", team.Name)
+
+	season := "2025"
+	_, err = svc.GetAttendance(ctx, &season, nil)
+	require.Equal(t, []string{"season"}, fieldNames(err))
+	_, err = svc.GetAttendance(ctx, nil, &missing)
+	require.ErrorIs(t, err, repository.ErrNotFound)
+}